@@ -58,6 +58,33 @@ func (policy CreationPolicy) AllowsAdoption() bool {
 	return policy == CreationPolicyAdopt || policy == CreationPolicyCreateOrAdopt
 }
 
+// ManagementPolicy controls which changes the operator makes to a Harbor
+// resource once it is recorded in status.
+type ManagementPolicy string
+
+const (
+	// ManagementPolicyFull creates, updates and deletes the Harbor resource.
+	ManagementPolicyFull ManagementPolicy = "Full"
+	// ManagementPolicyObserveOnly adopts and reads the Harbor resource and
+	// reports differences from the spec without changing Harbor.
+	ManagementPolicyObserveOnly ManagementPolicy = "ObserveOnly"
+	// ManagementPolicyNoDelete creates and updates the Harbor resource but
+	// leaves it in place when the Kubernetes object is deleted.
+	ManagementPolicyNoDelete ManagementPolicy = "NoDelete"
+)
+
+// AllowsWrites reports whether the policy permits changing Harbor. The zero
+// value is treated as Full.
+func (policy ManagementPolicy) AllowsWrites() bool {
+	return policy != ManagementPolicyObserveOnly
+}
+
+// AllowsDeletion reports whether the policy permits deleting the Harbor
+// resource.
+func (policy ManagementPolicy) AllowsDeletion() bool {
+	return policy == "" || policy == ManagementPolicyFull
+}
+
 // HarborConnectionReference identifies either a namespaced HarborConnection or a
 // cluster-scoped ClusterHarborConnection.
 type HarborConnectionReference struct {
@@ -93,6 +120,18 @@ type HarborSpecBase struct {
 	// +optional
 	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 
+	// ManagementPolicy controls which changes the operator makes in Harbor.
+	// Full creates, updates and deletes the Harbor resource.
+	// ObserveOnly adopts an existing Harbor resource by name and reports in
+	// status where it differs from the spec, but never writes to Harbor.
+	// NoDelete creates and updates the Harbor resource but never deletes it,
+	// as if deletionPolicy were Orphan.
+	// Defaults to Full.
+	// +kubebuilder:default=Full
+	// +kubebuilder:validation:Enum=Full;ObserveOnly;NoDelete
+	// +optional
+	ManagementPolicy ManagementPolicy `json:"managementPolicy,omitempty"`
+
 	// DriftDetectionInterval is the interval at which the operator checks for drift.
 	// When omitted, the operator's default drift detection interval is used.
 	// An explicit value of 0 disables periodic drift detection.
@@ -193,6 +232,38 @@ type HarborStatusBase struct {
 	// operations. Once set, changing the effective connection is refused.
 	// +optional
 	ResolvedHarborConnection *HarborConnectionBinding `json:"resolvedHarborConnection,omitempty"`
+
+	// Drift describes the most recent change made in Harbor outside the
+	// operator. It is kept after the operator corrects the change and is
+	// replaced when a different change is found.
+	// +optional
+	Drift *DriftStatus `json:"drift,omitempty"`
+}
+
+// DriftStatus reports where Harbor differed from an unchanged spec.
+type DriftStatus struct {
+	// DetectedAt is when the difference was first found.
+	DetectedAt metav1.Time `json:"detectedAt"`
+
+	// Fields lists the differing fields of the Harbor object.
+	// +listType=atomic
+	Fields []DriftField `json:"fields"`
+}
+
+// DriftField is a single field that differed between the spec and Harbor.
+type DriftField struct {
+	// Path is the JSON path of the field in the Harbor API representation,
+	// such as $.metadata.public.
+	Path string `json:"path"`
+
+	// Desired is the JSON value derived from the spec. Secret values are
+	// redacted.
+	// +optional
+	Desired string `json:"desired,omitempty"`
+
+	// Observed is the JSON value found in Harbor. Secret values are redacted.
+	// +optional
+	Observed string `json:"observed,omitempty"`
 }
 
 // GetDriftDetectionInterval returns the drift detection interval.
@@ -205,7 +276,12 @@ func (base *HarborClaimSpecBase) GetDriftDetectionInterval() *metav1.Duration {
 	return base.DriftDetectionInterval
 }
 
+// GetDeletionPolicy returns the deletion policy. A management policy that does
+// not allow deletion always orphans the Harbor resource.
 func (base *HarborSpecBase) GetDeletionPolicy() DeletionPolicy {
+	if !base.ManagementPolicy.AllowsDeletion() {
+		return DeletionPolicyOrphan
+	}
 	if base.DeletionPolicy == "" {
 		return DeletionPolicyDelete
 	}
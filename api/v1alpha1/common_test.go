@@ -26,3 +26,29 @@ func TestCreationPolicyCapabilities(t *testing.T) {
 		})
 	}
 }
+
+func TestManagementPolicyCapabilities(t *testing.T) {
+	tests := []struct {
+		name           string
+		policy         ManagementPolicy
+		allowsWrites   bool
+		deletionPolicy DeletionPolicy
+	}{
+		{name: "zero value defaults to full", allowsWrites: true, deletionPolicy: DeletionPolicyDelete},
+		{name: "full", policy: ManagementPolicyFull, allowsWrites: true, deletionPolicy: DeletionPolicyDelete},
+		{name: "observe only", policy: ManagementPolicyObserveOnly, deletionPolicy: DeletionPolicyOrphan},
+		{name: "no delete", policy: ManagementPolicyNoDelete, allowsWrites: true, deletionPolicy: DeletionPolicyOrphan},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.AllowsWrites(); got != tt.allowsWrites {
+				t.Fatalf("AllowsWrites() = %t, want %t", got, tt.allowsWrites)
+			}
+			base := HarborSpecBase{ManagementPolicy: tt.policy, DeletionPolicy: DeletionPolicyDelete}
+			if got := base.GetDeletionPolicy(); got != tt.deletionPolicy {
+				t.Fatalf("GetDeletionPolicy() = %q, want %q", got, tt.deletionPolicy)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package v1alpha1
+
+// The v1alpha1 types are the conversion hub for the kinds that are also
+// served as v1beta1.
+
+// Hub marks Project as the conversion hub.
+func (*Project) Hub() {}
+
+// Hub marks Quota as the conversion hub.
+func (*Quota) Hub() {}
+
+// Hub marks RetentionPolicy as the conversion hub.
+func (*RetentionPolicy) Hub() {}
+
+// Hub marks ImmutableTagRule as the conversion hub.
+func (*ImmutableTagRule) Hub() {}
+
+// Hub marks ReplicationPolicy as the conversion hub.
+func (*ReplicationPolicy) Hub() {}
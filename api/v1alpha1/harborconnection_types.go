@@ -23,14 +23,88 @@ type HarborConnectionSpec struct {
 	// When set, it is mutually exclusive with caBundle.
 	// +optional
 	CABundleSecretRef *SecretReference `json:"caBundleSecretRef,omitempty"`
+
+	// ClientCertificateSecretRef references a kubernetes.io/tls Secret whose
+	// tls.crt and tls.key are presented as a TLS client certificate, for example
+	// to an ingress in front of Harbor that requires mutual TLS. The key field
+	// of the reference is ignored.
+	// +optional
+	ClientCertificateSecretRef *SecretReference `json:"clientCertificateSecretRef,omitempty"`
+
+	// HealthCheck enables periodic connectivity checks. When omitted, the
+	// connection is only checked when it or one of its Secrets changes.
+	// +optional
+	HealthCheck *ConnectionHealthCheck `json:"healthCheck,omitempty"`
+
+	// Limits bounds the Harbor API requests that all controllers together send
+	// through this connection. When omitted, requests are not limited.
+	// +optional
+	Limits *ConnectionLimits `json:"limits,omitempty"`
+}
+
+// ConnectionLimits bounds the Harbor API load of one connection. Requests
+// that cannot get through within a short wait are not sent; the resource is
+// requeued with backoff instead.
+type ConnectionLimits struct {
+	// MaxInFlight is the maximum number of concurrent Harbor API requests.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxInFlight int32 `json:"maxInFlight,omitempty"`
+
+	// RequestsPerSecond is the sustained Harbor API request rate.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RequestsPerSecond int32 `json:"requestsPerSecond,omitempty"`
+
+	// Burst is the number of requests that may be sent at once above
+	// requestsPerSecond. Defaults to requestsPerSecond.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
 }
 
+// ConnectionHealthCheck configures periodic connectivity checks.
+type ConnectionHealthCheck struct {
+	// Interval between checks.
+	Interval metav1.Duration `json:"interval"`
+
+	// FailureThreshold is the number of consecutive failed checks after which
+	// the connection is reported unhealthy. Dependent resources then report
+	// ConnectionReady=False and skip their Harbor calls until a check succeeds.
+	// Defaults to 3.
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+}
+
+// Credential types supported by Credentials.Type.
+const (
+	// CredentialTypeBasic sends a Harbor username and password with HTTP basic
+	// authentication.
+	CredentialTypeBasic = "basic"
+	// CredentialTypeOIDCCLISecret sends a Harbor username and the user's CLI
+	// secret with HTTP basic authentication. Use it when Harbor runs in OIDC
+	// auth mode, where user passwords cannot be used against the API.
+	CredentialTypeOIDCCLISecret = "oidcCLISecret"
+	// CredentialTypeBearerToken sends an OIDC token from the identity provider
+	// in an Authorization: Bearer header.
+	CredentialTypeBearerToken = "bearerToken"
+)
+
 // Credentials holds default authentication details.
-// +kubebuilder:validation:XValidation:rule="has(self.username) != has(self.usernameSecretRef)",message="exactly one of username or usernameSecretRef must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.type) && self.type == 'bearerToken' || has(self.username) != has(self.usernameSecretRef)",message="exactly one of username or usernameSecretRef must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.type) && self.type == 'bearerToken' || has(self.passwordSecretRef)",message="passwordSecretRef is required for basic and oidcCLISecret credentials"
+// +kubebuilder:validation:XValidation:rule="has(self.type) && self.type == 'bearerToken' || !has(self.tokenSecretRef) && !has(self.tokenEndpoint)",message="tokenSecretRef and tokenEndpoint are only valid for bearerToken credentials"
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type != 'bearerToken' || !has(self.username) && !has(self.usernameSecretRef) && !has(self.passwordSecretRef)",message="bearerToken credentials do not use username or passwordSecretRef"
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type != 'bearerToken' || has(self.tokenSecretRef) != has(self.tokenEndpoint)",message="exactly one of tokenSecretRef or tokenEndpoint must be set for bearerToken credentials"
 type Credentials struct {
 	// Type selects the credential type. Defaults to basic.
+	// basic and oidcCLISecret authenticate with a username and secret;
+	// oidcCLISecret expects the CLI secret from the Harbor user profile.
+	// bearerToken sends a token from tokenSecretRef or tokenEndpoint.
 	// +kubebuilder:default=basic
-	// +kubebuilder:validation:Enum=basic
+	// +kubebuilder:validation:Enum=basic;oidcCLISecret;bearerToken
 	// +optional
 	Type string `json:"type,omitempty"`
 
@@ -45,8 +119,46 @@ type Credentials struct {
 	// +optional
 	UsernameSecretRef *SecretReference `json:"usernameSecretRef,omitempty"`
 
-	// PasswordSecretRef points to the Kubernetes Secret that stores the password / token.
-	PasswordSecretRef SecretReference `json:"passwordSecretRef"`
+	// PasswordSecretRef points to the Kubernetes Secret that stores the password,
+	// robot secret, or OIDC CLI secret. Required unless type is bearerToken.
+	// +optional
+	PasswordSecretRef *SecretReference `json:"passwordSecretRef,omitempty"`
+
+	// TokenSecretRef points to the Kubernetes Secret that stores a bearer token.
+	// The key defaults to token. Whoever writes the Secret is responsible for
+	// refreshing the token before it expires.
+	// +optional
+	TokenSecretRef *SecretReference `json:"tokenSecretRef,omitempty"`
+
+	// TokenEndpoint lets the operator obtain and refresh bearer tokens itself
+	// with the OAuth 2.0 client credentials grant.
+	// +optional
+	TokenEndpoint *TokenEndpoint `json:"tokenEndpoint,omitempty"`
+}
+
+// TokenEndpoint configures the OAuth 2.0 client credentials grant used to
+// obtain bearer tokens for Harbor.
+type TokenEndpoint struct {
+	// URL is the token endpoint of the identity provider.
+	// +kubebuilder:validation:Format=url
+	URL string `json:"url"`
+
+	// ClientID identifies the operator at the identity provider.
+	// +kubebuilder:validation:MinLength=1
+	ClientID string `json:"clientID"`
+
+	// ClientSecretRef points to the Kubernetes Secret that stores the client
+	// secret. The key defaults to clientSecret.
+	ClientSecretRef SecretReference `json:"clientSecretRef"`
+
+	// Scopes are requested with every token.
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+
+	// Audience is sent as the audience parameter when the identity provider
+	// needs it to issue a token Harbor accepts.
+	// +optional
+	Audience string `json:"audience,omitempty"`
 }
 
 // HarborConnectionStatus defines the observed state of HarborConnection.
@@ -55,6 +167,56 @@ type HarborConnectionStatus struct {
 
 	// Authenticated indicates whether the connection was successfully authenticated.
 	Authenticated bool `json:"authenticated,omitempty"`
+
+	// ClientCertificateNotAfter is the expiry of the certificate from
+	// clientCertificateSecretRef.
+	// +optional
+	ClientCertificateNotAfter *metav1.Time `json:"clientCertificateNotAfter,omitempty"`
+
+	// Harbor describes the Harbor instance as reported by its systeminfo API.
+	// +optional
+	Harbor *HarborSystemInfo `json:"harbor,omitempty"`
+
+	// ConsecutiveFailures counts failed health checks since the last
+	// successful one.
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+}
+
+// HarborFeature names an optional Harbor capability that dependent resources
+// may require.
+type HarborFeature string
+
+const (
+	// HarborFeatureSBOM is SBOM generation, including the
+	// auto_sbom_generation project setting. Available from Harbor 2.11.
+	HarborFeatureSBOM HarborFeature = "SBOM"
+	// HarborFeatureSecurityHub is the security hub vulnerability overview.
+	// Available from Harbor 2.10.
+	HarborFeatureSecurityHub HarborFeature = "SecurityHub"
+	// HarborFeatureOIDC means Harbor authenticates users with OIDC.
+	HarborFeatureOIDC HarborFeature = "OIDC"
+)
+
+// HarborSystemInfo is the Harbor version and capabilities discovered by a
+// connection.
+type HarborSystemInfo struct {
+	// Version is the Harbor build version. Harbor only reports it to
+	// authenticated callers.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// AuthMode is the Harbor authentication mode, such as db_auth or oidc_auth.
+	// +optional
+	AuthMode string `json:"authMode,omitempty"`
+
+	// ReadOnly reports whether Harbor is in read-only mode.
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// Features lists the optional capabilities available on this Harbor.
+	// +optional
+	Features []HarborFeature `json:"features,omitempty"`
 }
 
 // +kubebuilder:object:root=true
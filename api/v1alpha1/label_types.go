@@ -6,9 +6,18 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 // +kubebuilder:validation:XValidation:rule="!has(self.projectRef) || self.scope == 'p'",message="scope must be 'p' when projectRef is set"
 // +kubebuilder:validation:XValidation:rule="self.scope != 'p' || has(self.projectRef)",message="projectRef is required when scope is 'p'"
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.projectRef) || (has(self.projectRef) && self.projectRef == oldSelf.projectRef)",message="projectRef is immutable; delete and recreate the Label"
+// +kubebuilder:validation:XValidation:rule="has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName) || self.harborName == oldSelf.harborName)",message="harborName is immutable"
 type LabelSpec struct {
 	HarborSpecBase `json:",inline"`
 
+	// HarborName is the name of the label in Harbor. When omitted, it
+	// defaults to metadata.name. Set it for Harbor names that are not valid
+	// Kubernetes names. It cannot be changed after creation.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	HarborName string `json:"harborName,omitempty"`
+
 	// CreationPolicy controls whether the operator creates or adopts the Harbor label.
 	// When omitted, the operator's default creation policy is used.
 	// +kubebuilder:validation:Enum=Create;Adopt;CreateOrAdopt
@@ -70,6 +79,14 @@ type LabelList struct {
 	Items           []Label `json:"items"`
 }
 
+// HarborName returns the name of the label in Harbor.
+func (label *Label) HarborName() string {
+	if label.Spec.HarborName != "" {
+		return label.Spec.HarborName
+	}
+	return label.Name
+}
+
 func init() {
 	SchemeBuilder.Register(&Label{}, &LabelList{})
 }
@@ -6,9 +6,18 @@ import (
 
 // ProjectSpec defines the desired state of Project.
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.registryRef) ? !has(self.registryRef) : has(self.registryRef) && self.registryRef == oldSelf.registryRef",message="registryRef is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName) || self.harborName == oldSelf.harborName)",message="harborName is immutable"
 type ProjectSpec struct {
 	HarborSpecBase `json:",inline"`
 
+	// HarborName is the name of the project in Harbor. When omitted, it
+	// defaults to metadata.name. Set it for Harbor names that are not valid
+	// Kubernetes names. It cannot be changed after creation.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	HarborName string `json:"harborName,omitempty"`
+
 	// CreationPolicy controls whether the operator creates or adopts the Harbor project.
 	// When omitted, the operator's default creation policy is used.
 	// +kubebuilder:validation:Enum=Create;Adopt;CreateOrAdopt
@@ -81,6 +90,7 @@ type ProjectStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:resource:categories=harbor
 // +kubebuilder:printcolumn:name="Public",type=boolean,JSONPath=`.spec.public`
 // +kubebuilder:printcolumn:name="Registry",type=string,JSONPath=`.spec.registryRef.name`
@@ -107,6 +117,14 @@ type ProjectList struct {
 	Items           []Project `json:"items"`
 }
 
+// HarborName returns the name of the project in Harbor.
+func (project *Project) HarborName() string {
+	if project.Spec.HarborName != "" {
+		return project.Spec.HarborName
+	}
+	return project.Name
+}
+
 func init() {
 	SchemeBuilder.Register(&Project{}, &ProjectList{})
 }
@@ -6,9 +6,18 @@ import (
 
 // RegistrySpec defines the desired state of Registry.
 // +kubebuilder:validation:XValidation:rule="!(has(self.caCertificateRef) && size(self.caCertificate) > 0)",message="caCertificate and caCertificateRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName) || self.harborName == oldSelf.harborName)",message="harborName is immutable"
 type RegistrySpec struct {
 	HarborSpecBase `json:",inline"`
 
+	// HarborName is the name of the registry in Harbor. When omitted, it
+	// defaults to metadata.name. Set it for Harbor names that are not valid
+	// Kubernetes names. It cannot be changed after creation.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	HarborName string `json:"harborName,omitempty"`
+
 	// CreationPolicy controls whether the operator creates or adopts the Harbor registry.
 	// When omitted, the operator's default creation policy is used.
 	// +kubebuilder:validation:Enum=Create;Adopt;CreateOrAdopt
@@ -100,6 +109,14 @@ type RegistryList struct {
 	Items           []Registry `json:"items"`
 }
 
+// HarborName returns the name of the registry in Harbor.
+func (registry *Registry) HarborName() string {
+	if registry.Spec.HarborName != "" {
+		return registry.Spec.HarborName
+	}
+	return registry.Name
+}
+
 func init() {
 	SchemeBuilder.Register(&Registry{}, &RegistryList{})
 }
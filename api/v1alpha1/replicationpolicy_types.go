@@ -44,9 +44,18 @@ type ReplicationFilterSpec struct {
 // +kubebuilder:validation:XValidation:rule="has(self.destinationRegistryRef)",message="destinationRegistryRef is required"
 // +kubebuilder:validation:XValidation:rule="!has(self.trigger) || self.trigger.type != 'scheduled' || (has(self.trigger.settings) && has(self.trigger.settings.cron))",message="trigger.settings.cron must be set when trigger.type is scheduled"
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.sourceRegistryRef) || (has(self.sourceRegistryRef) && self.sourceRegistryRef == oldSelf.sourceRegistryRef && has(self.destinationRegistryRef) && self.destinationRegistryRef == oldSelf.destinationRegistryRef)",message="registry references are immutable; delete and recreate the ReplicationPolicy"
+// +kubebuilder:validation:XValidation:rule="has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName) || self.harborName == oldSelf.harborName)",message="harborName is immutable"
 type ReplicationPolicySpec struct {
 	HarborSpecBase `json:",inline"`
 
+	// HarborName is the name of the replication policy in Harbor. When omitted, it
+	// defaults to metadata.name. Set it for Harbor names that are not valid
+	// Kubernetes names. It cannot be changed after creation.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	HarborName string `json:"harborName,omitempty"`
+
 	// CreationPolicy controls whether the operator creates or adopts the Harbor policy.
 	// When omitted, the operator's default creation policy is used.
 	// +kubebuilder:validation:Enum=Create;Adopt;CreateOrAdopt
@@ -124,6 +133,7 @@ type ReplicationPolicyStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:resource:categories=harbor
 // +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceRegistryRef.name`
 // +kubebuilder:printcolumn:name="Destination",type=string,JSONPath=`.spec.destinationRegistryRef.name`
@@ -151,6 +161,14 @@ type ReplicationPolicyList struct {
 	Items           []ReplicationPolicy `json:"items"`
 }
 
+// HarborName returns the name of the replication policy in Harbor.
+func (policy *ReplicationPolicy) HarborName() string {
+	if policy.Spec.HarborName != "" {
+		return policy.Spec.HarborName
+	}
+	return policy.Name
+}
+
 func init() {
 	SchemeBuilder.Register(&ReplicationPolicy{}, &ReplicationPolicyList{})
 }
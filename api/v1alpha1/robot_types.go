@@ -97,11 +97,103 @@ type RobotPermission struct {
 	Access []RobotAccess `json:"access"`
 }
 
+// RobotSecretFormat is the format of the Secret a Robot writes its
+// credentials to.
+type RobotSecretFormat string
+
+const (
+	// RobotSecretFormatOpaque writes an Opaque Secret with the robot username
+	// and secret.
+	RobotSecretFormatOpaque RobotSecretFormat = "Opaque"
+	// RobotSecretFormatDockerConfigJSON writes a kubernetes.io/dockerconfigjson
+	// Secret that can be used as an image pull secret. It also keeps the robot
+	// username and secret keys of the Opaque format.
+	RobotSecretFormatDockerConfigJSON RobotSecretFormat = "DockerConfigJSON"
+)
+
+// RobotSecretDistribution copies the robot credentials into the namespaces
+// selected by NamespaceSelector.
+type RobotSecretDistribution struct {
+	// NamespaceSelector selects the namespaces that receive a copy of the
+	// robot credentials. An empty selector matches every namespace.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	// SecretName is the name of the Secret written to each selected namespace.
+	// The copies hold the robot username under "username" and the robot secret
+	// under "secret".
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	SecretName string `json:"secretName"`
+
+	// SecretFormat is the format of the copies. If omitted, it defaults to
+	// Opaque.
+	// +kubebuilder:validation:Enum=Opaque;DockerConfigJSON
+	// +kubebuilder:default=Opaque
+	// +optional
+	SecretFormat RobotSecretFormat `json:"secretFormat,omitempty"`
+}
+
+// RobotRotation schedules rotation of the robot secret. The secret is also
+// rotated once Harbor reports it as expired.
+type RobotRotation struct {
+	// Interval rotates the robot secret when this much time has passed since
+	// status.lastRotatedAt, or right away when the operator has not rotated it
+	// yet, such as after adoption.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// BeforeExpiry rotates the robot secret once, this long before
+	// status.expiresAt. It must be shorter than spec.duration. Harbor keeps
+	// the expiry of the robot when its secret is refreshed, so this does not
+	// prevent the robot from expiring.
+	// +optional
+	BeforeExpiry *metav1.Duration `json:"beforeExpiry,omitempty"`
+}
+
+// RobotWorkloadKind is a kind of workload that can be restarted after the
+// robot secret changes.
+type RobotWorkloadKind string
+
+const (
+	RobotWorkloadKindDeployment  RobotWorkloadKind = "Deployment"
+	RobotWorkloadKindStatefulSet RobotWorkloadKind = "StatefulSet"
+	RobotWorkloadKindDaemonSet   RobotWorkloadKind = "DaemonSet"
+)
+
+// RobotWorkloadSelector selects workloads in the namespace of the Robot.
+type RobotWorkloadSelector struct {
+	// Kind is the kind of the selected workloads.
+	// +kubebuilder:validation:Enum=Deployment;StatefulSet;DaemonSet
+	Kind RobotWorkloadKind `json:"kind"`
+
+	// Selector selects workloads of Kind by their labels.
+	Selector metav1.LabelSelector `json:"selector"`
+}
+
+// RobotRestartedWorkload is a workload the operator restarted after the robot
+// secret changed.
+type RobotRestartedWorkload struct {
+	// Kind is the kind of the workload.
+	Kind RobotWorkloadKind `json:"kind"`
+
+	// Name is the name of the workload in the namespace of the Robot.
+	Name string `json:"name"`
+}
+
 // RobotSpec defines the desired state of Robot.
 // +kubebuilder:validation:XValidation:rule="self.duration == -1 || self.duration > 0",message="duration must be -1 or a positive integer"
+// +kubebuilder:validation:XValidation:rule="has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName) || self.harborName == oldSelf.harborName)",message="harborName is immutable"
 type RobotSpec struct {
 	HarborSpecBase `json:",inline"`
 
+	// HarborName is the name of the robot in Harbor. When omitted, it
+	// defaults to metadata.name. Set it for Harbor names that are not valid
+	// Kubernetes names. It cannot be changed after creation.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	HarborName string `json:"harborName,omitempty"`
+
 	// CreationPolicy controls whether the operator creates or adopts the Harbor robot.
 	// When omitted, the operator's default creation policy is used.
 	// +kubebuilder:validation:Enum=Create;Adopt;CreateOrAdopt
@@ -141,6 +233,47 @@ type RobotSpec struct {
 	// in the same namespace with key "secret".
 	// +optional
 	SecretRef *SecretReference `json:"secretRef,omitempty"`
+
+	// SecretFormat is the format of the Secret referenced by SecretRef.
+	// DockerConfigJSON turns it into an image pull secret for the registry
+	// host of the Harbor connection, under the reserved key ".dockerconfigjson".
+	// If omitted, it defaults to Opaque.
+	// +kubebuilder:validation:Enum=Opaque;DockerConfigJSON
+	// +kubebuilder:default=Opaque
+	// +optional
+	SecretFormat RobotSecretFormat `json:"secretFormat,omitempty"`
+
+	// Distribution copies the robot credentials into every namespace that
+	// matches a label selector, in addition to the Secret in SecretRef.
+	// +optional
+	Distribution *RobotSecretDistribution `json:"distribution,omitempty"`
+
+	// Rotation schedules rotation of the robot secret before it expires.
+	// +optional
+	Rotation *RobotRotation `json:"rotation,omitempty"`
+
+	// RestartWorkloads selects workloads in the namespace of the Robot that
+	// consume the robot secret. When the secret changes, the operator sets the
+	// "harbor.harbor-operator.io/robot-secret-hash" annotation on their pod
+	// template, which rolls their pods. They are restarted at most once every
+	// five minutes.
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	RestartWorkloads []RobotWorkloadSelector `json:"restartWorkloads,omitempty"`
+}
+
+// RobotDistributionStatus reports the copy of the robot credentials in one
+// namespace.
+type RobotDistributionStatus struct {
+	// Namespace is the namespace of the copy.
+	Namespace string `json:"namespace"`
+
+	// Ready reports whether the copy holds the current robot credentials.
+	Ready bool `json:"ready"`
+
+	// Message explains why the copy is not ready.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // RobotStatus defines the observed state of Robot.
@@ -160,6 +293,32 @@ type RobotStatus struct {
 	// ExpiresAt is the expiration time reported by Harbor.
 	// +optional
 	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// NextRotationAt is when the operator rotates the robot secret next,
+	// following spec.rotation and status.expiresAt.
+	// +optional
+	NextRotationAt *metav1.Time `json:"nextRotationAt,omitempty"`
+
+	// Distribution lists the namespaces selected by spec.distribution and
+	// whether each holds a current copy of the robot credentials.
+	// +listType=map
+	// +listMapKey=namespace
+	// +optional
+	Distribution []RobotDistributionStatus `json:"distribution,omitempty"`
+
+	// SecretHash is the SHA-256 hash of the robot secret that the workloads in
+	// spec.restartWorkloads were last restarted for.
+	// +optional
+	SecretHash string `json:"secretHash,omitempty"`
+
+	// RestartedWorkloads lists the workloads restarted the last time the robot
+	// secret changed.
+	// +optional
+	RestartedWorkloads []RobotRestartedWorkload `json:"restartedWorkloads,omitempty"`
+
+	// LastRestartedAt is when the workloads were last restarted.
+	// +optional
+	LastRestartedAt *metav1.Time `json:"lastRestartedAt,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -191,6 +350,14 @@ type RobotList struct {
 	Items           []Robot `json:"items"`
 }
 
+// HarborName returns the name of the robot in Harbor.
+func (robot *Robot) HarborName() string {
+	if robot.Spec.HarborName != "" {
+		return robot.Spec.HarborName
+	}
+	return robot.Name
+}
+
 func init() {
 	SchemeBuilder.Register(&Robot{}, &RobotList{})
 }
@@ -4,9 +4,18 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 // ScannerRegistrationSpec defines the desired state of ScannerRegistration.
 // +kubebuilder:validation:XValidation:rule="!(has(self.accessCredentialSecretRef) && size(self.accessCredential) > 0)",message="accessCredential and accessCredentialSecretRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName) || self.harborName == oldSelf.harborName)",message="harborName is immutable"
 type ScannerRegistrationSpec struct {
 	HarborSpecBase `json:",inline"`
 
+	// HarborName is the name of the scanner registration in Harbor. When omitted, it
+	// defaults to metadata.name. Set it for Harbor names that are not valid
+	// Kubernetes names. It cannot be changed after creation.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	HarborName string `json:"harborName,omitempty"`
+
 	// CreationPolicy controls whether the operator creates or adopts the Harbor scanner registration.
 	// When omitted, the operator's default creation policy is used.
 	// +kubebuilder:validation:Enum=Create;Adopt;CreateOrAdopt
@@ -96,6 +105,14 @@ type ScannerRegistrationList struct {
 	Items           []ScannerRegistration `json:"items"`
 }
 
+// HarborName returns the name of the scanner registration in Harbor.
+func (registration *ScannerRegistration) HarborName() string {
+	if registration.Spec.HarborName != "" {
+		return registration.Spec.HarborName
+	}
+	return registration.Name
+}
+
 func init() {
 	SchemeBuilder.Register(&ScannerRegistration{}, &ScannerRegistrationList{})
 }
@@ -7,9 +7,18 @@ import (
 
 // UserSpec defines the desired state of User.
 // +kubebuilder:validation:XValidation:rule="size(self.passwordSecretRef.name) > 0",message="passwordSecretRef.name is required"
+// +kubebuilder:validation:XValidation:rule="has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName) || self.harborName == oldSelf.harborName)",message="harborName is immutable"
 type UserSpec struct {
 	HarborSpecBase `json:",inline"`
 
+	// HarborName is the name of the user in Harbor. When omitted, it
+	// defaults to metadata.name. Set it for Harbor names that are not valid
+	// Kubernetes names. It cannot be changed after creation.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	HarborName string `json:"harborName,omitempty"`
+
 	// CreationPolicy controls whether the operator creates or adopts the Harbor user.
 	// When omitted, the operator's default creation policy is used.
 	// +kubebuilder:validation:Enum=Create;Adopt;CreateOrAdopt
@@ -67,6 +76,14 @@ type UserList struct {
 	Items           []User `json:"items"`
 }
 
+// HarborName returns the name of the user in Harbor.
+func (user *User) HarborName() string {
+	if user.Spec.HarborName != "" {
+		return user.Spec.HarborName
+	}
+	return user.Name
+}
+
 func init() {
 	SchemeBuilder.Register(&User{}, &UserList{})
 }
@@ -35,9 +35,18 @@ type WebhookTargetSpec struct {
 // WebhookPolicySpec defines the desired state of WebhookPolicy.
 // +kubebuilder:validation:XValidation:rule="has(self.projectRef)",message="projectRef is required"
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.projectRef) || (has(self.projectRef) && self.projectRef == oldSelf.projectRef)",message="projectRef is immutable; delete and recreate the WebhookPolicy"
+// +kubebuilder:validation:XValidation:rule="has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName) || self.harborName == oldSelf.harborName)",message="harborName is immutable"
 type WebhookPolicySpec struct {
 	HarborSpecBase `json:",inline"`
 
+	// HarborName is the name of the webhook policy in Harbor. When omitted, it
+	// defaults to metadata.name. Set it for Harbor names that are not valid
+	// Kubernetes names. It cannot be changed after creation.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	HarborName string `json:"harborName,omitempty"`
+
 	// CreationPolicy controls whether the operator creates or adopts the Harbor webhook policy.
 	// When omitted, the operator's default creation policy is used.
 	// +kubebuilder:validation:Enum=Create;Adopt;CreateOrAdopt
@@ -106,6 +115,14 @@ type WebhookPolicyList struct {
 	Items           []WebhookPolicy `json:"items"`
 }
 
+// HarborName returns the name of the webhook policy in Harbor.
+func (policy *WebhookPolicy) HarborName() string {
+	if policy.Spec.HarborName != "" {
+		return policy.Spec.HarborName
+	}
+	return policy.Name
+}
+
 func init() {
 	SchemeBuilder.Register(&WebhookPolicy{}, &WebhookPolicyList{})
 }
@@ -261,6 +261,37 @@ func (in *ConfigurationValueSource) DeepCopy() *ConfigurationValueSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionHealthCheck) DeepCopyInto(out *ConnectionHealthCheck) {
+	*out = *in
+	out.Interval = in.Interval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionHealthCheck.
+func (in *ConnectionHealthCheck) DeepCopy() *ConnectionHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionLimits) DeepCopyInto(out *ConnectionLimits) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLimits.
+func (in *ConnectionLimits) DeepCopy() *ConnectionLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Credentials) DeepCopyInto(out *Credentials) {
 	*out = *in
@@ -269,7 +300,21 @@ func (in *Credentials) DeepCopyInto(out *Credentials) {
 		*out = new(SecretReference)
 		**out = **in
 	}
-	out.PasswordSecretRef = in.PasswordSecretRef
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.TokenEndpoint != nil {
+		in, out := &in.TokenEndpoint, &out.TokenEndpoint
+		*out = new(TokenEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Credentials.
@@ -282,6 +327,42 @@ func (in *Credentials) DeepCopy() *Credentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftField) DeepCopyInto(out *DriftField) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftField.
+func (in *DriftField) DeepCopy() *DriftField {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftStatus) DeepCopyInto(out *DriftStatus) {
+	*out = *in
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]DriftField, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftStatus.
+func (in *DriftStatus) DeepCopy() *DriftStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCSchedule) DeepCopyInto(out *GCSchedule) {
 	*out = *in
@@ -508,6 +589,21 @@ func (in *HarborConnectionSpec) DeepCopyInto(out *HarborConnectionSpec) {
 		*out = new(SecretReference)
 		**out = **in
 	}
+	if in.ClientCertificateSecretRef != nil {
+		in, out := &in.ClientCertificateSecretRef, &out.ClientCertificateSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(ConnectionHealthCheck)
+		**out = **in
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(ConnectionLimits)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarborConnectionSpec.
@@ -524,6 +620,15 @@ func (in *HarborConnectionSpec) DeepCopy() *HarborConnectionSpec {
 func (in *HarborConnectionStatus) DeepCopyInto(out *HarborConnectionStatus) {
 	*out = *in
 	in.HarborStatusBase.DeepCopyInto(&out.HarborStatusBase)
+	if in.ClientCertificateNotAfter != nil {
+		in, out := &in.ClientCertificateNotAfter, &out.ClientCertificateNotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.Harbor != nil {
+		in, out := &in.Harbor, &out.Harbor
+		*out = new(HarborSystemInfo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarborConnectionStatus.
@@ -576,6 +681,11 @@ func (in *HarborStatusBase) DeepCopyInto(out *HarborStatusBase) {
 		*out = new(HarborConnectionBinding)
 		**out = **in
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(DriftStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarborStatusBase.
@@ -588,6 +698,26 @@ func (in *HarborStatusBase) DeepCopy() *HarborStatusBase {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HarborSystemInfo) DeepCopyInto(out *HarborSystemInfo) {
+	*out = *in
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]HarborFeature, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarborSystemInfo.
+func (in *HarborSystemInfo) DeepCopy() *HarborSystemInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(HarborSystemInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImmutableSelector) DeepCopyInto(out *ImmutableSelector) {
 	*out = *in
@@ -1893,6 +2023,21 @@ func (in *RobotAccess) DeepCopy() *RobotAccess {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotDistributionStatus) DeepCopyInto(out *RobotDistributionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotDistributionStatus.
+func (in *RobotDistributionStatus) DeepCopy() *RobotDistributionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotDistributionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RobotList) DeepCopyInto(out *RobotList) {
 	*out = *in
@@ -1950,6 +2095,62 @@ func (in *RobotPermission) DeepCopy() *RobotPermission {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotRestartedWorkload) DeepCopyInto(out *RobotRestartedWorkload) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotRestartedWorkload.
+func (in *RobotRestartedWorkload) DeepCopy() *RobotRestartedWorkload {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotRestartedWorkload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotRotation) DeepCopyInto(out *RobotRotation) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BeforeExpiry != nil {
+		in, out := &in.BeforeExpiry, &out.BeforeExpiry
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotRotation.
+func (in *RobotRotation) DeepCopy() *RobotRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotSecretDistribution) DeepCopyInto(out *RobotSecretDistribution) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotSecretDistribution.
+func (in *RobotSecretDistribution) DeepCopy() *RobotSecretDistribution {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotSecretDistribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RobotSpec) DeepCopyInto(out *RobotSpec) {
 	*out = *in
@@ -1971,6 +2172,23 @@ func (in *RobotSpec) DeepCopyInto(out *RobotSpec) {
 		*out = new(SecretReference)
 		**out = **in
 	}
+	if in.Distribution != nil {
+		in, out := &in.Distribution, &out.Distribution
+		*out = new(RobotSecretDistribution)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(RobotRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RestartWorkloads != nil {
+		in, out := &in.RestartWorkloads, &out.RestartWorkloads
+		*out = make([]RobotWorkloadSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotSpec.
@@ -1995,6 +2213,24 @@ func (in *RobotStatus) DeepCopyInto(out *RobotStatus) {
 		in, out := &in.ExpiresAt, &out.ExpiresAt
 		*out = (*in).DeepCopy()
 	}
+	if in.NextRotationAt != nil {
+		in, out := &in.NextRotationAt, &out.NextRotationAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Distribution != nil {
+		in, out := &in.Distribution, &out.Distribution
+		*out = make([]RobotDistributionStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.RestartedWorkloads != nil {
+		in, out := &in.RestartedWorkloads, &out.RestartedWorkloads
+		*out = make([]RobotRestartedWorkload, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastRestartedAt != nil {
+		in, out := &in.LastRestartedAt, &out.LastRestartedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotStatus.
@@ -2007,6 +2243,22 @@ func (in *RobotStatus) DeepCopy() *RobotStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotWorkloadSelector) DeepCopyInto(out *RobotWorkloadSelector) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotWorkloadSelector.
+func (in *RobotWorkloadSelector) DeepCopy() *RobotWorkloadSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotWorkloadSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScanAllSchedule) DeepCopyInto(out *ScanAllSchedule) {
 	*out = *in
@@ -2232,6 +2484,27 @@ func (in *SecretReference) DeepCopy() *SecretReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenEndpoint) DeepCopyInto(out *TokenEndpoint) {
+	*out = *in
+	out.ClientSecretRef = in.ClientSecretRef
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenEndpoint.
+func (in *TokenEndpoint) DeepCopy() *TokenEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
@@ -0,0 +1,40 @@
+package v1beta1
+
+// SelectorDecoration selects whether a selector includes or excludes the
+// artifacts whose tag or repository matches its pattern.
+// +kubebuilder:validation:Enum=matches;excludes
+type SelectorDecoration string
+
+const (
+	SelectorDecorationMatches  SelectorDecoration = "matches"
+	SelectorDecorationExcludes SelectorDecoration = "excludes"
+)
+
+// TagSelector selects artifacts by tag.
+type TagSelector struct {
+	// Decoration selects whether matching tags are included or excluded.
+	// Defaults to matches.
+	// +kubebuilder:default=matches
+	// +optional
+	Decoration SelectorDecoration `json:"decoration,omitempty"`
+
+	// Pattern is a doublestar pattern matched against the tag, such as "v*".
+	Pattern string `json:"pattern"`
+
+	// Untagged also selects artifacts without a tag.
+	// +optional
+	Untagged bool `json:"untagged,omitempty"`
+}
+
+// RepositorySelector selects artifacts by repository.
+type RepositorySelector struct {
+	// Decoration selects whether matching repositories are included or excluded.
+	// Defaults to matches.
+	// +kubebuilder:default=matches
+	// +optional
+	Decoration SelectorDecoration `json:"decoration,omitempty"`
+
+	// Pattern is a doublestar pattern matched against the repository name,
+	// such as "team/**".
+	Pattern string `json:"pattern"`
+}
@@ -0,0 +1,128 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// V1alpha1SpecAnnotation holds the v1alpha1 spec of an object whose spec has
+// no exact v1beta1 form, such as a retention rule with a parameter that is not
+// a number. Converting the object back to v1alpha1 restores that spec unless
+// the v1beta1 spec was changed in the meantime.
+const V1alpha1SpecAnnotation = "harbor.harbor-operator.io/v1alpha1-spec"
+
+// preserveSpec records original in the V1alpha1SpecAnnotation of meta when
+// converting it to v1beta1 and back yields roundTripped instead.
+func preserveSpec[S any](meta *metav1.ObjectMeta, original, roundTripped S) error {
+	if equality.Semantic.DeepEqual(original, roundTripped) {
+		return nil
+	}
+	raw, err := json.Marshal(original)
+	if err != nil {
+		return fmt.Errorf("preserve v1alpha1 spec: %w", err)
+	}
+	metav1.SetMetaDataAnnotation(meta, V1alpha1SpecAnnotation, string(raw))
+	return nil
+}
+
+// restoreSpec replaces spec with the v1alpha1 spec recorded by preserveSpec
+// when the v1beta1 spec still converts from it, and removes the annotation.
+func restoreSpec[S any](meta *metav1.ObjectMeta, spec *S, roundTrip func(S) S) error {
+	raw, ok := meta.Annotations[V1alpha1SpecAnnotation]
+	if !ok {
+		return nil
+	}
+	delete(meta.Annotations, V1alpha1SpecAnnotation)
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+	var original S
+	if err := json.Unmarshal([]byte(raw), &original); err != nil {
+		return fmt.Errorf("restore v1alpha1 spec: %w", err)
+	}
+	if equality.Semantic.DeepEqual(roundTrip(original), *spec) {
+		*spec = original
+	}
+	return nil
+}
+
+func formatBool(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
+
+func parseBool(s string) *bool {
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return nil
+	}
+	return &b
+}
+
+func formatInt(n *int64) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.FormatInt(*n, 10)
+}
+
+func parseInt(s string) *int64 {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &n
+}
+
+// rawJSON encodes a string or string slice, which cannot fail.
+func rawJSON[T string | []string](v T) apiextensionsv1.JSON {
+	raw, _ := json.Marshal(v)
+	return apiextensionsv1.JSON{Raw: raw}
+}
+
+// doublestarKind is the only selector kind Harbor supports.
+const doublestarKind = "doublestar"
+
+// untaggedExtras is the selector extras Harbor uses to also select untagged artifacts.
+const untaggedExtras = `{"untagged":true}`
+
+func tagSelectorExtras(untagged bool) string {
+	if untagged {
+		return untaggedExtras
+	}
+	return ""
+}
+
+func untaggedFromExtras(extras string) bool {
+	var parsed struct {
+		Untagged bool `json:"untagged"`
+	}
+	return json.Unmarshal([]byte(extras), &parsed) == nil && parsed.Untagged
+}
+
+// Harbor prefixes the decorations of repository selectors with "repo".
+func repositoryDecorationToV1alpha1(d SelectorDecoration) string {
+	if d == "" {
+		return ""
+	}
+	return "repo" + strings.ToUpper(string(d[:1])) + string(d[1:])
+}
+
+func repositoryDecorationFromV1alpha1(d string) SelectorDecoration {
+	trimmed, ok := strings.CutPrefix(d, "repo")
+	if !ok || trimmed == "" {
+		return SelectorDecoration(d)
+	}
+	return SelectorDecoration(strings.ToLower(trimmed[:1]) + trimmed[1:])
+}
+
+// repositoryScope is the Harbor scope selector key for repositories.
+const repositoryScope = "repository"
@@ -0,0 +1,172 @@
+package v1beta1
+
+import (
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+func TestKindsAreConvertible(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := harborv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add v1alpha1 scheme: %v", err)
+	}
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatalf("add v1beta1 scheme: %v", err)
+	}
+	for _, obj := range []runtime.Object{&Project{}, &Quota{}, &RetentionPolicy{}, &ImmutableTagRule{}, &ReplicationPolicy{}} {
+		ok, err := conversion.IsConvertible(scheme, obj)
+		if err != nil || !ok {
+			t.Fatalf("expected %T to be convertible, got %t, %v", obj, ok, err)
+		}
+	}
+}
+
+func TestProjectConvertsTypedFields(t *testing.T) {
+	beta := &Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "team"},
+		Spec: ProjectSpec{
+			Public: true,
+			Metadata: &ProjectMetadata{
+				AutoScan:                ptr.To(true),
+				PreventVulnerableImages: ptr.To(false),
+				Severity:                VulnerabilitySeverityHigh,
+				ProxySpeedKB:            ptr.To[int64](-1),
+			},
+			CVEAllowlist: &CVEAllowlist{Items: []string{"CVE-2024-3094"}, ExpiresAt: ptr.To(metav1.Unix(1700000000, 0))},
+			StorageLimit: ptr.To(resource.MustParse("10Gi")),
+		},
+	}
+
+	alpha := &harborv1alpha1.Project{}
+	if err := beta.ConvertTo(alpha); err != nil {
+		t.Fatalf("ConvertTo returned error: %v", err)
+	}
+	want := harborv1alpha1.ProjectSpec{
+		Public: true,
+		Metadata: &harborv1alpha1.ProjectMetadata{
+			AutoScan:     "true",
+			PreventVul:   "false",
+			Severity:     "high",
+			ProxySpeedKB: "-1",
+		},
+		CVEAllowlist: &harborv1alpha1.CVEAllowlist{
+			ExpiresAt: 1700000000,
+			Items:     []harborv1alpha1.CVEAllowlistItem{{CveID: "CVE-2024-3094"}},
+		},
+		StorageLimit: 10 << 30,
+	}
+	if !equality.Semantic.DeepEqual(alpha.Spec, want) {
+		t.Fatalf("unexpected v1alpha1 spec:\n got %+v\nwant %+v", alpha.Spec, want)
+	}
+
+	back := &Project{}
+	if err := back.ConvertFrom(alpha); err != nil {
+		t.Fatalf("ConvertFrom returned error: %v", err)
+	}
+	if !equality.Semantic.DeepEqual(back.Spec, beta.Spec) || back.Annotations != nil {
+		t.Fatalf("expected a lossless round trip, got %+v with annotations %v", back.Spec, back.Annotations)
+	}
+}
+
+func TestConversionPreservesSpecsWithoutV1beta1Form(t *testing.T) {
+	alpha := &harborv1alpha1.RetentionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "keep", Namespace: "team", Annotations: map[string]string{"owner": "platform"}},
+		Spec: harborv1alpha1.RetentionPolicySpec{
+			ProjectRef: &harborv1alpha1.ProjectReference{Name: "demo"},
+			Rules: []harborv1alpha1.RetentionRule{{
+				Action:   "retain",
+				Template: "latestPushedK",
+				Params:   map[string]apiextensionsv1.JSON{"latestPushedK": {Raw: []byte(`{"value":10}`)}},
+				TagSelectors: []harborv1alpha1.RetentionSelector{
+					{Kind: "doublestar", Decoration: "matches", Pattern: "**"},
+				},
+				ScopeSelectors: map[string][]harborv1alpha1.RetentionSelector{
+					"repository": {{Kind: "doublestar", Decoration: "repoMatches", Pattern: "**"}},
+				},
+			}},
+			Trigger: &harborv1alpha1.RetentionTrigger{
+				Kind:     "Schedule",
+				Settings: map[string]apiextensionsv1.JSON{"cron": {Raw: []byte(`"0 0 0 * * *"`)}},
+			},
+		},
+	}
+
+	beta := &RetentionPolicy{}
+	if err := beta.ConvertFrom(alpha); err != nil {
+		t.Fatalf("ConvertFrom returned error: %v", err)
+	}
+	rule := beta.Spec.Rules[0]
+	if beta.Spec.Schedule != "0 0 0 * * *" || ptr.Deref(rule.Value, 0) != 10 ||
+		rule.RepositorySelectors[0] != (RepositorySelector{Decoration: SelectorDecorationMatches, Pattern: "**"}) {
+		t.Fatalf("unexpected v1beta1 spec: %+v", beta.Spec)
+	}
+	if _, ok := beta.Annotations[V1alpha1SpecAnnotation]; !ok {
+		t.Fatalf("expected the wrapped parameter to be preserved in an annotation, got %v", beta.Annotations)
+	}
+
+	restored := &harborv1alpha1.RetentionPolicy{}
+	if err := beta.ConvertTo(restored); err != nil {
+		t.Fatalf("ConvertTo returned error: %v", err)
+	}
+	if !equality.Semantic.DeepEqual(restored.Spec, alpha.Spec) {
+		t.Fatalf("expected the original spec to be restored, got %+v", restored.Spec)
+	}
+	if len(restored.Annotations) != 1 || restored.Annotations["owner"] != "platform" {
+		t.Fatalf("expected only the user's annotations, got %v", restored.Annotations)
+	}
+
+	beta.Spec.Rules[0].Value = ptr.To[int64](5)
+	edited := &harborv1alpha1.RetentionPolicy{}
+	if err := beta.ConvertTo(edited); err != nil {
+		t.Fatalf("ConvertTo returned error: %v", err)
+	}
+	if got := string(edited.Spec.Rules[0].Params["latestPushedK"].Raw); got != "5" {
+		t.Fatalf("expected the edited v1beta1 value to win, got %s", got)
+	}
+}
+
+func TestReplicationPolicyConvertsFiltersAndTrigger(t *testing.T) {
+	alpha := &harborv1alpha1.ReplicationPolicy{
+		Spec: harborv1alpha1.ReplicationPolicySpec{
+			Trigger: &harborv1alpha1.ReplicationTriggerSpec{
+				Type:     "scheduled",
+				Settings: &harborv1alpha1.ReplicationTriggerSettings{Cron: "0 0 * * * *"},
+			},
+			Filters: []harborv1alpha1.ReplicationFilterSpec{
+				{Type: "name", Value: apiextensionsv1.JSON{Raw: []byte(`"library/**"`)}},
+				{Type: "tag", Value: apiextensionsv1.JSON{Raw: []byte(`"v*"`)}, Decoration: "excludes"},
+				{Type: "label", Value: apiextensionsv1.JSON{Raw: []byte(`["prod","signed"]`)}, Decoration: "matches"},
+				{Type: "resource", Value: apiextensionsv1.JSON{Raw: []byte(`"image"`)}},
+			},
+		},
+	}
+
+	beta := &ReplicationPolicy{}
+	if err := beta.ConvertFrom(alpha); err != nil {
+		t.Fatalf("ConvertFrom returned error: %v", err)
+	}
+	want := ReplicationPolicySpec{
+		Trigger: &ReplicationTrigger{Type: ReplicationTriggerScheduled, Schedule: "0 0 * * * *"},
+		Filters: &ReplicationFilters{
+			Name:     "library/**",
+			Tag:      &ReplicationTagFilter{Pattern: "v*", Decoration: SelectorDecorationExcludes},
+			Labels:   &ReplicationLabelFilter{Names: []string{"prod", "signed"}, Decoration: SelectorDecorationMatches},
+			Resource: ReplicationResourceImage,
+		},
+	}
+	if !equality.Semantic.DeepEqual(beta.Spec, want) {
+		t.Fatalf("unexpected v1beta1 spec:\n got %+v\nwant %+v", beta.Spec, want)
+	}
+	if beta.Annotations != nil {
+		t.Fatalf("expected a lossless conversion, got annotations %v", beta.Annotations)
+	}
+}
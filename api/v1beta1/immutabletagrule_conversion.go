@@ -0,0 +1,89 @@
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// The action and template Harbor uses for every immutable tag rule.
+const (
+	immutableAction   = "immutable"
+	immutableTemplate = "immutable_template"
+)
+
+// ConvertTo converts this ImmutableTagRule to the hub version.
+func (src *ImmutableTagRule) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*harborv1alpha1.ImmutableTagRule)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = immutableTagRuleSpecToV1alpha1(src.Spec)
+	dst.Status = *src.Status.DeepCopy()
+	return restoreSpec(&dst.ObjectMeta, &dst.Spec, func(spec harborv1alpha1.ImmutableTagRuleSpec) harborv1alpha1.ImmutableTagRuleSpec {
+		return immutableTagRuleSpecToV1alpha1(immutableTagRuleSpecFromV1alpha1(spec))
+	})
+}
+
+// ConvertFrom converts the hub version to this ImmutableTagRule.
+func (dst *ImmutableTagRule) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*harborv1alpha1.ImmutableTagRule)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = immutableTagRuleSpecFromV1alpha1(src.Spec)
+	dst.Status = *src.Status.DeepCopy()
+	return preserveSpec(&dst.ObjectMeta, src.Spec, immutableTagRuleSpecToV1alpha1(dst.Spec))
+}
+
+func immutableTagRuleSpecToV1alpha1(in ImmutableTagRuleSpec) harborv1alpha1.ImmutableTagRuleSpec {
+	out := harborv1alpha1.ImmutableTagRuleSpec{
+		HarborSpecBase: *in.HarborSpecBase.DeepCopy(),
+		CreationPolicy: in.CreationPolicy,
+		ProjectRef:     in.ProjectRef.DeepCopy(),
+		Disabled:       in.Disabled,
+		Action:         immutableAction,
+		Template:       immutableTemplate,
+		Priority:       in.Priority,
+	}
+	for _, sel := range in.TagSelectors {
+		out.TagSelectors = append(out.TagSelectors, harborv1alpha1.ImmutableSelector{
+			Kind:       doublestarKind,
+			Decoration: string(sel.Decoration),
+			Pattern:    sel.Pattern,
+			Extras:     tagSelectorExtras(sel.Untagged),
+		})
+	}
+	if len(in.RepositorySelectors) > 0 {
+		var repos []harborv1alpha1.ImmutableSelector
+		for _, sel := range in.RepositorySelectors {
+			repos = append(repos, harborv1alpha1.ImmutableSelector{
+				Kind:       doublestarKind,
+				Decoration: repositoryDecorationToV1alpha1(sel.Decoration),
+				Pattern:    sel.Pattern,
+			})
+		}
+		out.ScopeSelectors = map[string][]harborv1alpha1.ImmutableSelector{repositoryScope: repos}
+	}
+	return out
+}
+
+func immutableTagRuleSpecFromV1alpha1(in harborv1alpha1.ImmutableTagRuleSpec) ImmutableTagRuleSpec {
+	out := ImmutableTagRuleSpec{
+		HarborSpecBase: *in.HarborSpecBase.DeepCopy(),
+		CreationPolicy: in.CreationPolicy,
+		ProjectRef:     in.ProjectRef.DeepCopy(),
+		Disabled:       in.Disabled,
+		Priority:       in.Priority,
+	}
+	for _, sel := range in.TagSelectors {
+		out.TagSelectors = append(out.TagSelectors, TagSelector{
+			Decoration: SelectorDecoration(sel.Decoration),
+			Pattern:    sel.Pattern,
+			Untagged:   untaggedFromExtras(sel.Extras),
+		})
+	}
+	for _, sel := range in.ScopeSelectors[repositoryScope] {
+		out.RepositorySelectors = append(out.RepositorySelectors, RepositorySelector{
+			Decoration: repositoryDecorationFromV1alpha1(sel.Decoration),
+			Pattern:    sel.Pattern,
+		})
+	}
+	return out
+}
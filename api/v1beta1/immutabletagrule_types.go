@@ -0,0 +1,73 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// ImmutableTagRuleSpec defines the desired state of ImmutableTagRule.
+// +kubebuilder:validation:XValidation:rule="has(self.projectRef)",message="projectRef is required"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.projectRef) || (has(self.projectRef) && self.projectRef == oldSelf.projectRef)",message="projectRef is immutable; delete and recreate the ImmutableTagRule"
+type ImmutableTagRuleSpec struct {
+	harborv1alpha1.HarborSpecBase `json:",inline"`
+
+	// CreationPolicy controls whether the operator creates or adopts the Harbor rule.
+	// When omitted, the operator's default creation policy is used.
+	// +kubebuilder:validation:Enum=Create;Adopt;CreateOrAdopt
+	// +optional
+	CreationPolicy harborv1alpha1.CreationPolicy `json:"creationPolicy,omitempty"`
+
+	// ProjectRef references a Project CR to derive the Harbor project ID.
+	// +optional
+	ProjectRef *harborv1alpha1.ProjectReference `json:"projectRef,omitempty"`
+
+	// Disabled indicates whether the rule is disabled. Defaults to false.
+	// +kubebuilder:default=false
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// TagSelectors select the tags that become immutable.
+	// +optional
+	TagSelectors []TagSelector `json:"tagSelectors,omitempty"`
+
+	// RepositorySelectors select the repositories the rule applies to.
+	// +optional
+	RepositorySelectors []RepositorySelector `json:"repositorySelectors,omitempty"`
+
+	// Priority defines the rule priority.
+	// +optional
+	Priority int `json:"priority,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=harbor
+// +kubebuilder:printcolumn:name="Project",type=string,JSONPath=`.spec.projectRef.name`
+// +kubebuilder:printcolumn:name="Disabled",type=boolean,JSONPath=`.spec.disabled`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ImmutableTagRule is the Schema for the immutabletagrules API.
+type ImmutableTagRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImmutableTagRuleSpec                  `json:"spec,omitempty"`
+	Status harborv1alpha1.ImmutableTagRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImmutableTagRuleList contains a list of ImmutableTagRule.
+type ImmutableTagRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImmutableTagRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ImmutableTagRule{}, &ImmutableTagRuleList{})
+}
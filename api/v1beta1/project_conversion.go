@@ -0,0 +1,104 @@
+package v1beta1
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// ConvertTo converts this Project to the hub version.
+func (src *Project) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*harborv1alpha1.Project)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = projectSpecToV1alpha1(src.Spec)
+	dst.Status = *src.Status.DeepCopy()
+	return restoreSpec(&dst.ObjectMeta, &dst.Spec, func(spec harborv1alpha1.ProjectSpec) harborv1alpha1.ProjectSpec {
+		return projectSpecToV1alpha1(projectSpecFromV1alpha1(spec))
+	})
+}
+
+// ConvertFrom converts the hub version to this Project.
+func (dst *Project) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*harborv1alpha1.Project)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = projectSpecFromV1alpha1(src.Spec)
+	dst.Status = *src.Status.DeepCopy()
+	return preserveSpec(&dst.ObjectMeta, src.Spec, projectSpecToV1alpha1(dst.Spec))
+}
+
+func projectSpecToV1alpha1(in ProjectSpec) harborv1alpha1.ProjectSpec {
+	out := harborv1alpha1.ProjectSpec{
+		HarborSpecBase: *in.HarborSpecBase.DeepCopy(),
+		HarborName:     in.HarborName,
+		CreationPolicy: in.CreationPolicy,
+		Public:         in.Public,
+		Owner:          in.Owner,
+		RegistryRef:    in.RegistryRef.DeepCopy(),
+	}
+	if m := in.Metadata; m != nil {
+		out.Metadata = &harborv1alpha1.ProjectMetadata{
+			EnableContentTrust:       formatBool(m.EnableContentTrust),
+			EnableContentTrustCosign: formatBool(m.EnableContentTrustCosign),
+			PreventVul:               formatBool(m.PreventVulnerableImages),
+			Severity:                 string(m.Severity),
+			AutoScan:                 formatBool(m.AutoScan),
+			AutoSBOMGeneration:       formatBool(m.AutoSBOMGeneration),
+			ReuseSysCVEAllowlist:     formatBool(m.ReuseSystemCVEAllowlist),
+			ProxySpeedKB:             formatInt(m.ProxySpeedKB),
+		}
+	}
+	if a := in.CVEAllowlist; a != nil {
+		out.CVEAllowlist = &harborv1alpha1.CVEAllowlist{}
+		for _, id := range a.Items {
+			out.CVEAllowlist.Items = append(out.CVEAllowlist.Items, harborv1alpha1.CVEAllowlistItem{CveID: id})
+		}
+		if a.ExpiresAt != nil {
+			out.CVEAllowlist.ExpiresAt = int(a.ExpiresAt.Unix())
+		}
+	}
+	if in.StorageLimit != nil {
+		out.StorageLimit = int(in.StorageLimit.Value())
+	}
+	return out
+}
+
+func projectSpecFromV1alpha1(in harborv1alpha1.ProjectSpec) ProjectSpec {
+	out := ProjectSpec{
+		HarborSpecBase: *in.HarborSpecBase.DeepCopy(),
+		HarborName:     in.HarborName,
+		CreationPolicy: in.CreationPolicy,
+		Public:         in.Public,
+		Owner:          in.Owner,
+		RegistryRef:    in.RegistryRef.DeepCopy(),
+	}
+	if m := in.Metadata; m != nil {
+		out.Metadata = &ProjectMetadata{
+			EnableContentTrust:       parseBool(m.EnableContentTrust),
+			EnableContentTrustCosign: parseBool(m.EnableContentTrustCosign),
+			PreventVulnerableImages:  parseBool(m.PreventVul),
+			Severity:                 VulnerabilitySeverity(m.Severity),
+			AutoScan:                 parseBool(m.AutoScan),
+			AutoSBOMGeneration:       parseBool(m.AutoSBOMGeneration),
+			ReuseSystemCVEAllowlist:  parseBool(m.ReuseSysCVEAllowlist),
+			ProxySpeedKB:             parseInt(m.ProxySpeedKB),
+		}
+	}
+	if a := in.CVEAllowlist; a != nil {
+		out.CVEAllowlist = &CVEAllowlist{}
+		for _, item := range a.Items {
+			out.CVEAllowlist.Items = append(out.CVEAllowlist.Items, item.CveID)
+		}
+		if a.ExpiresAt != 0 {
+			expiresAt := metav1.NewTime(time.Unix(int64(a.ExpiresAt), 0))
+			out.CVEAllowlist.ExpiresAt = &expiresAt
+		}
+	}
+	if in.StorageLimit != 0 {
+		out.StorageLimit = resource.NewQuantity(int64(in.StorageLimit), resource.BinarySI)
+	}
+	return out
+}
@@ -0,0 +1,148 @@
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// VulnerabilitySeverity is a vulnerability severity level reported by Harbor's scanners.
+// +kubebuilder:validation:Enum=none;low;medium;high;critical
+type VulnerabilitySeverity string
+
+const (
+	VulnerabilitySeverityNone     VulnerabilitySeverity = "none"
+	VulnerabilitySeverityLow      VulnerabilitySeverity = "low"
+	VulnerabilitySeverityMedium   VulnerabilitySeverity = "medium"
+	VulnerabilitySeverityHigh     VulnerabilitySeverity = "high"
+	VulnerabilitySeverityCritical VulnerabilitySeverity = "critical"
+)
+
+// ProjectSpec defines the desired state of Project.
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.registryRef) ? !has(self.registryRef) : has(self.registryRef) && self.registryRef == oldSelf.registryRef",message="registryRef is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName) || self.harborName == oldSelf.harborName)",message="harborName is immutable"
+type ProjectSpec struct {
+	harborv1alpha1.HarborSpecBase `json:",inline"`
+
+	// HarborName is the name of the project in Harbor. When omitted, it
+	// defaults to metadata.name. Set it for Harbor names that are not valid
+	// Kubernetes names. It cannot be changed after creation.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	HarborName string `json:"harborName,omitempty"`
+
+	// CreationPolicy controls whether the operator creates or adopts the Harbor project.
+	// When omitted, the operator's default creation policy is used.
+	// +kubebuilder:validation:Enum=Create;Adopt;CreateOrAdopt
+	// +optional
+	CreationPolicy harborv1alpha1.CreationPolicy `json:"creationPolicy,omitempty"`
+
+	// Public indicates whether the project is public.
+	Public bool `json:"public"`
+
+	// Owner is an optional field for the project owner.
+	// +optional
+	Owner string `json:"owner,omitempty"`
+
+	// Metadata holds additional configuration for the Harbor project.
+	// Settings that are omitted are left for Harbor to default.
+	// +optional
+	Metadata *ProjectMetadata `json:"metadata,omitempty"`
+
+	// CVEAllowlist lists the CVEs that do not prevent pulling vulnerable images.
+	// +optional
+	CVEAllowlist *CVEAllowlist `json:"cveAllowlist,omitempty"`
+
+	// StorageLimit is the storage quota of the project, such as 10Gi.
+	// -1 means unlimited. When omitted, Harbor's default applies.
+	// +optional
+	StorageLimit *resource.Quantity `json:"storageLimit,omitempty"`
+
+	// RegistryRef references the Registry to use for proxy cache projects.
+	// +optional
+	RegistryRef *harborv1alpha1.RegistryReference `json:"registryRef,omitempty"`
+}
+
+// ProjectMetadata defines additional settings for the project.
+type ProjectMetadata struct {
+	// EnableContentTrust only allows pulling signed images.
+	// +optional
+	EnableContentTrust *bool `json:"enableContentTrust,omitempty"`
+
+	// EnableContentTrustCosign only allows pulling images signed by cosign.
+	// +optional
+	EnableContentTrustCosign *bool `json:"enableContentTrustCosign,omitempty"`
+
+	// PreventVulnerableImages prevents pulling images with vulnerabilities of
+	// at least Severity.
+	// +optional
+	PreventVulnerableImages *bool `json:"preventVulnerableImages,omitempty"`
+
+	// Severity is the lowest severity that PreventVulnerableImages blocks.
+	// +optional
+	Severity VulnerabilitySeverity `json:"severity,omitempty"`
+
+	// AutoScan scans images when they are pushed.
+	// +optional
+	AutoScan *bool `json:"autoScan,omitempty"`
+
+	// AutoSBOMGeneration generates an SBOM when images are pushed.
+	// +optional
+	AutoSBOMGeneration *bool `json:"autoSBOMGeneration,omitempty"`
+
+	// ReuseSystemCVEAllowlist uses the system CVE allowlist instead of the
+	// project's CVEAllowlist.
+	// +optional
+	ReuseSystemCVEAllowlist *bool `json:"reuseSystemCVEAllowlist,omitempty"`
+
+	// ProxySpeedKB limits the bandwidth of a proxy cache project in KB/s.
+	// -1 means unlimited.
+	// +kubebuilder:validation:Minimum=-1
+	// +optional
+	ProxySpeedKB *int64 `json:"proxySpeedKB,omitempty"`
+}
+
+// CVEAllowlist defines the CVE allowlist of a project.
+type CVEAllowlist struct {
+	// Items are the allowed CVE IDs, such as CVE-2024-3094.
+	// +optional
+	Items []string `json:"items,omitempty"`
+
+	// ExpiresAt is when the allowlist stops applying. When omitted, it never expires.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=harbor
+// +kubebuilder:printcolumn:name="Public",type=boolean,JSONPath=`.spec.public`
+// +kubebuilder:printcolumn:name="Registry",type=string,JSONPath=`.spec.registryRef.name`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Project is the Schema for the projects API.
+type Project struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectSpec                  `json:"spec,omitempty"`
+	Status harborv1alpha1.ProjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectList contains a list of Project.
+type ProjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Project `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Project{}, &ProjectList{})
+}
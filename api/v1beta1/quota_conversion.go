@@ -0,0 +1,56 @@
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// ConvertTo converts this Quota to the hub version.
+func (src *Quota) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*harborv1alpha1.Quota)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = quotaSpecToV1alpha1(src.Spec)
+	dst.Status = *src.Status.DeepCopy()
+	return restoreSpec(&dst.ObjectMeta, &dst.Spec, func(spec harborv1alpha1.QuotaSpec) harborv1alpha1.QuotaSpec {
+		return quotaSpecToV1alpha1(quotaSpecFromV1alpha1(spec))
+	})
+}
+
+// ConvertFrom converts the hub version to this Quota.
+func (dst *Quota) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*harborv1alpha1.Quota)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = quotaSpecFromV1alpha1(src.Spec)
+	dst.Status = *src.Status.DeepCopy()
+	return preserveSpec(&dst.ObjectMeta, src.Spec, quotaSpecToV1alpha1(dst.Spec))
+}
+
+func quotaSpecToV1alpha1(in QuotaSpec) harborv1alpha1.QuotaSpec {
+	out := harborv1alpha1.QuotaSpec{
+		HarborSpecBase: *in.HarborSpecBase.DeepCopy(),
+		ProjectRef:     in.ProjectRef.DeepCopy(),
+	}
+	if in.Hard != nil {
+		out.Hard = make(map[string]int64, len(in.Hard))
+		for name, limit := range in.Hard {
+			out.Hard[name] = limit.Value()
+		}
+	}
+	return out
+}
+
+func quotaSpecFromV1alpha1(in harborv1alpha1.QuotaSpec) QuotaSpec {
+	out := QuotaSpec{
+		HarborSpecBase: *in.HarborSpecBase.DeepCopy(),
+		ProjectRef:     in.ProjectRef.DeepCopy(),
+	}
+	if in.Hard != nil {
+		out.Hard = make(map[string]resource.Quantity, len(in.Hard))
+		for name, limit := range in.Hard {
+			out.Hard[name] = *resource.NewQuantity(limit, resource.BinarySI)
+		}
+	}
+	return out
+}
@@ -0,0 +1,55 @@
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// QuotaSpec defines the desired state of Quota.
+// +kubebuilder:validation:XValidation:rule="has(self.projectRef)",message="projectRef is required"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.projectRef) || (has(self.projectRef) && self.projectRef == oldSelf.projectRef)",message="projectRef is immutable; delete and recreate the Quota"
+type QuotaSpec struct {
+	harborv1alpha1.HarborSpecBase `json:",inline"`
+
+	// ProjectRef references a Project CR to derive the Harbor project ID.
+	// +optional
+	ProjectRef *harborv1alpha1.ProjectReference `json:"projectRef,omitempty"`
+
+	// Hard defines the quota hard limits by resource name, such as
+	// storage: 10Gi. -1 means unlimited.
+	// +optional
+	Hard map[string]resource.Quantity `json:"hard,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=harbor
+// +kubebuilder:printcolumn:name="Project",type=string,JSONPath=`.spec.projectRef.name`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Quota is the Schema for the quotas API.
+type Quota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QuotaSpec                  `json:"spec,omitempty"`
+	Status harborv1alpha1.QuotaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// QuotaList contains a list of Quota.
+type QuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Quota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Quota{}, &QuotaList{})
+}
@@ -0,0 +1,153 @@
+package v1beta1
+
+import (
+	"encoding/json"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// replicationTriggerTypes maps v1beta1 trigger types to Harbor's.
+var replicationTriggerTypes = map[ReplicationTriggerType]string{
+	ReplicationTriggerManual:     "manual",
+	ReplicationTriggerEventBased: "event_based",
+	ReplicationTriggerScheduled:  "scheduled",
+}
+
+// The Harbor replication filter types.
+const (
+	replicationFilterName     = "name"
+	replicationFilterTag      = "tag"
+	replicationFilterLabel    = "label"
+	replicationFilterResource = "resource"
+)
+
+// ConvertTo converts this ReplicationPolicy to the hub version.
+func (src *ReplicationPolicy) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*harborv1alpha1.ReplicationPolicy)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = replicationSpecToV1alpha1(src.Spec)
+	dst.Status = *src.Status.DeepCopy()
+	return restoreSpec(&dst.ObjectMeta, &dst.Spec, func(spec harborv1alpha1.ReplicationPolicySpec) harborv1alpha1.ReplicationPolicySpec {
+		return replicationSpecToV1alpha1(replicationSpecFromV1alpha1(spec))
+	})
+}
+
+// ConvertFrom converts the hub version to this ReplicationPolicy.
+func (dst *ReplicationPolicy) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*harborv1alpha1.ReplicationPolicy)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = replicationSpecFromV1alpha1(src.Spec)
+	dst.Status = *src.Status.DeepCopy()
+	return preserveSpec(&dst.ObjectMeta, src.Spec, replicationSpecToV1alpha1(dst.Spec))
+}
+
+func replicationSpecToV1alpha1(in ReplicationPolicySpec) harborv1alpha1.ReplicationPolicySpec {
+	in = *in.DeepCopy()
+	out := harborv1alpha1.ReplicationPolicySpec{
+		HarborSpecBase:            in.HarborSpecBase,
+		HarborName:                in.HarborName,
+		CreationPolicy:            in.CreationPolicy,
+		Description:               in.Description,
+		SourceRegistryRef:         in.SourceRegistryRef,
+		DestinationRegistryRef:    in.DestinationRegistryRef,
+		DestNamespace:             in.DestNamespace,
+		DestNamespaceReplaceCount: in.DestNamespaceReplaceCount,
+		ReplicateDeletion:         in.ReplicateDeletion,
+		Override:                  in.Override,
+		Enabled:                   in.Enabled,
+		Speed:                     in.Speed,
+		CopyByChunk:               in.CopyByChunk,
+		SingleActiveReplication:   in.SingleActiveReplication,
+	}
+	if t := in.Trigger; t != nil {
+		out.Trigger = &harborv1alpha1.ReplicationTriggerSpec{Type: replicationTriggerTypes[t.Type]}
+		if out.Trigger.Type == "" {
+			out.Trigger.Type = string(t.Type)
+		}
+		if t.Schedule != "" {
+			out.Trigger.Settings = &harborv1alpha1.ReplicationTriggerSettings{Cron: t.Schedule}
+		}
+	}
+	if f := in.Filters; f != nil {
+		if f.Name != "" {
+			out.Filters = append(out.Filters, harborv1alpha1.ReplicationFilterSpec{
+				Type:  replicationFilterName,
+				Value: rawJSON(f.Name),
+			})
+		}
+		if f.Tag != nil {
+			out.Filters = append(out.Filters, harborv1alpha1.ReplicationFilterSpec{
+				Type:       replicationFilterTag,
+				Value:      rawJSON(f.Tag.Pattern),
+				Decoration: string(f.Tag.Decoration),
+			})
+		}
+		if f.Labels != nil {
+			out.Filters = append(out.Filters, harborv1alpha1.ReplicationFilterSpec{
+				Type:       replicationFilterLabel,
+				Value:      rawJSON(f.Labels.Names),
+				Decoration: string(f.Labels.Decoration),
+			})
+		}
+		if f.Resource != "" {
+			out.Filters = append(out.Filters, harborv1alpha1.ReplicationFilterSpec{
+				Type:  replicationFilterResource,
+				Value: rawJSON(string(f.Resource)),
+			})
+		}
+	}
+	return out
+}
+
+func replicationSpecFromV1alpha1(in harborv1alpha1.ReplicationPolicySpec) ReplicationPolicySpec {
+	in = *in.DeepCopy()
+	out := ReplicationPolicySpec{
+		HarborSpecBase:            in.HarborSpecBase,
+		HarborName:                in.HarborName,
+		CreationPolicy:            in.CreationPolicy,
+		Description:               in.Description,
+		SourceRegistryRef:         in.SourceRegistryRef,
+		DestinationRegistryRef:    in.DestinationRegistryRef,
+		DestNamespace:             in.DestNamespace,
+		DestNamespaceReplaceCount: in.DestNamespaceReplaceCount,
+		ReplicateDeletion:         in.ReplicateDeletion,
+		Override:                  in.Override,
+		Enabled:                   in.Enabled,
+		Speed:                     in.Speed,
+		CopyByChunk:               in.CopyByChunk,
+		SingleActiveReplication:   in.SingleActiveReplication,
+	}
+	if t := in.Trigger; t != nil {
+		out.Trigger = &ReplicationTrigger{Type: ReplicationTriggerType(t.Type)}
+		for beta, alpha := range replicationTriggerTypes {
+			if alpha == t.Type {
+				out.Trigger.Type = beta
+			}
+		}
+		if t.Settings != nil {
+			out.Trigger.Schedule = t.Settings.Cron
+		}
+	}
+	if len(in.Filters) > 0 {
+		out.Filters = &ReplicationFilters{}
+		for _, f := range in.Filters {
+			switch f.Type {
+			case replicationFilterName:
+				_ = json.Unmarshal(f.Value.Raw, &out.Filters.Name)
+			case replicationFilterTag:
+				out.Filters.Tag = &ReplicationTagFilter{Decoration: SelectorDecoration(f.Decoration)}
+				_ = json.Unmarshal(f.Value.Raw, &out.Filters.Tag.Pattern)
+			case replicationFilterLabel:
+				out.Filters.Labels = &ReplicationLabelFilter{Decoration: SelectorDecoration(f.Decoration)}
+				_ = json.Unmarshal(f.Value.Raw, &out.Filters.Labels.Names)
+			case replicationFilterResource:
+				var resource string
+				_ = json.Unmarshal(f.Value.Raw, &resource)
+				out.Filters.Resource = ReplicationResourceType(resource)
+			}
+		}
+	}
+	return out
+}
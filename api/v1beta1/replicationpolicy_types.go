@@ -0,0 +1,199 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// ReplicationTriggerType selects when a replication policy runs.
+// +kubebuilder:validation:Enum=Manual;EventBased;Scheduled
+type ReplicationTriggerType string
+
+const (
+	ReplicationTriggerManual     ReplicationTriggerType = "Manual"
+	ReplicationTriggerEventBased ReplicationTriggerType = "EventBased"
+	ReplicationTriggerScheduled  ReplicationTriggerType = "Scheduled"
+)
+
+// ReplicationResourceType selects the kind of artifacts a policy replicates.
+// +kubebuilder:validation:Enum=image;artifact
+type ReplicationResourceType string
+
+const (
+	ReplicationResourceImage    ReplicationResourceType = "image"
+	ReplicationResourceArtifact ReplicationResourceType = "artifact"
+)
+
+// ReplicationTrigger defines when the replication policy runs.
+// +kubebuilder:validation:XValidation:rule="self.type != 'Scheduled' || size(self.schedule) > 0",message="schedule must be set when type is Scheduled"
+type ReplicationTrigger struct {
+	// Type selects when the policy runs.
+	Type ReplicationTriggerType `json:"type"`
+
+	// Schedule is the six-field cron expression for Scheduled triggers.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+}
+
+// ReplicationFilters select the artifacts a policy replicates. Artifacts
+// must match every filter that is set.
+type ReplicationFilters struct {
+	// Name is a doublestar pattern matched against the repository name,
+	// such as "library/**".
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Tag selects artifacts by tag.
+	// +optional
+	Tag *ReplicationTagFilter `json:"tag,omitempty"`
+
+	// Labels selects artifacts by their Harbor labels.
+	// +optional
+	Labels *ReplicationLabelFilter `json:"labels,omitempty"`
+
+	// Resource selects the kind of artifacts to replicate.
+	// +optional
+	Resource ReplicationResourceType `json:"resource,omitempty"`
+}
+
+// ReplicationTagFilter selects artifacts by tag.
+type ReplicationTagFilter struct {
+	// Pattern is a doublestar pattern matched against the tag.
+	Pattern string `json:"pattern"`
+
+	// Decoration selects whether matching tags are included or excluded.
+	// Defaults to matches.
+	// +kubebuilder:default=matches
+	// +optional
+	Decoration SelectorDecoration `json:"decoration,omitempty"`
+}
+
+// ReplicationLabelFilter selects artifacts by their Harbor labels.
+type ReplicationLabelFilter struct {
+	// Names are the label names an artifact must carry.
+	// +kubebuilder:validation:MinItems=1
+	Names []string `json:"names"`
+
+	// Decoration selects whether matching artifacts are included or excluded.
+	// Defaults to matches.
+	// +kubebuilder:default=matches
+	// +optional
+	Decoration SelectorDecoration `json:"decoration,omitempty"`
+}
+
+// ReplicationPolicySpec defines the desired state of ReplicationPolicy.
+// +kubebuilder:validation:XValidation:rule="has(self.sourceRegistryRef)",message="sourceRegistryRef is required"
+// +kubebuilder:validation:XValidation:rule="has(self.destinationRegistryRef)",message="destinationRegistryRef is required"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.sourceRegistryRef) || (has(self.sourceRegistryRef) && self.sourceRegistryRef == oldSelf.sourceRegistryRef && has(self.destinationRegistryRef) && self.destinationRegistryRef == oldSelf.destinationRegistryRef)",message="registry references are immutable; delete and recreate the ReplicationPolicy"
+// +kubebuilder:validation:XValidation:rule="has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName) || self.harborName == oldSelf.harborName)",message="harborName is immutable"
+type ReplicationPolicySpec struct {
+	harborv1alpha1.HarborSpecBase `json:",inline"`
+
+	// HarborName is the name of the replication policy in Harbor. When omitted, it
+	// defaults to metadata.name. Set it for Harbor names that are not valid
+	// Kubernetes names. It cannot be changed after creation.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	HarborName string `json:"harborName,omitempty"`
+
+	// CreationPolicy controls whether the operator creates or adopts the Harbor policy.
+	// When omitted, the operator's default creation policy is used.
+	// +kubebuilder:validation:Enum=Create;Adopt;CreateOrAdopt
+	// +optional
+	CreationPolicy harborv1alpha1.CreationPolicy `json:"creationPolicy,omitempty"`
+
+	// Description is an optional policy description.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// SourceRegistryRef references a Registry CR to use as the source.
+	// +optional
+	SourceRegistryRef *harborv1alpha1.RegistryReference `json:"sourceRegistryRef,omitempty"`
+
+	// DestinationRegistryRef references a Registry CR to use as the destination.
+	// +optional
+	DestinationRegistryRef *harborv1alpha1.RegistryReference `json:"destinationRegistryRef,omitempty"`
+
+	// DestNamespace is the destination namespace.
+	// +optional
+	DestNamespace string `json:"destNamespace,omitempty"`
+
+	// DestNamespaceReplaceCount controls how many path components are replaced by DestNamespace.
+	// Defaults to -1, which selects Harbor's legacy replacement behavior.
+	// +kubebuilder:default=-1
+	// +optional
+	DestNamespaceReplaceCount *int `json:"destNamespaceReplaceCount,omitempty"`
+
+	// Trigger defines when the replication policy runs.
+	// +optional
+	Trigger *ReplicationTrigger `json:"trigger,omitempty"`
+
+	// Filters select the artifacts to replicate.
+	// +optional
+	Filters *ReplicationFilters `json:"filters,omitempty"`
+
+	// ReplicateDeletion indicates whether delete operations are replicated.
+	// When omitted, the operator leaves this field unset for Harbor to interpret.
+	// +optional
+	ReplicateDeletion *bool `json:"replicateDeletion,omitempty"`
+
+	// Override indicates whether to overwrite destination resources.
+	// When omitted, the operator leaves this field unset for Harbor to interpret.
+	// +optional
+	Override *bool `json:"override,omitempty"`
+
+	// Enabled indicates whether the policy is enabled.
+	// When omitted, the operator leaves this field unset for Harbor to interpret.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Speed is the speed limit for each task.
+	// When omitted, the operator leaves this field unset for Harbor to interpret.
+	// +optional
+	Speed *int `json:"speed,omitempty"`
+
+	// CopyByChunk indicates whether to enable copy by chunk.
+	// When omitted, the operator leaves this field unset for Harbor to interpret.
+	// +optional
+	CopyByChunk *bool `json:"copyByChunk,omitempty"`
+
+	// SingleActiveReplication avoids overlapping executions.
+	// When omitted, the operator leaves this field unset for Harbor to interpret.
+	// +optional
+	SingleActiveReplication *bool `json:"singleActiveReplication,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=harbor
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceRegistryRef.name`
+// +kubebuilder:printcolumn:name="Destination",type=string,JSONPath=`.spec.destinationRegistryRef.name`
+// +kubebuilder:printcolumn:name="Enabled",type=boolean,JSONPath=`.spec.enabled`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ReplicationPolicy is the Schema for the replicationpolicies API.
+type ReplicationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReplicationPolicySpec                  `json:"spec,omitempty"`
+	Status harborv1alpha1.ReplicationPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReplicationPolicyList contains a list of ReplicationPolicy.
+type ReplicationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicationPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReplicationPolicy{}, &ReplicationPolicyList{})
+}
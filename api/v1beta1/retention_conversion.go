@@ -0,0 +1,132 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"strconv"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// retainAction is the only action Harbor supports for retention rules.
+const retainAction = "retain"
+
+// ConvertTo converts this RetentionPolicy to the hub version.
+func (src *RetentionPolicy) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*harborv1alpha1.RetentionPolicy)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = retentionSpecToV1alpha1(src.Spec)
+	dst.Status = *src.Status.DeepCopy()
+	return restoreSpec(&dst.ObjectMeta, &dst.Spec, func(spec harborv1alpha1.RetentionPolicySpec) harborv1alpha1.RetentionPolicySpec {
+		return retentionSpecToV1alpha1(retentionSpecFromV1alpha1(spec))
+	})
+}
+
+// ConvertFrom converts the hub version to this RetentionPolicy.
+func (dst *RetentionPolicy) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*harborv1alpha1.RetentionPolicy)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = retentionSpecFromV1alpha1(src.Spec)
+	dst.Status = *src.Status.DeepCopy()
+	return preserveSpec(&dst.ObjectMeta, src.Spec, retentionSpecToV1alpha1(dst.Spec))
+}
+
+func retentionSpecToV1alpha1(in RetentionPolicySpec) harborv1alpha1.RetentionPolicySpec {
+	out := harborv1alpha1.RetentionPolicySpec{
+		HarborSpecBase: *in.HarborSpecBase.DeepCopy(),
+		ProjectRef:     in.ProjectRef.DeepCopy(),
+		Trigger: &harborv1alpha1.RetentionTrigger{
+			Kind:     harborv1alpha1.ScheduleTypeSchedule,
+			Settings: map[string]apiextensionsv1.JSON{"cron": rawJSON(in.Schedule)},
+		},
+		Scope: in.Scope.DeepCopy(),
+	}
+	for _, rule := range in.Rules {
+		alpha := harborv1alpha1.RetentionRule{
+			Disabled: rule.Disabled,
+			Action:   retainAction,
+			Template: string(rule.Template),
+		}
+		if rule.Value != nil {
+			alpha.Params = map[string]apiextensionsv1.JSON{
+				string(rule.Template): {Raw: []byte(strconv.FormatInt(*rule.Value, 10))},
+			}
+		}
+		for _, sel := range rule.TagSelectors {
+			alpha.TagSelectors = append(alpha.TagSelectors, harborv1alpha1.RetentionSelector{
+				Kind:       doublestarKind,
+				Decoration: string(sel.Decoration),
+				Pattern:    sel.Pattern,
+				Extras:     tagSelectorExtras(sel.Untagged),
+			})
+		}
+		if len(rule.RepositorySelectors) > 0 {
+			var repos []harborv1alpha1.RetentionSelector
+			for _, sel := range rule.RepositorySelectors {
+				repos = append(repos, harborv1alpha1.RetentionSelector{
+					Kind:       doublestarKind,
+					Decoration: repositoryDecorationToV1alpha1(sel.Decoration),
+					Pattern:    sel.Pattern,
+				})
+			}
+			alpha.ScopeSelectors = map[string][]harborv1alpha1.RetentionSelector{repositoryScope: repos}
+		}
+		out.Rules = append(out.Rules, alpha)
+	}
+	return out
+}
+
+func retentionSpecFromV1alpha1(in harborv1alpha1.RetentionPolicySpec) RetentionPolicySpec {
+	out := RetentionPolicySpec{
+		HarborSpecBase: *in.HarborSpecBase.DeepCopy(),
+		ProjectRef:     in.ProjectRef.DeepCopy(),
+		Scope:          in.Scope.DeepCopy(),
+	}
+	if in.Trigger != nil {
+		if raw, ok := in.Trigger.Settings["cron"]; ok {
+			_ = json.Unmarshal(raw.Raw, &out.Schedule)
+		}
+	}
+	for _, alpha := range in.Rules {
+		rule := RetentionRule{
+			Disabled: alpha.Disabled,
+			Template: RetentionTemplate(alpha.Template),
+		}
+		if raw, ok := alpha.Params[alpha.Template]; ok {
+			rule.Value = retentionParam(raw)
+		}
+		for _, sel := range alpha.TagSelectors {
+			rule.TagSelectors = append(rule.TagSelectors, TagSelector{
+				Decoration: SelectorDecoration(sel.Decoration),
+				Pattern:    sel.Pattern,
+				Untagged:   untaggedFromExtras(sel.Extras),
+			})
+		}
+		for _, sel := range alpha.ScopeSelectors[repositoryScope] {
+			rule.RepositorySelectors = append(rule.RepositorySelectors, RepositorySelector{
+				Decoration: repositoryDecorationFromV1alpha1(sel.Decoration),
+				Pattern:    sel.Pattern,
+			})
+		}
+		out.Rules = append(out.Rules, rule)
+	}
+	return out
+}
+
+// retentionParam decodes a retention template parameter, which Harbor
+// accepts either as a number or wrapped as {"value": number}.
+func retentionParam(raw apiextensionsv1.JSON) *int64 {
+	var wrapped struct {
+		Value *int64 `json:"value"`
+	}
+	if json.Unmarshal(raw.Raw, &wrapped) == nil && wrapped.Value != nil {
+		return wrapped.Value
+	}
+	var n int64
+	if err := json.Unmarshal(raw.Raw, &n); err != nil {
+		return nil
+	}
+	return &n
+}
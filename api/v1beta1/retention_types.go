@@ -0,0 +1,107 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// RetentionTemplate selects which artifacts a retention rule retains.
+// +kubebuilder:validation:Enum=always;nothing;latestPushedK;latestPulledN;latestActiveK;nDaysSinceLastPush;nDaysSinceLastPull;lastXDays
+type RetentionTemplate string
+
+const (
+	RetentionTemplateAlways             RetentionTemplate = "always"
+	RetentionTemplateNothing            RetentionTemplate = "nothing"
+	RetentionTemplateLatestPushedK      RetentionTemplate = "latestPushedK"
+	RetentionTemplateLatestPulledN      RetentionTemplate = "latestPulledN"
+	RetentionTemplateLatestActiveK      RetentionTemplate = "latestActiveK"
+	RetentionTemplateNDaysSinceLastPush RetentionTemplate = "nDaysSinceLastPush"
+	RetentionTemplateNDaysSinceLastPull RetentionTemplate = "nDaysSinceLastPull"
+	RetentionTemplateLastXDays          RetentionTemplate = "lastXDays"
+)
+
+// RetentionPolicySpec defines the desired state of a retention policy.
+// +kubebuilder:validation:XValidation:rule="!has(self.projectRef) || !has(self.scope) || (self.scope.ref == 0 && (size(self.scope.level) == 0 || self.scope.level == 'project'))",message="scope.ref must be empty and scope.level must be empty or 'project' when projectRef is set"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.projectRef) || (has(self.projectRef) && self.projectRef == oldSelf.projectRef)",message="projectRef is immutable; delete and recreate the RetentionPolicy"
+type RetentionPolicySpec struct {
+	harborv1alpha1.HarborSpecBase `json:",inline"`
+
+	// ProjectRef references a Project CR to derive the Harbor project ID.
+	// When set, scope.ref is resolved from the Project status and scope.level is forced to "project".
+	// +optional
+	ProjectRef *harborv1alpha1.ProjectReference `json:"projectRef,omitempty"`
+
+	// Rules defines the retention rules. An artifact is retained when any
+	// enabled rule retains it.
+	// +kubebuilder:validation:MinItems=1
+	Rules []RetentionRule `json:"rules"`
+
+	// Schedule is the six-field cron expression on which the policy runs,
+	// such as "0 0 0 * * *". When omitted, the policy only runs when
+	// triggered in Harbor.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Scope defines the policy scope.
+	// +optional
+	Scope *harborv1alpha1.RetentionScope `json:"scope,omitempty"`
+}
+
+// RetentionRule defines a retention rule.
+// +kubebuilder:validation:XValidation:rule="self.template in ['always', 'nothing'] || has(self.value)",message="value is required by the template"
+type RetentionRule struct {
+	// Disabled indicates whether the rule is disabled. Defaults to false.
+	// +kubebuilder:default=false
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Template selects which artifacts the rule retains.
+	Template RetentionTemplate `json:"template"`
+
+	// Value is the template parameter: the number of artifacts for the
+	// latest* templates, or the number of days for the day-based templates.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Value *int64 `json:"value,omitempty"`
+
+	// TagSelectors select the tags the rule applies to.
+	// +optional
+	TagSelectors []TagSelector `json:"tagSelectors,omitempty"`
+
+	// RepositorySelectors select the repositories the rule applies to.
+	// +optional
+	RepositorySelectors []RepositorySelector `json:"repositorySelectors,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=harbor
+// +kubebuilder:printcolumn:name="Project",type=string,JSONPath=`.spec.projectRef.name`
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// RetentionPolicy is the Schema for the retentionpolicies API.
+type RetentionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RetentionPolicySpec                  `json:"spec,omitempty"`
+	Status harborv1alpha1.RetentionPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RetentionPolicyList contains a list of RetentionPolicy.
+type RetentionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RetentionPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RetentionPolicy{}, &RetentionPolicyList{})
+}
@@ -0,0 +1,730 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CVEAllowlist) DeepCopyInto(out *CVEAllowlist) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CVEAllowlist.
+func (in *CVEAllowlist) DeepCopy() *CVEAllowlist {
+	if in == nil {
+		return nil
+	}
+	out := new(CVEAllowlist)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImmutableTagRule) DeepCopyInto(out *ImmutableTagRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImmutableTagRule.
+func (in *ImmutableTagRule) DeepCopy() *ImmutableTagRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ImmutableTagRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImmutableTagRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImmutableTagRuleList) DeepCopyInto(out *ImmutableTagRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImmutableTagRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImmutableTagRuleList.
+func (in *ImmutableTagRuleList) DeepCopy() *ImmutableTagRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImmutableTagRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImmutableTagRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImmutableTagRuleSpec) DeepCopyInto(out *ImmutableTagRuleSpec) {
+	*out = *in
+	in.HarborSpecBase.DeepCopyInto(&out.HarborSpecBase)
+	if in.ProjectRef != nil {
+		in, out := &in.ProjectRef, &out.ProjectRef
+		*out = new(v1alpha1.ProjectReference)
+		**out = **in
+	}
+	if in.TagSelectors != nil {
+		in, out := &in.TagSelectors, &out.TagSelectors
+		*out = make([]TagSelector, len(*in))
+		copy(*out, *in)
+	}
+	if in.RepositorySelectors != nil {
+		in, out := &in.RepositorySelectors, &out.RepositorySelectors
+		*out = make([]RepositorySelector, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImmutableTagRuleSpec.
+func (in *ImmutableTagRuleSpec) DeepCopy() *ImmutableTagRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImmutableTagRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Project) DeepCopyInto(out *Project) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
+func (in *Project) DeepCopy() *Project {
+	if in == nil {
+		return nil
+	}
+	out := new(Project)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Project) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Project, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMetadata) DeepCopyInto(out *ProjectMetadata) {
+	*out = *in
+	if in.EnableContentTrust != nil {
+		in, out := &in.EnableContentTrust, &out.EnableContentTrust
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableContentTrustCosign != nil {
+		in, out := &in.EnableContentTrustCosign, &out.EnableContentTrustCosign
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreventVulnerableImages != nil {
+		in, out := &in.PreventVulnerableImages, &out.PreventVulnerableImages
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoScan != nil {
+		in, out := &in.AutoScan, &out.AutoScan
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoSBOMGeneration != nil {
+		in, out := &in.AutoSBOMGeneration, &out.AutoSBOMGeneration
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReuseSystemCVEAllowlist != nil {
+		in, out := &in.ReuseSystemCVEAllowlist, &out.ReuseSystemCVEAllowlist
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProxySpeedKB != nil {
+		in, out := &in.ProxySpeedKB, &out.ProxySpeedKB
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMetadata.
+func (in *ProjectMetadata) DeepCopy() *ProjectMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+	*out = *in
+	in.HarborSpecBase.DeepCopyInto(&out.HarborSpecBase)
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(ProjectMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CVEAllowlist != nil {
+		in, out := &in.CVEAllowlist, &out.CVEAllowlist
+		*out = new(CVEAllowlist)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageLimit != nil {
+		in, out := &in.StorageLimit, &out.StorageLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.RegistryRef != nil {
+		in, out := &in.RegistryRef, &out.RegistryRef
+		*out = new(v1alpha1.RegistryReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Quota) DeepCopyInto(out *Quota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Quota.
+func (in *Quota) DeepCopy() *Quota {
+	if in == nil {
+		return nil
+	}
+	out := new(Quota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Quota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaList) DeepCopyInto(out *QuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Quota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaList.
+func (in *QuotaList) DeepCopy() *QuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
+	*out = *in
+	in.HarborSpecBase.DeepCopyInto(&out.HarborSpecBase)
+	if in.ProjectRef != nil {
+		in, out := &in.ProjectRef, &out.ProjectRef
+		*out = new(v1alpha1.ProjectReference)
+		**out = **in
+	}
+	if in.Hard != nil {
+		in, out := &in.Hard, &out.Hard
+		*out = make(map[string]resource.Quantity, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaSpec.
+func (in *QuotaSpec) DeepCopy() *QuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationFilters) DeepCopyInto(out *ReplicationFilters) {
+	*out = *in
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(ReplicationTagFilter)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = new(ReplicationLabelFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationFilters.
+func (in *ReplicationFilters) DeepCopy() *ReplicationFilters {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationFilters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationLabelFilter) DeepCopyInto(out *ReplicationLabelFilter) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationLabelFilter.
+func (in *ReplicationLabelFilter) DeepCopy() *ReplicationLabelFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationLabelFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicy) DeepCopyInto(out *ReplicationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicy.
+func (in *ReplicationPolicy) DeepCopy() *ReplicationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicyList) DeepCopyInto(out *ReplicationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReplicationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicyList.
+func (in *ReplicationPolicyList) DeepCopy() *ReplicationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicySpec) DeepCopyInto(out *ReplicationPolicySpec) {
+	*out = *in
+	in.HarborSpecBase.DeepCopyInto(&out.HarborSpecBase)
+	if in.SourceRegistryRef != nil {
+		in, out := &in.SourceRegistryRef, &out.SourceRegistryRef
+		*out = new(v1alpha1.RegistryReference)
+		**out = **in
+	}
+	if in.DestinationRegistryRef != nil {
+		in, out := &in.DestinationRegistryRef, &out.DestinationRegistryRef
+		*out = new(v1alpha1.RegistryReference)
+		**out = **in
+	}
+	if in.DestNamespaceReplaceCount != nil {
+		in, out := &in.DestNamespaceReplaceCount, &out.DestNamespaceReplaceCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.Trigger != nil {
+		in, out := &in.Trigger, &out.Trigger
+		*out = new(ReplicationTrigger)
+		**out = **in
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = new(ReplicationFilters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicateDeletion != nil {
+		in, out := &in.ReplicateDeletion, &out.ReplicateDeletion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Override != nil {
+		in, out := &in.Override, &out.Override
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Speed != nil {
+		in, out := &in.Speed, &out.Speed
+		*out = new(int)
+		**out = **in
+	}
+	if in.CopyByChunk != nil {
+		in, out := &in.CopyByChunk, &out.CopyByChunk
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SingleActiveReplication != nil {
+		in, out := &in.SingleActiveReplication, &out.SingleActiveReplication
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicySpec.
+func (in *ReplicationPolicySpec) DeepCopy() *ReplicationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationTagFilter) DeepCopyInto(out *ReplicationTagFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationTagFilter.
+func (in *ReplicationTagFilter) DeepCopy() *ReplicationTagFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationTagFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationTrigger) DeepCopyInto(out *ReplicationTrigger) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationTrigger.
+func (in *ReplicationTrigger) DeepCopy() *ReplicationTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositorySelector) DeepCopyInto(out *RepositorySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositorySelector.
+func (in *RepositorySelector) DeepCopy() *RepositorySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositorySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionPolicy) DeepCopyInto(out *RetentionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionPolicy.
+func (in *RetentionPolicy) DeepCopy() *RetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RetentionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionPolicyList) DeepCopyInto(out *RetentionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RetentionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionPolicyList.
+func (in *RetentionPolicyList) DeepCopy() *RetentionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RetentionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionPolicySpec) DeepCopyInto(out *RetentionPolicySpec) {
+	*out = *in
+	in.HarborSpecBase.DeepCopyInto(&out.HarborSpecBase)
+	if in.ProjectRef != nil {
+		in, out := &in.ProjectRef, &out.ProjectRef
+		*out = new(v1alpha1.ProjectReference)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RetentionRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(v1alpha1.RetentionScope)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionPolicySpec.
+func (in *RetentionPolicySpec) DeepCopy() *RetentionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionRule) DeepCopyInto(out *RetentionRule) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TagSelectors != nil {
+		in, out := &in.TagSelectors, &out.TagSelectors
+		*out = make([]TagSelector, len(*in))
+		copy(*out, *in)
+	}
+	if in.RepositorySelectors != nil {
+		in, out := &in.RepositorySelectors, &out.RepositorySelectors
+		*out = make([]RepositorySelector, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionRule.
+func (in *RetentionRule) DeepCopy() *RetentionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagSelector) DeepCopyInto(out *TagSelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagSelector.
+func (in *TagSelector) DeepCopy() *TagSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(TagSelector)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,3545 @@
+{{- /* Generated by hack/sync-chart-crds.sh from config/crd/bases. */ -}}
+{{- define "harbor-operator.crdConversion" -}}
+{{- if .root.Values.webhook.enabled }}
+  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+{{- with .caBundle }}
+        caBundle: {{ . }}
+{{- end }}
+        service:
+          name: {{ include "harbor-operator.fullname" .root }}-webhook
+          namespace: {{ .root.Release.Namespace }}
+          path: /convert
+          port: 443
+      conversionReviewVersions:
+      - v1
+{{- end }}
+{{- end -}}
+{{- define "harbor-operator.convertedCRDs" -}}
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    controller-gen.kubebuilder.io/version: v0.20.1
+    helm.sh/resource-policy: keep
+{{- if and .root.Values.webhook.enabled .root.Values.webhook.certManager.enabled }}
+    cert-manager.io/inject-ca-from: {{ .root.Release.Namespace }}/{{ include "harbor-operator.fullname" .root }}-webhook
+{{- end }}
+  name: immutabletagrules.harbor.harbor-operator.io
+spec:
+{{- include "harbor-operator.crdConversion" . }}
+  group: harbor.harbor-operator.io
+  names:
+    categories:
+    - harbor
+    kind: ImmutableTagRule
+    listKind: ImmutableTagRuleList
+    plural: immutabletagrules
+    singular: immutabletagrule
+  scope: Namespaced
+  versions:
+  - additionalPrinterColumns:
+    - jsonPath: .spec.projectRef.name
+      name: Project
+      type: string
+    - jsonPath: .spec.disabled
+      name: Disabled
+      type: boolean
+    - jsonPath: .status.conditions[?(@.type=="Ready")].status
+      name: Ready
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].reason
+      name: Reason
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].message
+      name: Message
+      priority: 1
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1alpha1
+    schema:
+      openAPIV3Schema:
+        description: ImmutableTagRule is the Schema for the immutabletagrules API.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: ImmutableTagRuleSpec defines the desired state of ImmutableTagRule.
+            properties:
+              action:
+                description: Action defines the rule action.
+                type: string
+              creationPolicy:
+                description: |-
+                  CreationPolicy controls whether the operator creates or adopts the Harbor rule.
+                  When omitted, the operator's default creation policy is used.
+                enum:
+                - Create
+                - Adopt
+                - CreateOrAdopt
+                type: string
+              deletionPolicy:
+                default: Delete
+                description: |-
+                  DeletionPolicy controls what happens when the Kubernetes object is deleted.
+                  Delete removes the corresponding Harbor resource before removing the finalizer.
+                  Orphan skips Harbor-side deletion and removes the finalizer so the
+                  Kubernetes object can be deleted while leaving the Harbor resource in place.
+                  Defaults to Delete.
+                enum:
+                - Delete
+                - Orphan
+                type: string
+              disabled:
+                default: false
+                description: Disabled indicates whether the rule is disabled. Defaults
+                  to false.
+                type: boolean
+              driftDetectionInterval:
+                description: |-
+                  DriftDetectionInterval is the interval at which the operator checks for drift.
+                  When omitted, the operator's default drift detection interval is used.
+                  An explicit value of 0 disables periodic drift detection.
+                type: string
+              harborConnectionRef:
+                description: |-
+                  HarborConnectionRef references the Harbor connection object to use.
+                  When the operator is started with --harbor-connection, this field may be omitted.
+                properties:
+                  kind:
+                    default: HarborConnection
+                    description: |-
+                      Kind selects the Harbor connection object kind.
+                      Defaults to HarborConnection.
+                    enum:
+                    - HarborConnection
+                    - ClusterHarborConnection
+                    type: string
+                  name:
+                    description: Name of the referenced Harbor connection object.
+                    type: string
+                required:
+                - name
+                type: object
+              managementPolicy:
+                default: Full
+                description: |-
+                  ManagementPolicy controls which changes the operator makes in Harbor.
+                  Full creates, updates and deletes the Harbor resource.
+                  ObserveOnly adopts an existing Harbor resource by name and reports in
+                  status where it differs from the spec, but never writes to Harbor.
+                  NoDelete creates and updates the Harbor resource but never deletes it,
+                  as if deletionPolicy were Orphan.
+                  Defaults to Full.
+                enum:
+                - Full
+                - ObserveOnly
+                - NoDelete
+                type: string
+              params:
+                additionalProperties:
+                  x-kubernetes-preserve-unknown-fields: true
+                description: Params holds template parameters.
+                type: object
+              priority:
+                description: Priority defines the rule priority.
+                type: integer
+              projectRef:
+                description: ProjectRef references a Project CR to derive the Harbor
+                  project ID.
+                properties:
+                  name:
+                    description: Name of the Project resource.
+                    minLength: 1
+                    type: string
+                  namespace:
+                    description: Namespace of the Project resource. Defaults to the
+                      referencing resource namespace.
+                    type: string
+                required:
+                - name
+                type: object
+              reconcileNonce:
+                description: ReconcileNonce forces an immediate reconcile when updated.
+                type: string
+              scopeSelectors:
+                additionalProperties:
+                  items:
+                    description: ImmutableSelector defines an immutable tag rule selector.
+                    properties:
+                      decoration:
+                        description: Decoration defines selector decoration.
+                        type: string
+                      extras:
+                        description: Extras defines extra selector details.
+                        type: string
+                      kind:
+                        description: Kind defines selector kind.
+                        type: string
+                      pattern:
+                        description: Pattern defines selector pattern.
+                        type: string
+                    type: object
+                  type: array
+                description: ScopeSelectors define scope selectors.
+                type: object
+              tagSelectors:
+                description: TagSelectors define tag selectors.
+                items:
+                  description: ImmutableSelector defines an immutable tag rule selector.
+                  properties:
+                    decoration:
+                      description: Decoration defines selector decoration.
+                      type: string
+                    extras:
+                      description: Extras defines extra selector details.
+                      type: string
+                    kind:
+                      description: Kind defines selector kind.
+                      type: string
+                    pattern:
+                      description: Pattern defines selector pattern.
+                      type: string
+                  type: object
+                type: array
+              template:
+                description: Template defines the rule template.
+                type: string
+            type: object
+            x-kubernetes-validations:
+            - message: projectRef is required
+              rule: has(self.projectRef)
+            - message: projectRef is immutable; delete and recreate the ImmutableTagRule
+              rule: '!has(oldSelf.projectRef) || (has(self.projectRef) && self.projectRef
+                == oldSelf.projectRef)'
+          status:
+            description: ImmutableTagRuleStatus defines the observed state of ImmutableTagRule.
+            properties:
+              conditions:
+                description: Conditions represent the latest available observations
+                  of the resource's state.
+                items:
+                  description: Condition contains details for one aspect of the current
+                    state of this API Resource.
+                  properties:
+                    lastTransitionTime:
+                      description: |-
+                        lastTransitionTime is the last time the condition transitioned from one status to another.
+                        This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+                      format: date-time
+                      type: string
+                    message:
+                      description: |-
+                        message is a human readable message indicating details about the transition.
+                        This may be an empty string.
+                      maxLength: 32768
+                      type: string
+                    observedGeneration:
+                      description: |-
+                        observedGeneration represents the .metadata.generation that the condition was set based upon.
+                        For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+                        with respect to the current state of the instance.
+                      format: int64
+                      minimum: 0
+                      type: integer
+                    reason:
+                      description: |-
+                        reason contains a programmatic identifier indicating the reason for the condition's last transition.
+                        Producers of specific condition types may define expected values and meanings for this field,
+                        and whether the values are considered a guaranteed API.
+                        The value should be a CamelCase string.
+                        This field may not be empty.
+                      maxLength: 1024
+                      minLength: 1
+                      pattern: ^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$
+                      type: string
+                    status:
+                      description: status of the condition, one of True, False, Unknown.
+                      enum:
+                      - "True"
+                      - "False"
+                      - Unknown
+                      type: string
+                    type:
+                      description: type of condition in CamelCase or in foo.example.com/CamelCase.
+                      maxLength: 316
+                      pattern: ^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$
+                      type: string
+                  required:
+                  - lastTransitionTime
+                  - message
+                  - reason
+                  - status
+                  - type
+                  type: object
+                type: array
+              drift:
+                description: |-
+                  Drift describes the most recent change made in Harbor outside the
+                  operator. It is kept after the operator corrects the change and is
+                  replaced when a different change is found.
+                properties:
+                  detectedAt:
+                    description: DetectedAt is when the difference was first found.
+                    format: date-time
+                    type: string
+                  fields:
+                    description: Fields lists the differing fields of the Harbor object.
+                    items:
+                      description: DriftField is a single field that differed between
+                        the spec and Harbor.
+                      properties:
+                        desired:
+                          description: |-
+                            Desired is the JSON value derived from the spec. Secret values are
+                            redacted.
+                          type: string
+                        observed:
+                          description: Observed is the JSON value found in Harbor.
+                            Secret values are redacted.
+                          type: string
+                        path:
+                          description: |-
+                            Path is the JSON path of the field in the Harbor API representation,
+                            such as $.metadata.public.
+                          type: string
+                      required:
+                      - path
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - detectedAt
+                - fields
+                type: object
+              harborImmutableRuleID:
+                description: HarborImmutableRuleID is the ID of the rule in Harbor.
+                type: integer
+              observedGeneration:
+                description: ObservedGeneration is the most recent generation observed
+                  by the controller.
+                format: int64
+                type: integer
+              resolvedHarborConnection:
+                description: |-
+                  ResolvedHarborConnection is the exact connection identity used for Harbor
+                  operations. Once set, changing the effective connection is refused.
+                properties:
+                  kind:
+                    description: Kind is either HarborConnection or ClusterHarborConnection.
+                    type: string
+                  name:
+                    description: Name is the name of the connection object.
+                    type: string
+                  namespace:
+                    description: Namespace is set for a namespaced HarborConnection.
+                    type: string
+                  uid:
+                    description: UID is the Kubernetes UID of the connection object.
+                    type: string
+                required:
+                - kind
+                - name
+                - uid
+                type: object
+            type: object
+        type: object
+    served: true
+    storage: true
+    subresources:
+      status: {}
+  - additionalPrinterColumns:
+    - jsonPath: .spec.projectRef.name
+      name: Project
+      type: string
+    - jsonPath: .spec.disabled
+      name: Disabled
+      type: boolean
+    - jsonPath: .status.conditions[?(@.type=="Ready")].status
+      name: Ready
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].reason
+      name: Reason
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].message
+      name: Message
+      priority: 1
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1beta1
+    schema:
+      openAPIV3Schema:
+        description: ImmutableTagRule is the Schema for the immutabletagrules API.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: ImmutableTagRuleSpec defines the desired state of ImmutableTagRule.
+            properties:
+              creationPolicy:
+                description: |-
+                  CreationPolicy controls whether the operator creates or adopts the Harbor rule.
+                  When omitted, the operator's default creation policy is used.
+                enum:
+                - Create
+                - Adopt
+                - CreateOrAdopt
+                type: string
+              deletionPolicy:
+                default: Delete
+                description: |-
+                  DeletionPolicy controls what happens when the Kubernetes object is deleted.
+                  Delete removes the corresponding Harbor resource before removing the finalizer.
+                  Orphan skips Harbor-side deletion and removes the finalizer so the
+                  Kubernetes object can be deleted while leaving the Harbor resource in place.
+                  Defaults to Delete.
+                enum:
+                - Delete
+                - Orphan
+                type: string
+              disabled:
+                default: false
+                description: Disabled indicates whether the rule is disabled. Defaults
+                  to false.
+                type: boolean
+              driftDetectionInterval:
+                description: |-
+                  DriftDetectionInterval is the interval at which the operator checks for drift.
+                  When omitted, the operator's default drift detection interval is used.
+                  An explicit value of 0 disables periodic drift detection.
+                type: string
+              harborConnectionRef:
+                description: |-
+                  HarborConnectionRef references the Harbor connection object to use.
+                  When the operator is started with --harbor-connection, this field may be omitted.
+                properties:
+                  kind:
+                    default: HarborConnection
+                    description: |-
+                      Kind selects the Harbor connection object kind.
+                      Defaults to HarborConnection.
+                    enum:
+                    - HarborConnection
+                    - ClusterHarborConnection
+                    type: string
+                  name:
+                    description: Name of the referenced Harbor connection object.
+                    type: string
+                required:
+                - name
+                type: object
+              managementPolicy:
+                default: Full
+                description: |-
+                  ManagementPolicy controls which changes the operator makes in Harbor.
+                  Full creates, updates and deletes the Harbor resource.
+                  ObserveOnly adopts an existing Harbor resource by name and reports in
+                  status where it differs from the spec, but never writes to Harbor.
+                  NoDelete creates and updates the Harbor resource but never deletes it,
+                  as if deletionPolicy were Orphan.
+                  Defaults to Full.
+                enum:
+                - Full
+                - ObserveOnly
+                - NoDelete
+                type: string
+              priority:
+                description: Priority defines the rule priority.
+                type: integer
+              projectRef:
+                description: ProjectRef references a Project CR to derive the Harbor
+                  project ID.
+                properties:
+                  name:
+                    description: Name of the Project resource.
+                    minLength: 1
+                    type: string
+                  namespace:
+                    description: Namespace of the Project resource. Defaults to the
+                      referencing resource namespace.
+                    type: string
+                required:
+                - name
+                type: object
+              reconcileNonce:
+                description: ReconcileNonce forces an immediate reconcile when updated.
+                type: string
+              repositorySelectors:
+                description: RepositorySelectors select the repositories the rule
+                  applies to.
+                items:
+                  description: RepositorySelector selects artifacts by repository.
+                  properties:
+                    decoration:
+                      default: matches
+                      description: |-
+                        Decoration selects whether matching repositories are included or excluded.
+                        Defaults to matches.
+                      enum:
+                      - matches
+                      - excludes
+                      type: string
+                    pattern:
+                      description: |-
+                        Pattern is a doublestar pattern matched against the repository name,
+                        such as "team/**".
+                      type: string
+                  required:
+                  - pattern
+                  type: object
+                type: array
+              tagSelectors:
+                description: TagSelectors select the tags that become immutable.
+                items:
+                  description: TagSelector selects artifacts by tag.
+                  properties:
+                    decoration:
+                      default: matches
+                      description: |-
+                        Decoration selects whether matching tags are included or excluded.
+                        Defaults to matches.
+                      enum:
+                      - matches
+                      - excludes
+                      type: string
+                    pattern:
+                      description: Pattern is a doublestar pattern matched against
+                        the tag, such as "v*".
+                      type: string
+                    untagged:
+                      description: Untagged also selects artifacts without a tag.
+                      type: boolean
+                  required:
+                  - pattern
+                  type: object
+                type: array
+            type: object
+            x-kubernetes-validations:
+            - message: projectRef is required
+              rule: has(self.projectRef)
+            - message: projectRef is immutable; delete and recreate the ImmutableTagRule
+              rule: '!has(oldSelf.projectRef) || (has(self.projectRef) && self.projectRef
+                == oldSelf.projectRef)'
+          status:
+            description: ImmutableTagRuleStatus defines the observed state of ImmutableTagRule.
+            properties:
+              conditions:
+                description: Conditions represent the latest available observations
+                  of the resource's state.
+                items:
+                  description: Condition contains details for one aspect of the current
+                    state of this API Resource.
+                  properties:
+                    lastTransitionTime:
+                      description: |-
+                        lastTransitionTime is the last time the condition transitioned from one status to another.
+                        This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+                      format: date-time
+                      type: string
+                    message:
+                      description: |-
+                        message is a human readable message indicating details about the transition.
+                        This may be an empty string.
+                      maxLength: 32768
+                      type: string
+                    observedGeneration:
+                      description: |-
+                        observedGeneration represents the .metadata.generation that the condition was set based upon.
+                        For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+                        with respect to the current state of the instance.
+                      format: int64
+                      minimum: 0
+                      type: integer
+                    reason:
+                      description: |-
+                        reason contains a programmatic identifier indicating the reason for the condition's last transition.
+                        Producers of specific condition types may define expected values and meanings for this field,
+                        and whether the values are considered a guaranteed API.
+                        The value should be a CamelCase string.
+                        This field may not be empty.
+                      maxLength: 1024
+                      minLength: 1
+                      pattern: ^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$
+                      type: string
+                    status:
+                      description: status of the condition, one of True, False, Unknown.
+                      enum:
+                      - "True"
+                      - "False"
+                      - Unknown
+                      type: string
+                    type:
+                      description: type of condition in CamelCase or in foo.example.com/CamelCase.
+                      maxLength: 316
+                      pattern: ^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$
+                      type: string
+                  required:
+                  - lastTransitionTime
+                  - message
+                  - reason
+                  - status
+                  - type
+                  type: object
+                type: array
+              drift:
+                description: |-
+                  Drift describes the most recent change made in Harbor outside the
+                  operator. It is kept after the operator corrects the change and is
+                  replaced when a different change is found.
+                properties:
+                  detectedAt:
+                    description: DetectedAt is when the difference was first found.
+                    format: date-time
+                    type: string
+                  fields:
+                    description: Fields lists the differing fields of the Harbor object.
+                    items:
+                      description: DriftField is a single field that differed between
+                        the spec and Harbor.
+                      properties:
+                        desired:
+                          description: |-
+                            Desired is the JSON value derived from the spec. Secret values are
+                            redacted.
+                          type: string
+                        observed:
+                          description: Observed is the JSON value found in Harbor.
+                            Secret values are redacted.
+                          type: string
+                        path:
+                          description: |-
+                            Path is the JSON path of the field in the Harbor API representation,
+                            such as $.metadata.public.
+                          type: string
+                      required:
+                      - path
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - detectedAt
+                - fields
+                type: object
+              harborImmutableRuleID:
+                description: HarborImmutableRuleID is the ID of the rule in Harbor.
+                type: integer
+              observedGeneration:
+                description: ObservedGeneration is the most recent generation observed
+                  by the controller.
+                format: int64
+                type: integer
+              resolvedHarborConnection:
+                description: |-
+                  ResolvedHarborConnection is the exact connection identity used for Harbor
+                  operations. Once set, changing the effective connection is refused.
+                properties:
+                  kind:
+                    description: Kind is either HarborConnection or ClusterHarborConnection.
+                    type: string
+                  name:
+                    description: Name is the name of the connection object.
+                    type: string
+                  namespace:
+                    description: Namespace is set for a namespaced HarborConnection.
+                    type: string
+                  uid:
+                    description: UID is the Kubernetes UID of the connection object.
+                    type: string
+                required:
+                - kind
+                - name
+                - uid
+                type: object
+            type: object
+        type: object
+    served: {{ .root.Values.webhook.enabled }}
+    storage: false
+    subresources:
+      status: {}
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    controller-gen.kubebuilder.io/version: v0.20.1
+    helm.sh/resource-policy: keep
+{{- if and .root.Values.webhook.enabled .root.Values.webhook.certManager.enabled }}
+    cert-manager.io/inject-ca-from: {{ .root.Release.Namespace }}/{{ include "harbor-operator.fullname" .root }}-webhook
+{{- end }}
+  name: projects.harbor.harbor-operator.io
+spec:
+{{- include "harbor-operator.crdConversion" . }}
+  group: harbor.harbor-operator.io
+  names:
+    categories:
+    - harbor
+    kind: Project
+    listKind: ProjectList
+    plural: projects
+    singular: project
+  scope: Namespaced
+  versions:
+  - additionalPrinterColumns:
+    - jsonPath: .spec.public
+      name: Public
+      type: boolean
+    - jsonPath: .spec.registryRef.name
+      name: Registry
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].status
+      name: Ready
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].reason
+      name: Reason
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].message
+      name: Message
+      priority: 1
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1alpha1
+    schema:
+      openAPIV3Schema:
+        description: Project is the Schema for the projects API.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: ProjectSpec defines the desired state of Project.
+            properties:
+              creationPolicy:
+                description: |-
+                  CreationPolicy controls whether the operator creates or adopts the Harbor project.
+                  When omitted, the operator's default creation policy is used.
+                enum:
+                - Create
+                - Adopt
+                - CreateOrAdopt
+                type: string
+              cve_allowlist:
+                description: CVEAllowlist holds the configuration for the CVE allowlist.
+                properties:
+                  creation_time:
+                    format: date-time
+                    type: string
+                  expires_at:
+                    type: integer
+                  id:
+                    type: integer
+                  items:
+                    items:
+                      description: CVEAllowlistItem defines a single CVE allowlist
+                        entry.
+                      properties:
+                        cve_id:
+                          type: string
+                      required:
+                      - cve_id
+                      type: object
+                    type: array
+                  project_id:
+                    type: integer
+                  update_time:
+                    format: date-time
+                    type: string
+                type: object
+              deletionPolicy:
+                default: Delete
+                description: |-
+                  DeletionPolicy controls what happens when the Kubernetes object is deleted.
+                  Delete removes the corresponding Harbor resource before removing the finalizer.
+                  Orphan skips Harbor-side deletion and removes the finalizer so the
+                  Kubernetes object can be deleted while leaving the Harbor resource in place.
+                  Defaults to Delete.
+                enum:
+                - Delete
+                - Orphan
+                type: string
+              driftDetectionInterval:
+                description: |-
+                  DriftDetectionInterval is the interval at which the operator checks for drift.
+                  When omitted, the operator's default drift detection interval is used.
+                  An explicit value of 0 disables periodic drift detection.
+                type: string
+              harborConnectionRef:
+                description: |-
+                  HarborConnectionRef references the Harbor connection object to use.
+                  When the operator is started with --harbor-connection, this field may be omitted.
+                properties:
+                  kind:
+                    default: HarborConnection
+                    description: |-
+                      Kind selects the Harbor connection object kind.
+                      Defaults to HarborConnection.
+                    enum:
+                    - HarborConnection
+                    - ClusterHarborConnection
+                    type: string
+                  name:
+                    description: Name of the referenced Harbor connection object.
+                    type: string
+                required:
+                - name
+                type: object
+              harborName:
+                description: |-
+                  HarborName is the name of the project in Harbor. When omitted, it
+                  defaults to metadata.name. Set it for Harbor names that are not valid
+                  Kubernetes names. It cannot be changed after creation.
+                maxLength: 255
+                minLength: 1
+                type: string
+              managementPolicy:
+                default: Full
+                description: |-
+                  ManagementPolicy controls which changes the operator makes in Harbor.
+                  Full creates, updates and deletes the Harbor resource.
+                  ObserveOnly adopts an existing Harbor resource by name and reports in
+                  status where it differs from the spec, but never writes to Harbor.
+                  NoDelete creates and updates the Harbor resource but never deletes it,
+                  as if deletionPolicy were Orphan.
+                  Defaults to Full.
+                enum:
+                - Full
+                - ObserveOnly
+                - NoDelete
+                type: string
+              metadata:
+                description: Metadata holds additional configuration for the Harbor
+                  project.
+                properties:
+                  auto_sbom_generation:
+                    type: string
+                  auto_scan:
+                    type: string
+                  enable_content_trust:
+                    type: string
+                  enable_content_trust_cosign:
+                    type: string
+                  prevent_vul:
+                    type: string
+                  proxy_speed_kb:
+                    type: string
+                  public:
+                    description: |-
+                      Public overrides the visibility derived from ProjectSpec.Public.
+                      When omitted, it defaults to the string form of ProjectSpec.Public.
+                    type: string
+                  retention_id:
+                    type: string
+                  reuse_sys_cve_allowlist:
+                    type: string
+                  severity:
+                    type: string
+                type: object
+              owner:
+                description: Owner is an optional field for the project owner.
+                type: string
+              public:
+                description: Public indicates whether the project is public.
+                type: boolean
+              reconcileNonce:
+                description: ReconcileNonce forces an immediate reconcile when updated.
+                type: string
+              registryRef:
+                description: RegistryRef references the Registry to use for proxy
+                  cache projects.
+                properties:
+                  name:
+                    description: Name of the Registry resource.
+                    minLength: 1
+                    type: string
+                  namespace:
+                    description: Namespace of the Registry resource. Defaults to the
+                      referencing resource namespace.
+                    type: string
+                required:
+                - name
+                type: object
+              storage_limit:
+                description: StorageLimit is the storage limit for the project.
+                type: integer
+            required:
+            - public
+            type: object
+            x-kubernetes-validations:
+            - message: registryRef is immutable
+              rule: '!has(oldSelf.registryRef) ? !has(self.registryRef) : has(self.registryRef)
+                && self.registryRef == oldSelf.registryRef'
+            - message: harborName is immutable
+              rule: has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName)
+                || self.harborName == oldSelf.harborName)
+          status:
+            description: ProjectStatus defines the observed state of Project.
+            properties:
+              conditions:
+                description: Conditions represent the latest available observations
+                  of the resource's state.
+                items:
+                  description: Condition contains details for one aspect of the current
+                    state of this API Resource.
+                  properties:
+                    lastTransitionTime:
+                      description: |-
+                        lastTransitionTime is the last time the condition transitioned from one status to another.
+                        This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+                      format: date-time
+                      type: string
+                    message:
+                      description: |-
+                        message is a human readable message indicating details about the transition.
+                        This may be an empty string.
+                      maxLength: 32768
+                      type: string
+                    observedGeneration:
+                      description: |-
+                        observedGeneration represents the .metadata.generation that the condition was set based upon.
+                        For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+                        with respect to the current state of the instance.
+                      format: int64
+                      minimum: 0
+                      type: integer
+                    reason:
+                      description: |-
+                        reason contains a programmatic identifier indicating the reason for the condition's last transition.
+                        Producers of specific condition types may define expected values and meanings for this field,
+                        and whether the values are considered a guaranteed API.
+                        The value should be a CamelCase string.
+                        This field may not be empty.
+                      maxLength: 1024
+                      minLength: 1
+                      pattern: ^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$
+                      type: string
+                    status:
+                      description: status of the condition, one of True, False, Unknown.
+                      enum:
+                      - "True"
+                      - "False"
+                      - Unknown
+                      type: string
+                    type:
+                      description: type of condition in CamelCase or in foo.example.com/CamelCase.
+                      maxLength: 316
+                      pattern: ^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$
+                      type: string
+                  required:
+                  - lastTransitionTime
+                  - message
+                  - reason
+                  - status
+                  - type
+                  type: object
+                type: array
+              drift:
+                description: |-
+                  Drift describes the most recent change made in Harbor outside the
+                  operator. It is kept after the operator corrects the change and is
+                  replaced when a different change is found.
+                properties:
+                  detectedAt:
+                    description: DetectedAt is when the difference was first found.
+                    format: date-time
+                    type: string
+                  fields:
+                    description: Fields lists the differing fields of the Harbor object.
+                    items:
+                      description: DriftField is a single field that differed between
+                        the spec and Harbor.
+                      properties:
+                        desired:
+                          description: |-
+                            Desired is the JSON value derived from the spec. Secret values are
+                            redacted.
+                          type: string
+                        observed:
+                          description: Observed is the JSON value found in Harbor.
+                            Secret values are redacted.
+                          type: string
+                        path:
+                          description: |-
+                            Path is the JSON path of the field in the Harbor API representation,
+                            such as $.metadata.public.
+                          type: string
+                      required:
+                      - path
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - detectedAt
+                - fields
+                type: object
+              harborProjectID:
+                description: HarborProjectID is the ID of the project in Harbor.
+                type: integer
+              observedGeneration:
+                description: ObservedGeneration is the most recent generation observed
+                  by the controller.
+                format: int64
+                type: integer
+              resolvedHarborConnection:
+                description: |-
+                  ResolvedHarborConnection is the exact connection identity used for Harbor
+                  operations. Once set, changing the effective connection is refused.
+                properties:
+                  kind:
+                    description: Kind is either HarborConnection or ClusterHarborConnection.
+                    type: string
+                  name:
+                    description: Name is the name of the connection object.
+                    type: string
+                  namespace:
+                    description: Namespace is set for a namespaced HarborConnection.
+                    type: string
+                  uid:
+                    description: UID is the Kubernetes UID of the connection object.
+                    type: string
+                required:
+                - kind
+                - name
+                - uid
+                type: object
+            type: object
+        type: object
+    served: true
+    storage: true
+    subresources:
+      status: {}
+  - additionalPrinterColumns:
+    - jsonPath: .spec.public
+      name: Public
+      type: boolean
+    - jsonPath: .spec.registryRef.name
+      name: Registry
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].status
+      name: Ready
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].reason
+      name: Reason
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].message
+      name: Message
+      priority: 1
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1beta1
+    schema:
+      openAPIV3Schema:
+        description: Project is the Schema for the projects API.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: ProjectSpec defines the desired state of Project.
+            properties:
+              creationPolicy:
+                description: |-
+                  CreationPolicy controls whether the operator creates or adopts the Harbor project.
+                  When omitted, the operator's default creation policy is used.
+                enum:
+                - Create
+                - Adopt
+                - CreateOrAdopt
+                type: string
+              cveAllowlist:
+                description: CVEAllowlist lists the CVEs that do not prevent pulling
+                  vulnerable images.
+                properties:
+                  expiresAt:
+                    description: ExpiresAt is when the allowlist stops applying. When
+                      omitted, it never expires.
+                    format: date-time
+                    type: string
+                  items:
+                    description: Items are the allowed CVE IDs, such as CVE-2024-3094.
+                    items:
+                      type: string
+                    type: array
+                type: object
+              deletionPolicy:
+                default: Delete
+                description: |-
+                  DeletionPolicy controls what happens when the Kubernetes object is deleted.
+                  Delete removes the corresponding Harbor resource before removing the finalizer.
+                  Orphan skips Harbor-side deletion and removes the finalizer so the
+                  Kubernetes object can be deleted while leaving the Harbor resource in place.
+                  Defaults to Delete.
+                enum:
+                - Delete
+                - Orphan
+                type: string
+              driftDetectionInterval:
+                description: |-
+                  DriftDetectionInterval is the interval at which the operator checks for drift.
+                  When omitted, the operator's default drift detection interval is used.
+                  An explicit value of 0 disables periodic drift detection.
+                type: string
+              harborConnectionRef:
+                description: |-
+                  HarborConnectionRef references the Harbor connection object to use.
+                  When the operator is started with --harbor-connection, this field may be omitted.
+                properties:
+                  kind:
+                    default: HarborConnection
+                    description: |-
+                      Kind selects the Harbor connection object kind.
+                      Defaults to HarborConnection.
+                    enum:
+                    - HarborConnection
+                    - ClusterHarborConnection
+                    type: string
+                  name:
+                    description: Name of the referenced Harbor connection object.
+                    type: string
+                required:
+                - name
+                type: object
+              harborName:
+                description: |-
+                  HarborName is the name of the project in Harbor. When omitted, it
+                  defaults to metadata.name. Set it for Harbor names that are not valid
+                  Kubernetes names. It cannot be changed after creation.
+                maxLength: 255
+                minLength: 1
+                type: string
+              managementPolicy:
+                default: Full
+                description: |-
+                  ManagementPolicy controls which changes the operator makes in Harbor.
+                  Full creates, updates and deletes the Harbor resource.
+                  ObserveOnly adopts an existing Harbor resource by name and reports in
+                  status where it differs from the spec, but never writes to Harbor.
+                  NoDelete creates and updates the Harbor resource but never deletes it,
+                  as if deletionPolicy were Orphan.
+                  Defaults to Full.
+                enum:
+                - Full
+                - ObserveOnly
+                - NoDelete
+                type: string
+              metadata:
+                description: |-
+                  Metadata holds additional configuration for the Harbor project.
+                  Settings that are omitted are left for Harbor to default.
+                properties:
+                  autoSBOMGeneration:
+                    description: AutoSBOMGeneration generates an SBOM when images
+                      are pushed.
+                    type: boolean
+                  autoScan:
+                    description: AutoScan scans images when they are pushed.
+                    type: boolean
+                  enableContentTrust:
+                    description: EnableContentTrust only allows pulling signed images.
+                    type: boolean
+                  enableContentTrustCosign:
+                    description: EnableContentTrustCosign only allows pulling images
+                      signed by cosign.
+                    type: boolean
+                  preventVulnerableImages:
+                    description: |-
+                      PreventVulnerableImages prevents pulling images with vulnerabilities of
+                      at least Severity.
+                    type: boolean
+                  proxySpeedKB:
+                    description: |-
+                      ProxySpeedKB limits the bandwidth of a proxy cache project in KB/s.
+                      -1 means unlimited.
+                    format: int64
+                    minimum: -1
+                    type: integer
+                  reuseSystemCVEAllowlist:
+                    description: |-
+                      ReuseSystemCVEAllowlist uses the system CVE allowlist instead of the
+                      project's CVEAllowlist.
+                    type: boolean
+                  severity:
+                    description: Severity is the lowest severity that PreventVulnerableImages
+                      blocks.
+                    enum:
+                    - none
+                    - low
+                    - medium
+                    - high
+                    - critical
+                    type: string
+                type: object
+              owner:
+                description: Owner is an optional field for the project owner.
+                type: string
+              public:
+                description: Public indicates whether the project is public.
+                type: boolean
+              reconcileNonce:
+                description: ReconcileNonce forces an immediate reconcile when updated.
+                type: string
+              registryRef:
+                description: RegistryRef references the Registry to use for proxy
+                  cache projects.
+                properties:
+                  name:
+                    description: Name of the Registry resource.
+                    minLength: 1
+                    type: string
+                  namespace:
+                    description: Namespace of the Registry resource. Defaults to the
+                      referencing resource namespace.
+                    type: string
+                required:
+                - name
+                type: object
+              storageLimit:
+                anyOf:
+                - type: integer
+                - type: string
+                description: |-
+                  StorageLimit is the storage quota of the project, such as 10Gi.
+                  -1 means unlimited. When omitted, Harbor's default applies.
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+            required:
+            - public
+            type: object
+            x-kubernetes-validations:
+            - message: registryRef is immutable
+              rule: '!has(oldSelf.registryRef) ? !has(self.registryRef) : has(self.registryRef)
+                && self.registryRef == oldSelf.registryRef'
+            - message: harborName is immutable
+              rule: has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName)
+                || self.harborName == oldSelf.harborName)
+          status:
+            description: ProjectStatus defines the observed state of Project.
+            properties:
+              conditions:
+                description: Conditions represent the latest available observations
+                  of the resource's state.
+                items:
+                  description: Condition contains details for one aspect of the current
+                    state of this API Resource.
+                  properties:
+                    lastTransitionTime:
+                      description: |-
+                        lastTransitionTime is the last time the condition transitioned from one status to another.
+                        This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+                      format: date-time
+                      type: string
+                    message:
+                      description: |-
+                        message is a human readable message indicating details about the transition.
+                        This may be an empty string.
+                      maxLength: 32768
+                      type: string
+                    observedGeneration:
+                      description: |-
+                        observedGeneration represents the .metadata.generation that the condition was set based upon.
+                        For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+                        with respect to the current state of the instance.
+                      format: int64
+                      minimum: 0
+                      type: integer
+                    reason:
+                      description: |-
+                        reason contains a programmatic identifier indicating the reason for the condition's last transition.
+                        Producers of specific condition types may define expected values and meanings for this field,
+                        and whether the values are considered a guaranteed API.
+                        The value should be a CamelCase string.
+                        This field may not be empty.
+                      maxLength: 1024
+                      minLength: 1
+                      pattern: ^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$
+                      type: string
+                    status:
+                      description: status of the condition, one of True, False, Unknown.
+                      enum:
+                      - "True"
+                      - "False"
+                      - Unknown
+                      type: string
+                    type:
+                      description: type of condition in CamelCase or in foo.example.com/CamelCase.
+                      maxLength: 316
+                      pattern: ^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$
+                      type: string
+                  required:
+                  - lastTransitionTime
+                  - message
+                  - reason
+                  - status
+                  - type
+                  type: object
+                type: array
+              drift:
+                description: |-
+                  Drift describes the most recent change made in Harbor outside the
+                  operator. It is kept after the operator corrects the change and is
+                  replaced when a different change is found.
+                properties:
+                  detectedAt:
+                    description: DetectedAt is when the difference was first found.
+                    format: date-time
+                    type: string
+                  fields:
+                    description: Fields lists the differing fields of the Harbor object.
+                    items:
+                      description: DriftField is a single field that differed between
+                        the spec and Harbor.
+                      properties:
+                        desired:
+                          description: |-
+                            Desired is the JSON value derived from the spec. Secret values are
+                            redacted.
+                          type: string
+                        observed:
+                          description: Observed is the JSON value found in Harbor.
+                            Secret values are redacted.
+                          type: string
+                        path:
+                          description: |-
+                            Path is the JSON path of the field in the Harbor API representation,
+                            such as $.metadata.public.
+                          type: string
+                      required:
+                      - path
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - detectedAt
+                - fields
+                type: object
+              harborProjectID:
+                description: HarborProjectID is the ID of the project in Harbor.
+                type: integer
+              observedGeneration:
+                description: ObservedGeneration is the most recent generation observed
+                  by the controller.
+                format: int64
+                type: integer
+              resolvedHarborConnection:
+                description: |-
+                  ResolvedHarborConnection is the exact connection identity used for Harbor
+                  operations. Once set, changing the effective connection is refused.
+                properties:
+                  kind:
+                    description: Kind is either HarborConnection or ClusterHarborConnection.
+                    type: string
+                  name:
+                    description: Name is the name of the connection object.
+                    type: string
+                  namespace:
+                    description: Namespace is set for a namespaced HarborConnection.
+                    type: string
+                  uid:
+                    description: UID is the Kubernetes UID of the connection object.
+                    type: string
+                required:
+                - kind
+                - name
+                - uid
+                type: object
+            type: object
+        type: object
+    served: {{ .root.Values.webhook.enabled }}
+    storage: false
+    subresources:
+      status: {}
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    controller-gen.kubebuilder.io/version: v0.20.1
+    helm.sh/resource-policy: keep
+{{- if and .root.Values.webhook.enabled .root.Values.webhook.certManager.enabled }}
+    cert-manager.io/inject-ca-from: {{ .root.Release.Namespace }}/{{ include "harbor-operator.fullname" .root }}-webhook
+{{- end }}
+  name: quotas.harbor.harbor-operator.io
+spec:
+{{- include "harbor-operator.crdConversion" . }}
+  group: harbor.harbor-operator.io
+  names:
+    categories:
+    - harbor
+    kind: Quota
+    listKind: QuotaList
+    plural: quotas
+    singular: quota
+  scope: Namespaced
+  versions:
+  - additionalPrinterColumns:
+    - jsonPath: .spec.projectRef.name
+      name: Project
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].status
+      name: Ready
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].reason
+      name: Reason
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].message
+      name: Message
+      priority: 1
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1alpha1
+    schema:
+      openAPIV3Schema:
+        description: Quota is the Schema for the quotas API.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: QuotaSpec defines the desired state of Quota.
+            properties:
+              deletionPolicy:
+                default: Delete
+                description: |-
+                  DeletionPolicy controls what happens when the Kubernetes object is deleted.
+                  Delete removes the corresponding Harbor resource before removing the finalizer.
+                  Orphan skips Harbor-side deletion and removes the finalizer so the
+                  Kubernetes object can be deleted while leaving the Harbor resource in place.
+                  Defaults to Delete.
+                enum:
+                - Delete
+                - Orphan
+                type: string
+              driftDetectionInterval:
+                description: |-
+                  DriftDetectionInterval is the interval at which the operator checks for drift.
+                  When omitted, the operator's default drift detection interval is used.
+                  An explicit value of 0 disables periodic drift detection.
+                type: string
+              harborConnectionRef:
+                description: |-
+                  HarborConnectionRef references the Harbor connection object to use.
+                  When the operator is started with --harbor-connection, this field may be omitted.
+                properties:
+                  kind:
+                    default: HarborConnection
+                    description: |-
+                      Kind selects the Harbor connection object kind.
+                      Defaults to HarborConnection.
+                    enum:
+                    - HarborConnection
+                    - ClusterHarborConnection
+                    type: string
+                  name:
+                    description: Name of the referenced Harbor connection object.
+                    type: string
+                required:
+                - name
+                type: object
+              hard:
+                additionalProperties:
+                  format: int64
+                  type: integer
+                description: Hard defines the quota hard limits (resource name ->
+                  limit).
+                type: object
+              managementPolicy:
+                default: Full
+                description: |-
+                  ManagementPolicy controls which changes the operator makes in Harbor.
+                  Full creates, updates and deletes the Harbor resource.
+                  ObserveOnly adopts an existing Harbor resource by name and reports in
+                  status where it differs from the spec, but never writes to Harbor.
+                  NoDelete creates and updates the Harbor resource but never deletes it,
+                  as if deletionPolicy were Orphan.
+                  Defaults to Full.
+                enum:
+                - Full
+                - ObserveOnly
+                - NoDelete
+                type: string
+              projectRef:
+                description: ProjectRef references a Project CR to derive the Harbor
+                  project ID.
+                properties:
+                  name:
+                    description: Name of the Project resource.
+                    minLength: 1
+                    type: string
+                  namespace:
+                    description: Namespace of the Project resource. Defaults to the
+                      referencing resource namespace.
+                    type: string
+                required:
+                - name
+                type: object
+              reconcileNonce:
+                description: ReconcileNonce forces an immediate reconcile when updated.
+                type: string
+            type: object
+            x-kubernetes-validations:
+            - message: projectRef is required
+              rule: has(self.projectRef)
+            - message: projectRef is immutable; delete and recreate the Quota
+              rule: '!has(oldSelf.projectRef) || (has(self.projectRef) && self.projectRef
+                == oldSelf.projectRef)'
+          status:
+            description: QuotaStatus defines the observed state of Quota.
+            properties:
+              conditions:
+                description: Conditions represent the latest available observations
+                  of the resource's state.
+                items:
+                  description: Condition contains details for one aspect of the current
+                    state of this API Resource.
+                  properties:
+                    lastTransitionTime:
+                      description: |-
+                        lastTransitionTime is the last time the condition transitioned from one status to another.
+                        This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+                      format: date-time
+                      type: string
+                    message:
+                      description: |-
+                        message is a human readable message indicating details about the transition.
+                        This may be an empty string.
+                      maxLength: 32768
+                      type: string
+                    observedGeneration:
+                      description: |-
+                        observedGeneration represents the .metadata.generation that the condition was set based upon.
+                        For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+                        with respect to the current state of the instance.
+                      format: int64
+                      minimum: 0
+                      type: integer
+                    reason:
+                      description: |-
+                        reason contains a programmatic identifier indicating the reason for the condition's last transition.
+                        Producers of specific condition types may define expected values and meanings for this field,
+                        and whether the values are considered a guaranteed API.
+                        The value should be a CamelCase string.
+                        This field may not be empty.
+                      maxLength: 1024
+                      minLength: 1
+                      pattern: ^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$
+                      type: string
+                    status:
+                      description: status of the condition, one of True, False, Unknown.
+                      enum:
+                      - "True"
+                      - "False"
+                      - Unknown
+                      type: string
+                    type:
+                      description: type of condition in CamelCase or in foo.example.com/CamelCase.
+                      maxLength: 316
+                      pattern: ^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$
+                      type: string
+                  required:
+                  - lastTransitionTime
+                  - message
+                  - reason
+                  - status
+                  - type
+                  type: object
+                type: array
+              drift:
+                description: |-
+                  Drift describes the most recent change made in Harbor outside the
+                  operator. It is kept after the operator corrects the change and is
+                  replaced when a different change is found.
+                properties:
+                  detectedAt:
+                    description: DetectedAt is when the difference was first found.
+                    format: date-time
+                    type: string
+                  fields:
+                    description: Fields lists the differing fields of the Harbor object.
+                    items:
+                      description: DriftField is a single field that differed between
+                        the spec and Harbor.
+                      properties:
+                        desired:
+                          description: |-
+                            Desired is the JSON value derived from the spec. Secret values are
+                            redacted.
+                          type: string
+                        observed:
+                          description: Observed is the JSON value found in Harbor.
+                            Secret values are redacted.
+                          type: string
+                        path:
+                          description: |-
+                            Path is the JSON path of the field in the Harbor API representation,
+                            such as $.metadata.public.
+                          type: string
+                      required:
+                      - path
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - detectedAt
+                - fields
+                type: object
+              harborQuotaID:
+                description: HarborQuotaID is the ID of the quota in Harbor.
+                type: integer
+              observedGeneration:
+                description: ObservedGeneration is the most recent generation observed
+                  by the controller.
+                format: int64
+                type: integer
+              resolvedHarborConnection:
+                description: |-
+                  ResolvedHarborConnection is the exact connection identity used for Harbor
+                  operations. Once set, changing the effective connection is refused.
+                properties:
+                  kind:
+                    description: Kind is either HarborConnection or ClusterHarborConnection.
+                    type: string
+                  name:
+                    description: Name is the name of the connection object.
+                    type: string
+                  namespace:
+                    description: Namespace is set for a namespaced HarborConnection.
+                    type: string
+                  uid:
+                    description: UID is the Kubernetes UID of the connection object.
+                    type: string
+                required:
+                - kind
+                - name
+                - uid
+                type: object
+            type: object
+        type: object
+    served: true
+    storage: true
+    subresources:
+      status: {}
+  - additionalPrinterColumns:
+    - jsonPath: .spec.projectRef.name
+      name: Project
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].status
+      name: Ready
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].reason
+      name: Reason
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].message
+      name: Message
+      priority: 1
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1beta1
+    schema:
+      openAPIV3Schema:
+        description: Quota is the Schema for the quotas API.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: QuotaSpec defines the desired state of Quota.
+            properties:
+              deletionPolicy:
+                default: Delete
+                description: |-
+                  DeletionPolicy controls what happens when the Kubernetes object is deleted.
+                  Delete removes the corresponding Harbor resource before removing the finalizer.
+                  Orphan skips Harbor-side deletion and removes the finalizer so the
+                  Kubernetes object can be deleted while leaving the Harbor resource in place.
+                  Defaults to Delete.
+                enum:
+                - Delete
+                - Orphan
+                type: string
+              driftDetectionInterval:
+                description: |-
+                  DriftDetectionInterval is the interval at which the operator checks for drift.
+                  When omitted, the operator's default drift detection interval is used.
+                  An explicit value of 0 disables periodic drift detection.
+                type: string
+              harborConnectionRef:
+                description: |-
+                  HarborConnectionRef references the Harbor connection object to use.
+                  When the operator is started with --harbor-connection, this field may be omitted.
+                properties:
+                  kind:
+                    default: HarborConnection
+                    description: |-
+                      Kind selects the Harbor connection object kind.
+                      Defaults to HarborConnection.
+                    enum:
+                    - HarborConnection
+                    - ClusterHarborConnection
+                    type: string
+                  name:
+                    description: Name of the referenced Harbor connection object.
+                    type: string
+                required:
+                - name
+                type: object
+              hard:
+                additionalProperties:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                description: |-
+                  Hard defines the quota hard limits by resource name, such as
+                  storage: 10Gi. -1 means unlimited.
+                type: object
+              managementPolicy:
+                default: Full
+                description: |-
+                  ManagementPolicy controls which changes the operator makes in Harbor.
+                  Full creates, updates and deletes the Harbor resource.
+                  ObserveOnly adopts an existing Harbor resource by name and reports in
+                  status where it differs from the spec, but never writes to Harbor.
+                  NoDelete creates and updates the Harbor resource but never deletes it,
+                  as if deletionPolicy were Orphan.
+                  Defaults to Full.
+                enum:
+                - Full
+                - ObserveOnly
+                - NoDelete
+                type: string
+              projectRef:
+                description: ProjectRef references a Project CR to derive the Harbor
+                  project ID.
+                properties:
+                  name:
+                    description: Name of the Project resource.
+                    minLength: 1
+                    type: string
+                  namespace:
+                    description: Namespace of the Project resource. Defaults to the
+                      referencing resource namespace.
+                    type: string
+                required:
+                - name
+                type: object
+              reconcileNonce:
+                description: ReconcileNonce forces an immediate reconcile when updated.
+                type: string
+            type: object
+            x-kubernetes-validations:
+            - message: projectRef is required
+              rule: has(self.projectRef)
+            - message: projectRef is immutable; delete and recreate the Quota
+              rule: '!has(oldSelf.projectRef) || (has(self.projectRef) && self.projectRef
+                == oldSelf.projectRef)'
+          status:
+            description: QuotaStatus defines the observed state of Quota.
+            properties:
+              conditions:
+                description: Conditions represent the latest available observations
+                  of the resource's state.
+                items:
+                  description: Condition contains details for one aspect of the current
+                    state of this API Resource.
+                  properties:
+                    lastTransitionTime:
+                      description: |-
+                        lastTransitionTime is the last time the condition transitioned from one status to another.
+                        This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+                      format: date-time
+                      type: string
+                    message:
+                      description: |-
+                        message is a human readable message indicating details about the transition.
+                        This may be an empty string.
+                      maxLength: 32768
+                      type: string
+                    observedGeneration:
+                      description: |-
+                        observedGeneration represents the .metadata.generation that the condition was set based upon.
+                        For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+                        with respect to the current state of the instance.
+                      format: int64
+                      minimum: 0
+                      type: integer
+                    reason:
+                      description: |-
+                        reason contains a programmatic identifier indicating the reason for the condition's last transition.
+                        Producers of specific condition types may define expected values and meanings for this field,
+                        and whether the values are considered a guaranteed API.
+                        The value should be a CamelCase string.
+                        This field may not be empty.
+                      maxLength: 1024
+                      minLength: 1
+                      pattern: ^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$
+                      type: string
+                    status:
+                      description: status of the condition, one of True, False, Unknown.
+                      enum:
+                      - "True"
+                      - "False"
+                      - Unknown
+                      type: string
+                    type:
+                      description: type of condition in CamelCase or in foo.example.com/CamelCase.
+                      maxLength: 316
+                      pattern: ^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$
+                      type: string
+                  required:
+                  - lastTransitionTime
+                  - message
+                  - reason
+                  - status
+                  - type
+                  type: object
+                type: array
+              drift:
+                description: |-
+                  Drift describes the most recent change made in Harbor outside the
+                  operator. It is kept after the operator corrects the change and is
+                  replaced when a different change is found.
+                properties:
+                  detectedAt:
+                    description: DetectedAt is when the difference was first found.
+                    format: date-time
+                    type: string
+                  fields:
+                    description: Fields lists the differing fields of the Harbor object.
+                    items:
+                      description: DriftField is a single field that differed between
+                        the spec and Harbor.
+                      properties:
+                        desired:
+                          description: |-
+                            Desired is the JSON value derived from the spec. Secret values are
+                            redacted.
+                          type: string
+                        observed:
+                          description: Observed is the JSON value found in Harbor.
+                            Secret values are redacted.
+                          type: string
+                        path:
+                          description: |-
+                            Path is the JSON path of the field in the Harbor API representation,
+                            such as $.metadata.public.
+                          type: string
+                      required:
+                      - path
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - detectedAt
+                - fields
+                type: object
+              harborQuotaID:
+                description: HarborQuotaID is the ID of the quota in Harbor.
+                type: integer
+              observedGeneration:
+                description: ObservedGeneration is the most recent generation observed
+                  by the controller.
+                format: int64
+                type: integer
+              resolvedHarborConnection:
+                description: |-
+                  ResolvedHarborConnection is the exact connection identity used for Harbor
+                  operations. Once set, changing the effective connection is refused.
+                properties:
+                  kind:
+                    description: Kind is either HarborConnection or ClusterHarborConnection.
+                    type: string
+                  name:
+                    description: Name is the name of the connection object.
+                    type: string
+                  namespace:
+                    description: Namespace is set for a namespaced HarborConnection.
+                    type: string
+                  uid:
+                    description: UID is the Kubernetes UID of the connection object.
+                    type: string
+                required:
+                - kind
+                - name
+                - uid
+                type: object
+            type: object
+        type: object
+    served: {{ .root.Values.webhook.enabled }}
+    storage: false
+    subresources:
+      status: {}
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    controller-gen.kubebuilder.io/version: v0.20.1
+    helm.sh/resource-policy: keep
+{{- if and .root.Values.webhook.enabled .root.Values.webhook.certManager.enabled }}
+    cert-manager.io/inject-ca-from: {{ .root.Release.Namespace }}/{{ include "harbor-operator.fullname" .root }}-webhook
+{{- end }}
+  name: replicationpolicies.harbor.harbor-operator.io
+spec:
+{{- include "harbor-operator.crdConversion" . }}
+  group: harbor.harbor-operator.io
+  names:
+    categories:
+    - harbor
+    kind: ReplicationPolicy
+    listKind: ReplicationPolicyList
+    plural: replicationpolicies
+    singular: replicationpolicy
+  scope: Namespaced
+  versions:
+  - additionalPrinterColumns:
+    - jsonPath: .spec.sourceRegistryRef.name
+      name: Source
+      type: string
+    - jsonPath: .spec.destinationRegistryRef.name
+      name: Destination
+      type: string
+    - jsonPath: .spec.enabled
+      name: Enabled
+      type: boolean
+    - jsonPath: .status.conditions[?(@.type=="Ready")].status
+      name: Ready
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].reason
+      name: Reason
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].message
+      name: Message
+      priority: 1
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1alpha1
+    schema:
+      openAPIV3Schema:
+        description: ReplicationPolicy is the Schema for the replicationpolicies API.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: ReplicationPolicySpec defines the desired state of ReplicationPolicy.
+            properties:
+              copyByChunk:
+                description: |-
+                  CopyByChunk indicates whether to enable copy by chunk.
+                  When omitted, the operator leaves this field unset for Harbor to interpret.
+                type: boolean
+              creationPolicy:
+                description: |-
+                  CreationPolicy controls whether the operator creates or adopts the Harbor policy.
+                  When omitted, the operator's default creation policy is used.
+                enum:
+                - Create
+                - Adopt
+                - CreateOrAdopt
+                type: string
+              deletionPolicy:
+                default: Delete
+                description: |-
+                  DeletionPolicy controls what happens when the Kubernetes object is deleted.
+                  Delete removes the corresponding Harbor resource before removing the finalizer.
+                  Orphan skips Harbor-side deletion and removes the finalizer so the
+                  Kubernetes object can be deleted while leaving the Harbor resource in place.
+                  Defaults to Delete.
+                enum:
+                - Delete
+                - Orphan
+                type: string
+              description:
+                description: Description is an optional policy description.
+                type: string
+              destNamespace:
+                description: DestNamespace is the destination namespace.
+                type: string
+              destNamespaceReplaceCount:
+                default: -1
+                description: |-
+                  DestNamespaceReplaceCount controls how many path components are replaced by DestNamespace.
+                  Defaults to -1, which selects Harbor's legacy replacement behavior.
+                type: integer
+              destinationRegistryRef:
+                description: DestinationRegistryRef references a Registry CR to use
+                  as the destination.
+                properties:
+                  name:
+                    description: Name of the Registry resource.
+                    minLength: 1
+                    type: string
+                  namespace:
+                    description: Namespace of the Registry resource. Defaults to the
+                      referencing resource namespace.
+                    type: string
+                required:
+                - name
+                type: object
+              driftDetectionInterval:
+                description: |-
+                  DriftDetectionInterval is the interval at which the operator checks for drift.
+                  When omitted, the operator's default drift detection interval is used.
+                  An explicit value of 0 disables periodic drift detection.
+                type: string
+              enabled:
+                description: |-
+                  Enabled indicates whether the policy is enabled.
+                  When omitted, the operator leaves this field unset for Harbor to interpret.
+                type: boolean
+              filters:
+                description: Filters defines the replication filters.
+                items:
+                  description: ReplicationFilterSpec defines a replication filter.
+                  properties:
+                    decoration:
+                      description: Decoration defines how to interpret the filter.
+                      type: string
+                    type:
+                      description: Type defines the filter type.
+                      type: string
+                    value:
+                      description: Value defines the filter value.
+                      x-kubernetes-preserve-unknown-fields: true
+                  type: object
+                type: array
+              harborConnectionRef:
+                description: |-
+                  HarborConnectionRef references the Harbor connection object to use.
+                  When the operator is started with --harbor-connection, this field may be omitted.
+                properties:
+                  kind:
+                    default: HarborConnection
+                    description: |-
+                      Kind selects the Harbor connection object kind.
+                      Defaults to HarborConnection.
+                    enum:
+                    - HarborConnection
+                    - ClusterHarborConnection
+                    type: string
+                  name:
+                    description: Name of the referenced Harbor connection object.
+                    type: string
+                required:
+                - name
+                type: object
+              harborName:
+                description: |-
+                  HarborName is the name of the replication policy in Harbor. When omitted, it
+                  defaults to metadata.name. Set it for Harbor names that are not valid
+                  Kubernetes names. It cannot be changed after creation.
+                maxLength: 255
+                minLength: 1
+                type: string
+              managementPolicy:
+                default: Full
+                description: |-
+                  ManagementPolicy controls which changes the operator makes in Harbor.
+                  Full creates, updates and deletes the Harbor resource.
+                  ObserveOnly adopts an existing Harbor resource by name and reports in
+                  status where it differs from the spec, but never writes to Harbor.
+                  NoDelete creates and updates the Harbor resource but never deletes it,
+                  as if deletionPolicy were Orphan.
+                  Defaults to Full.
+                enum:
+                - Full
+                - ObserveOnly
+                - NoDelete
+                type: string
+              override:
+                description: |-
+                  Override indicates whether to overwrite destination resources.
+                  When omitted, the operator leaves this field unset for Harbor to interpret.
+                type: boolean
+              reconcileNonce:
+                description: ReconcileNonce forces an immediate reconcile when updated.
+                type: string
+              replicateDeletion:
+                description: |-
+                  ReplicateDeletion indicates whether delete operations are replicated.
+                  When omitted, the operator leaves this field unset for Harbor to interpret.
+                type: boolean
+              singleActiveReplication:
+                description: |-
+                  SingleActiveReplication avoids overlapping executions.
+                  When omitted, the operator leaves this field unset for Harbor to interpret.
+                type: boolean
+              sourceRegistryRef:
+                description: SourceRegistryRef references a Registry CR to use as
+                  the source.
+                properties:
+                  name:
+                    description: Name of the Registry resource.
+                    minLength: 1
+                    type: string
+                  namespace:
+                    description: Namespace of the Registry resource. Defaults to the
+                      referencing resource namespace.
+                    type: string
+                required:
+                - name
+                type: object
+              speed:
+                description: |-
+                  Speed is the speed limit for each task.
+                  When omitted, the operator leaves this field unset for Harbor to interpret.
+                type: integer
+              trigger:
+                description: Trigger defines when the replication policy runs.
+                properties:
+                  settings:
+                    description: Settings holds trigger settings.
+                    properties:
+                      cron:
+                        description: Cron is the cron expression for scheduled triggers.
+                        type: string
+                    type: object
+                  type:
+                    description: Type defines the trigger type (manual, event_based,
+                      scheduled).
+                    enum:
+                    - manual
+                    - event_based
+                    - scheduled
+                    type: string
+                type: object
+            type: object
+            x-kubernetes-validations:
+            - message: sourceRegistryRef is required
+              rule: has(self.sourceRegistryRef)
+            - message: destinationRegistryRef is required
+              rule: has(self.destinationRegistryRef)
+            - message: trigger.settings.cron must be set when trigger.type is scheduled
+              rule: '!has(self.trigger) || self.trigger.type != ''scheduled'' || (has(self.trigger.settings)
+                && has(self.trigger.settings.cron))'
+            - message: registry references are immutable; delete and recreate the
+                ReplicationPolicy
+              rule: '!has(oldSelf.sourceRegistryRef) || (has(self.sourceRegistryRef)
+                && self.sourceRegistryRef == oldSelf.sourceRegistryRef && has(self.destinationRegistryRef)
+                && self.destinationRegistryRef == oldSelf.destinationRegistryRef)'
+            - message: harborName is immutable
+              rule: has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName)
+                || self.harborName == oldSelf.harborName)
+          status:
+            description: ReplicationPolicyStatus defines the observed state of ReplicationPolicy.
+            properties:
+              conditions:
+                description: Conditions represent the latest available observations
+                  of the resource's state.
+                items:
+                  description: Condition contains details for one aspect of the current
+                    state of this API Resource.
+                  properties:
+                    lastTransitionTime:
+                      description: |-
+                        lastTransitionTime is the last time the condition transitioned from one status to another.
+                        This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+                      format: date-time
+                      type: string
+                    message:
+                      description: |-
+                        message is a human readable message indicating details about the transition.
+                        This may be an empty string.
+                      maxLength: 32768
+                      type: string
+                    observedGeneration:
+                      description: |-
+                        observedGeneration represents the .metadata.generation that the condition was set based upon.
+                        For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+                        with respect to the current state of the instance.
+                      format: int64
+                      minimum: 0
+                      type: integer
+                    reason:
+                      description: |-
+                        reason contains a programmatic identifier indicating the reason for the condition's last transition.
+                        Producers of specific condition types may define expected values and meanings for this field,
+                        and whether the values are considered a guaranteed API.
+                        The value should be a CamelCase string.
+                        This field may not be empty.
+                      maxLength: 1024
+                      minLength: 1
+                      pattern: ^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$
+                      type: string
+                    status:
+                      description: status of the condition, one of True, False, Unknown.
+                      enum:
+                      - "True"
+                      - "False"
+                      - Unknown
+                      type: string
+                    type:
+                      description: type of condition in CamelCase or in foo.example.com/CamelCase.
+                      maxLength: 316
+                      pattern: ^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$
+                      type: string
+                  required:
+                  - lastTransitionTime
+                  - message
+                  - reason
+                  - status
+                  - type
+                  type: object
+                type: array
+              drift:
+                description: |-
+                  Drift describes the most recent change made in Harbor outside the
+                  operator. It is kept after the operator corrects the change and is
+                  replaced when a different change is found.
+                properties:
+                  detectedAt:
+                    description: DetectedAt is when the difference was first found.
+                    format: date-time
+                    type: string
+                  fields:
+                    description: Fields lists the differing fields of the Harbor object.
+                    items:
+                      description: DriftField is a single field that differed between
+                        the spec and Harbor.
+                      properties:
+                        desired:
+                          description: |-
+                            Desired is the JSON value derived from the spec. Secret values are
+                            redacted.
+                          type: string
+                        observed:
+                          description: Observed is the JSON value found in Harbor.
+                            Secret values are redacted.
+                          type: string
+                        path:
+                          description: |-
+                            Path is the JSON path of the field in the Harbor API representation,
+                            such as $.metadata.public.
+                          type: string
+                      required:
+                      - path
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - detectedAt
+                - fields
+                type: object
+              harborReplicationPolicyID:
+                description: HarborReplicationPolicyID is the ID of the policy in
+                  Harbor.
+                type: integer
+              observedGeneration:
+                description: ObservedGeneration is the most recent generation observed
+                  by the controller.
+                format: int64
+                type: integer
+              resolvedHarborConnection:
+                description: |-
+                  ResolvedHarborConnection is the exact connection identity used for Harbor
+                  operations. Once set, changing the effective connection is refused.
+                properties:
+                  kind:
+                    description: Kind is either HarborConnection or ClusterHarborConnection.
+                    type: string
+                  name:
+                    description: Name is the name of the connection object.
+                    type: string
+                  namespace:
+                    description: Namespace is set for a namespaced HarborConnection.
+                    type: string
+                  uid:
+                    description: UID is the Kubernetes UID of the connection object.
+                    type: string
+                required:
+                - kind
+                - name
+                - uid
+                type: object
+            type: object
+        type: object
+    served: true
+    storage: true
+    subresources:
+      status: {}
+  - additionalPrinterColumns:
+    - jsonPath: .spec.sourceRegistryRef.name
+      name: Source
+      type: string
+    - jsonPath: .spec.destinationRegistryRef.name
+      name: Destination
+      type: string
+    - jsonPath: .spec.enabled
+      name: Enabled
+      type: boolean
+    - jsonPath: .status.conditions[?(@.type=="Ready")].status
+      name: Ready
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].reason
+      name: Reason
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].message
+      name: Message
+      priority: 1
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1beta1
+    schema:
+      openAPIV3Schema:
+        description: ReplicationPolicy is the Schema for the replicationpolicies API.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: ReplicationPolicySpec defines the desired state of ReplicationPolicy.
+            properties:
+              copyByChunk:
+                description: |-
+                  CopyByChunk indicates whether to enable copy by chunk.
+                  When omitted, the operator leaves this field unset for Harbor to interpret.
+                type: boolean
+              creationPolicy:
+                description: |-
+                  CreationPolicy controls whether the operator creates or adopts the Harbor policy.
+                  When omitted, the operator's default creation policy is used.
+                enum:
+                - Create
+                - Adopt
+                - CreateOrAdopt
+                type: string
+              deletionPolicy:
+                default: Delete
+                description: |-
+                  DeletionPolicy controls what happens when the Kubernetes object is deleted.
+                  Delete removes the corresponding Harbor resource before removing the finalizer.
+                  Orphan skips Harbor-side deletion and removes the finalizer so the
+                  Kubernetes object can be deleted while leaving the Harbor resource in place.
+                  Defaults to Delete.
+                enum:
+                - Delete
+                - Orphan
+                type: string
+              description:
+                description: Description is an optional policy description.
+                type: string
+              destNamespace:
+                description: DestNamespace is the destination namespace.
+                type: string
+              destNamespaceReplaceCount:
+                default: -1
+                description: |-
+                  DestNamespaceReplaceCount controls how many path components are replaced by DestNamespace.
+                  Defaults to -1, which selects Harbor's legacy replacement behavior.
+                type: integer
+              destinationRegistryRef:
+                description: DestinationRegistryRef references a Registry CR to use
+                  as the destination.
+                properties:
+                  name:
+                    description: Name of the Registry resource.
+                    minLength: 1
+                    type: string
+                  namespace:
+                    description: Namespace of the Registry resource. Defaults to the
+                      referencing resource namespace.
+                    type: string
+                required:
+                - name
+                type: object
+              driftDetectionInterval:
+                description: |-
+                  DriftDetectionInterval is the interval at which the operator checks for drift.
+                  When omitted, the operator's default drift detection interval is used.
+                  An explicit value of 0 disables periodic drift detection.
+                type: string
+              enabled:
+                description: |-
+                  Enabled indicates whether the policy is enabled.
+                  When omitted, the operator leaves this field unset for Harbor to interpret.
+                type: boolean
+              filters:
+                description: Filters select the artifacts to replicate.
+                properties:
+                  labels:
+                    description: Labels selects artifacts by their Harbor labels.
+                    properties:
+                      decoration:
+                        default: matches
+                        description: |-
+                          Decoration selects whether matching artifacts are included or excluded.
+                          Defaults to matches.
+                        enum:
+                        - matches
+                        - excludes
+                        type: string
+                      names:
+                        description: Names are the label names an artifact must carry.
+                        items:
+                          type: string
+                        minItems: 1
+                        type: array
+                    required:
+                    - names
+                    type: object
+                  name:
+                    description: |-
+                      Name is a doublestar pattern matched against the repository name,
+                      such as "library/**".
+                    type: string
+                  resource:
+                    description: Resource selects the kind of artifacts to replicate.
+                    enum:
+                    - image
+                    - artifact
+                    type: string
+                  tag:
+                    description: Tag selects artifacts by tag.
+                    properties:
+                      decoration:
+                        default: matches
+                        description: |-
+                          Decoration selects whether matching tags are included or excluded.
+                          Defaults to matches.
+                        enum:
+                        - matches
+                        - excludes
+                        type: string
+                      pattern:
+                        description: Pattern is a doublestar pattern matched against
+                          the tag.
+                        type: string
+                    required:
+                    - pattern
+                    type: object
+                type: object
+              harborConnectionRef:
+                description: |-
+                  HarborConnectionRef references the Harbor connection object to use.
+                  When the operator is started with --harbor-connection, this field may be omitted.
+                properties:
+                  kind:
+                    default: HarborConnection
+                    description: |-
+                      Kind selects the Harbor connection object kind.
+                      Defaults to HarborConnection.
+                    enum:
+                    - HarborConnection
+                    - ClusterHarborConnection
+                    type: string
+                  name:
+                    description: Name of the referenced Harbor connection object.
+                    type: string
+                required:
+                - name
+                type: object
+              harborName:
+                description: |-
+                  HarborName is the name of the replication policy in Harbor. When omitted, it
+                  defaults to metadata.name. Set it for Harbor names that are not valid
+                  Kubernetes names. It cannot be changed after creation.
+                maxLength: 255
+                minLength: 1
+                type: string
+              managementPolicy:
+                default: Full
+                description: |-
+                  ManagementPolicy controls which changes the operator makes in Harbor.
+                  Full creates, updates and deletes the Harbor resource.
+                  ObserveOnly adopts an existing Harbor resource by name and reports in
+                  status where it differs from the spec, but never writes to Harbor.
+                  NoDelete creates and updates the Harbor resource but never deletes it,
+                  as if deletionPolicy were Orphan.
+                  Defaults to Full.
+                enum:
+                - Full
+                - ObserveOnly
+                - NoDelete
+                type: string
+              override:
+                description: |-
+                  Override indicates whether to overwrite destination resources.
+                  When omitted, the operator leaves this field unset for Harbor to interpret.
+                type: boolean
+              reconcileNonce:
+                description: ReconcileNonce forces an immediate reconcile when updated.
+                type: string
+              replicateDeletion:
+                description: |-
+                  ReplicateDeletion indicates whether delete operations are replicated.
+                  When omitted, the operator leaves this field unset for Harbor to interpret.
+                type: boolean
+              singleActiveReplication:
+                description: |-
+                  SingleActiveReplication avoids overlapping executions.
+                  When omitted, the operator leaves this field unset for Harbor to interpret.
+                type: boolean
+              sourceRegistryRef:
+                description: SourceRegistryRef references a Registry CR to use as
+                  the source.
+                properties:
+                  name:
+                    description: Name of the Registry resource.
+                    minLength: 1
+                    type: string
+                  namespace:
+                    description: Namespace of the Registry resource. Defaults to the
+                      referencing resource namespace.
+                    type: string
+                required:
+                - name
+                type: object
+              speed:
+                description: |-
+                  Speed is the speed limit for each task.
+                  When omitted, the operator leaves this field unset for Harbor to interpret.
+                type: integer
+              trigger:
+                description: Trigger defines when the replication policy runs.
+                properties:
+                  schedule:
+                    description: Schedule is the six-field cron expression for Scheduled
+                      triggers.
+                    type: string
+                  type:
+                    description: Type selects when the policy runs.
+                    enum:
+                    - Manual
+                    - EventBased
+                    - Scheduled
+                    type: string
+                required:
+                - type
+                type: object
+                x-kubernetes-validations:
+                - message: schedule must be set when type is Scheduled
+                  rule: self.type != 'Scheduled' || size(self.schedule) > 0
+            type: object
+            x-kubernetes-validations:
+            - message: sourceRegistryRef is required
+              rule: has(self.sourceRegistryRef)
+            - message: destinationRegistryRef is required
+              rule: has(self.destinationRegistryRef)
+            - message: registry references are immutable; delete and recreate the
+                ReplicationPolicy
+              rule: '!has(oldSelf.sourceRegistryRef) || (has(self.sourceRegistryRef)
+                && self.sourceRegistryRef == oldSelf.sourceRegistryRef && has(self.destinationRegistryRef)
+                && self.destinationRegistryRef == oldSelf.destinationRegistryRef)'
+            - message: harborName is immutable
+              rule: has(self.harborName) == has(oldSelf.harborName) && (!has(self.harborName)
+                || self.harborName == oldSelf.harborName)
+          status:
+            description: ReplicationPolicyStatus defines the observed state of ReplicationPolicy.
+            properties:
+              conditions:
+                description: Conditions represent the latest available observations
+                  of the resource's state.
+                items:
+                  description: Condition contains details for one aspect of the current
+                    state of this API Resource.
+                  properties:
+                    lastTransitionTime:
+                      description: |-
+                        lastTransitionTime is the last time the condition transitioned from one status to another.
+                        This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+                      format: date-time
+                      type: string
+                    message:
+                      description: |-
+                        message is a human readable message indicating details about the transition.
+                        This may be an empty string.
+                      maxLength: 32768
+                      type: string
+                    observedGeneration:
+                      description: |-
+                        observedGeneration represents the .metadata.generation that the condition was set based upon.
+                        For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+                        with respect to the current state of the instance.
+                      format: int64
+                      minimum: 0
+                      type: integer
+                    reason:
+                      description: |-
+                        reason contains a programmatic identifier indicating the reason for the condition's last transition.
+                        Producers of specific condition types may define expected values and meanings for this field,
+                        and whether the values are considered a guaranteed API.
+                        The value should be a CamelCase string.
+                        This field may not be empty.
+                      maxLength: 1024
+                      minLength: 1
+                      pattern: ^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$
+                      type: string
+                    status:
+                      description: status of the condition, one of True, False, Unknown.
+                      enum:
+                      - "True"
+                      - "False"
+                      - Unknown
+                      type: string
+                    type:
+                      description: type of condition in CamelCase or in foo.example.com/CamelCase.
+                      maxLength: 316
+                      pattern: ^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$
+                      type: string
+                  required:
+                  - lastTransitionTime
+                  - message
+                  - reason
+                  - status
+                  - type
+                  type: object
+                type: array
+              drift:
+                description: |-
+                  Drift describes the most recent change made in Harbor outside the
+                  operator. It is kept after the operator corrects the change and is
+                  replaced when a different change is found.
+                properties:
+                  detectedAt:
+                    description: DetectedAt is when the difference was first found.
+                    format: date-time
+                    type: string
+                  fields:
+                    description: Fields lists the differing fields of the Harbor object.
+                    items:
+                      description: DriftField is a single field that differed between
+                        the spec and Harbor.
+                      properties:
+                        desired:
+                          description: |-
+                            Desired is the JSON value derived from the spec. Secret values are
+                            redacted.
+                          type: string
+                        observed:
+                          description: Observed is the JSON value found in Harbor.
+                            Secret values are redacted.
+                          type: string
+                        path:
+                          description: |-
+                            Path is the JSON path of the field in the Harbor API representation,
+                            such as $.metadata.public.
+                          type: string
+                      required:
+                      - path
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - detectedAt
+                - fields
+                type: object
+              harborReplicationPolicyID:
+                description: HarborReplicationPolicyID is the ID of the policy in
+                  Harbor.
+                type: integer
+              observedGeneration:
+                description: ObservedGeneration is the most recent generation observed
+                  by the controller.
+                format: int64
+                type: integer
+              resolvedHarborConnection:
+                description: |-
+                  ResolvedHarborConnection is the exact connection identity used for Harbor
+                  operations. Once set, changing the effective connection is refused.
+                properties:
+                  kind:
+                    description: Kind is either HarborConnection or ClusterHarborConnection.
+                    type: string
+                  name:
+                    description: Name is the name of the connection object.
+                    type: string
+                  namespace:
+                    description: Namespace is set for a namespaced HarborConnection.
+                    type: string
+                  uid:
+                    description: UID is the Kubernetes UID of the connection object.
+                    type: string
+                required:
+                - kind
+                - name
+                - uid
+                type: object
+            type: object
+        type: object
+    served: {{ .root.Values.webhook.enabled }}
+    storage: false
+    subresources:
+      status: {}
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    controller-gen.kubebuilder.io/version: v0.20.1
+    helm.sh/resource-policy: keep
+{{- if and .root.Values.webhook.enabled .root.Values.webhook.certManager.enabled }}
+    cert-manager.io/inject-ca-from: {{ .root.Release.Namespace }}/{{ include "harbor-operator.fullname" .root }}-webhook
+{{- end }}
+  name: retentionpolicies.harbor.harbor-operator.io
+spec:
+{{- include "harbor-operator.crdConversion" . }}
+  group: harbor.harbor-operator.io
+  names:
+    categories:
+    - harbor
+    kind: RetentionPolicy
+    listKind: RetentionPolicyList
+    plural: retentionpolicies
+    singular: retentionpolicy
+  scope: Namespaced
+  versions:
+  - additionalPrinterColumns:
+    - jsonPath: .spec.projectRef.name
+      name: Project
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].status
+      name: Ready
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].reason
+      name: Reason
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].message
+      name: Message
+      priority: 1
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1alpha1
+    schema:
+      openAPIV3Schema:
+        description: RetentionPolicy is the Schema for the retentionpolicies API.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: RetentionPolicySpec defines the desired state of a retention
+              policy.
+            properties:
+              deletionPolicy:
+                default: Delete
+                description: |-
+                  DeletionPolicy controls what happens when the Kubernetes object is deleted.
+                  Delete removes the corresponding Harbor resource before removing the finalizer.
+                  Orphan skips Harbor-side deletion and removes the finalizer so the
+                  Kubernetes object can be deleted while leaving the Harbor resource in place.
+                  Defaults to Delete.
+                enum:
+                - Delete
+                - Orphan
+                type: string
+              driftDetectionInterval:
+                description: |-
+                  DriftDetectionInterval is the interval at which the operator checks for drift.
+                  When omitted, the operator's default drift detection interval is used.
+                  An explicit value of 0 disables periodic drift detection.
+                type: string
+              harborConnectionRef:
+                description: |-
+                  HarborConnectionRef references the Harbor connection object to use.
+                  When the operator is started with --harbor-connection, this field may be omitted.
+                properties:
+                  kind:
+                    default: HarborConnection
+                    description: |-
+                      Kind selects the Harbor connection object kind.
+                      Defaults to HarborConnection.
+                    enum:
+                    - HarborConnection
+                    - ClusterHarborConnection
+                    type: string
+                  name:
+                    description: Name of the referenced Harbor connection object.
+                    type: string
+                required:
+                - name
+                type: object
+              managementPolicy:
+                default: Full
+                description: |-
+                  ManagementPolicy controls which changes the operator makes in Harbor.
+                  Full creates, updates and deletes the Harbor resource.
+                  ObserveOnly adopts an existing Harbor resource by name and reports in
+                  status where it differs from the spec, but never writes to Harbor.
+                  NoDelete creates and updates the Harbor resource but never deletes it,
+                  as if deletionPolicy were Orphan.
+                  Defaults to Full.
+                enum:
+                - Full
+                - ObserveOnly
+                - NoDelete
+                type: string
+              projectRef:
+                description: |-
+                  ProjectRef references a Project CR to derive the Harbor project ID.
+                  When set, scope.ref is resolved from the Project status and scope.level is forced to "project".
+                properties:
+                  name:
+                    description: Name of the Project resource.
+                    minLength: 1
+                    type: string
+                  namespace:
+                    description: Namespace of the Project resource. Defaults to the
+                      referencing resource namespace.
+                    type: string
+                required:
+                - name
+                type: object
+              reconcileNonce:
+                description: ReconcileNonce forces an immediate reconcile when updated.
+                type: string
+              rules:
+                description: Rules defines the retention rules.
+                items:
+                  description: RetentionRule defines a retention rule.
+                  properties:
+                    action:
+                      description: Action defines the rule action, e.g. "delete".
+                      type: string
+                    disabled:
+                      default: false
+                      description: Disabled indicates whether the rule is disabled.
+                        Defaults to false.
+                      type: boolean
+                    params:
+                      additionalProperties:
+                        x-kubernetes-preserve-unknown-fields: true
+                      description: Params holds template parameters.
+                      type: object
+                    scopeSelectors:
+                      additionalProperties:
+                        items:
+                          description: RetentionSelector defines a selector.
+                          properties:
+                            decoration:
+                              description: Decoration defines selector decoration.
+                              type: string
+                            extras:
+                              description: Extras defines extra selector details.
+                              type: string
+                            kind:
+                              description: Kind defines selector kind.
+                              type: string
+                            pattern:
+                              description: Pattern defines selector pattern.
+                              type: string
+                          type: object
+                        type: array
+                      description: ScopeSelectors define the scope selectors.
+                      type: object
+                    tagSelectors:
+                      description: TagSelectors define the tag selectors.
+                      items:
+                        description: RetentionSelector defines a selector.
+                        properties:
+                          decoration:
+                            description: Decoration defines selector decoration.
+                            type: string
+                          extras:
+                            description: Extras defines extra selector details.
+                            type: string
+                          kind:
+                            description: Kind defines selector kind.
+                            type: string
+                          pattern:
+                            description: Pattern defines selector pattern.
+                            type: string
+                        type: object
+                      type: array
+                    template:
+                      description: Template defines the rule template.
+                      type: string
+                  type: object
+                minItems: 1
+                type: array
+              scope:
+                description: Scope defines the policy scope.
+                properties:
+                  level:
+                    description: Level defines scope level, e.g. "project".
+                    type: string
+                  ref:
+                    description: Ref is the scope reference.
+                    type: integer
+                type: object
+              trigger:
+                description: Trigger defines when the retention policy runs.
+                properties:
+                  kind:
+                    description: Kind defines trigger kind.
+                    type: string
+                  references:
+                    additionalProperties:
+                      x-kubernetes-preserve-unknown-fields: true
+                    description: References holds trigger references.
+                    type: object
+                  settings:
+                    additionalProperties:
+                      x-kubernetes-preserve-unknown-fields: true
+                    description: Settings holds trigger settings.
+                    type: object
+                type: object
+            required:
+            - rules
+            type: object
+            x-kubernetes-validations:
+            - message: trigger is required
+              rule: has(self.trigger)
+            - message: scope.ref must be empty and scope.level must be empty or 'project'
+                when projectRef is set
+              rule: '!has(self.projectRef) || !has(self.scope) || (self.scope.ref
+                == 0 && (size(self.scope.level) == 0 || self.scope.level == ''project''))'
+            - message: projectRef is immutable; delete and recreate the RetentionPolicy
+              rule: '!has(oldSelf.projectRef) || (has(self.projectRef) && self.projectRef
+                == oldSelf.projectRef)'
+          status:
+            description: RetentionPolicyStatus defines the observed state of RetentionPolicy.
+            properties:
+              conditions:
+                description: Conditions represent the latest available observations
+                  of the resource's state.
+                items:
+                  description: Condition contains details for one aspect of the current
+                    state of this API Resource.
+                  properties:
+                    lastTransitionTime:
+                      description: |-
+                        lastTransitionTime is the last time the condition transitioned from one status to another.
+                        This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+                      format: date-time
+                      type: string
+                    message:
+                      description: |-
+                        message is a human readable message indicating details about the transition.
+                        This may be an empty string.
+                      maxLength: 32768
+                      type: string
+                    observedGeneration:
+                      description: |-
+                        observedGeneration represents the .metadata.generation that the condition was set based upon.
+                        For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+                        with respect to the current state of the instance.
+                      format: int64
+                      minimum: 0
+                      type: integer
+                    reason:
+                      description: |-
+                        reason contains a programmatic identifier indicating the reason for the condition's last transition.
+                        Producers of specific condition types may define expected values and meanings for this field,
+                        and whether the values are considered a guaranteed API.
+                        The value should be a CamelCase string.
+                        This field may not be empty.
+                      maxLength: 1024
+                      minLength: 1
+                      pattern: ^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$
+                      type: string
+                    status:
+                      description: status of the condition, one of True, False, Unknown.
+                      enum:
+                      - "True"
+                      - "False"
+                      - Unknown
+                      type: string
+                    type:
+                      description: type of condition in CamelCase or in foo.example.com/CamelCase.
+                      maxLength: 316
+                      pattern: ^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$
+                      type: string
+                  required:
+                  - lastTransitionTime
+                  - message
+                  - reason
+                  - status
+                  - type
+                  type: object
+                type: array
+              drift:
+                description: |-
+                  Drift describes the most recent change made in Harbor outside the
+                  operator. It is kept after the operator corrects the change and is
+                  replaced when a different change is found.
+                properties:
+                  detectedAt:
+                    description: DetectedAt is when the difference was first found.
+                    format: date-time
+                    type: string
+                  fields:
+                    description: Fields lists the differing fields of the Harbor object.
+                    items:
+                      description: DriftField is a single field that differed between
+                        the spec and Harbor.
+                      properties:
+                        desired:
+                          description: |-
+                            Desired is the JSON value derived from the spec. Secret values are
+                            redacted.
+                          type: string
+                        observed:
+                          description: Observed is the JSON value found in Harbor.
+                            Secret values are redacted.
+                          type: string
+                        path:
+                          description: |-
+                            Path is the JSON path of the field in the Harbor API representation,
+                            such as $.metadata.public.
+                          type: string
+                      required:
+                      - path
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - detectedAt
+                - fields
+                type: object
+              harborRetentionID:
+                description: HarborRetentionID is the ID of the retention policy in
+                  Harbor.
+                type: integer
+              observedGeneration:
+                description: ObservedGeneration is the most recent generation observed
+                  by the controller.
+                format: int64
+                type: integer
+              resolvedHarborConnection:
+                description: |-
+                  ResolvedHarborConnection is the exact connection identity used for Harbor
+                  operations. Once set, changing the effective connection is refused.
+                properties:
+                  kind:
+                    description: Kind is either HarborConnection or ClusterHarborConnection.
+                    type: string
+                  name:
+                    description: Name is the name of the connection object.
+                    type: string
+                  namespace:
+                    description: Namespace is set for a namespaced HarborConnection.
+                    type: string
+                  uid:
+                    description: UID is the Kubernetes UID of the connection object.
+                    type: string
+                required:
+                - kind
+                - name
+                - uid
+                type: object
+            type: object
+        type: object
+    served: true
+    storage: true
+    subresources:
+      status: {}
+  - additionalPrinterColumns:
+    - jsonPath: .spec.projectRef.name
+      name: Project
+      type: string
+    - jsonPath: .spec.schedule
+      name: Schedule
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].status
+      name: Ready
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].reason
+      name: Reason
+      type: string
+    - jsonPath: .status.conditions[?(@.type=="Ready")].message
+      name: Message
+      priority: 1
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1beta1
+    schema:
+      openAPIV3Schema:
+        description: RetentionPolicy is the Schema for the retentionpolicies API.
+        properties:
+          apiVersion:
+            description: |-
+              APIVersion defines the versioned schema of this representation of an object.
+              Servers should convert recognized schemas to the latest internal value, and
+              may reject unrecognized values.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+            type: string
+          kind:
+            description: |-
+              Kind is a string value representing the REST resource this object represents.
+              Servers may infer this from the endpoint the client submits requests to.
+              Cannot be updated.
+              In CamelCase.
+              More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: RetentionPolicySpec defines the desired state of a retention
+              policy.
+            properties:
+              deletionPolicy:
+                default: Delete
+                description: |-
+                  DeletionPolicy controls what happens when the Kubernetes object is deleted.
+                  Delete removes the corresponding Harbor resource before removing the finalizer.
+                  Orphan skips Harbor-side deletion and removes the finalizer so the
+                  Kubernetes object can be deleted while leaving the Harbor resource in place.
+                  Defaults to Delete.
+                enum:
+                - Delete
+                - Orphan
+                type: string
+              driftDetectionInterval:
+                description: |-
+                  DriftDetectionInterval is the interval at which the operator checks for drift.
+                  When omitted, the operator's default drift detection interval is used.
+                  An explicit value of 0 disables periodic drift detection.
+                type: string
+              harborConnectionRef:
+                description: |-
+                  HarborConnectionRef references the Harbor connection object to use.
+                  When the operator is started with --harbor-connection, this field may be omitted.
+                properties:
+                  kind:
+                    default: HarborConnection
+                    description: |-
+                      Kind selects the Harbor connection object kind.
+                      Defaults to HarborConnection.
+                    enum:
+                    - HarborConnection
+                    - ClusterHarborConnection
+                    type: string
+                  name:
+                    description: Name of the referenced Harbor connection object.
+                    type: string
+                required:
+                - name
+                type: object
+              managementPolicy:
+                default: Full
+                description: |-
+                  ManagementPolicy controls which changes the operator makes in Harbor.
+                  Full creates, updates and deletes the Harbor resource.
+                  ObserveOnly adopts an existing Harbor resource by name and reports in
+                  status where it differs from the spec, but never writes to Harbor.
+                  NoDelete creates and updates the Harbor resource but never deletes it,
+                  as if deletionPolicy were Orphan.
+                  Defaults to Full.
+                enum:
+                - Full
+                - ObserveOnly
+                - NoDelete
+                type: string
+              projectRef:
+                description: |-
+                  ProjectRef references a Project CR to derive the Harbor project ID.
+                  When set, scope.ref is resolved from the Project status and scope.level is forced to "project".
+                properties:
+                  name:
+                    description: Name of the Project resource.
+                    minLength: 1
+                    type: string
+                  namespace:
+                    description: Namespace of the Project resource. Defaults to the
+                      referencing resource namespace.
+                    type: string
+                required:
+                - name
+                type: object
+              reconcileNonce:
+                description: ReconcileNonce forces an immediate reconcile when updated.
+                type: string
+              rules:
+                description: |-
+                  Rules defines the retention rules. An artifact is retained when any
+                  enabled rule retains it.
+                items:
+                  description: RetentionRule defines a retention rule.
+                  properties:
+                    disabled:
+                      default: false
+                      description: Disabled indicates whether the rule is disabled.
+                        Defaults to false.
+                      type: boolean
+                    repositorySelectors:
+                      description: RepositorySelectors select the repositories the
+                        rule applies to.
+                      items:
+                        description: RepositorySelector selects artifacts by repository.
+                        properties:
+                          decoration:
+                            default: matches
+                            description: |-
+                              Decoration selects whether matching repositories are included or excluded.
+                              Defaults to matches.
+                            enum:
+                            - matches
+                            - excludes
+                            type: string
+                          pattern:
+                            description: |-
+                              Pattern is a doublestar pattern matched against the repository name,
+                              such as "team/**".
+                            type: string
+                        required:
+                        - pattern
+                        type: object
+                      type: array
+                    tagSelectors:
+                      description: TagSelectors select the tags the rule applies to.
+                      items:
+                        description: TagSelector selects artifacts by tag.
+                        properties:
+                          decoration:
+                            default: matches
+                            description: |-
+                              Decoration selects whether matching tags are included or excluded.
+                              Defaults to matches.
+                            enum:
+                            - matches
+                            - excludes
+                            type: string
+                          pattern:
+                            description: Pattern is a doublestar pattern matched against
+                              the tag, such as "v*".
+                            type: string
+                          untagged:
+                            description: Untagged also selects artifacts without a
+                              tag.
+                            type: boolean
+                        required:
+                        - pattern
+                        type: object
+                      type: array
+                    template:
+                      description: Template selects which artifacts the rule retains.
+                      enum:
+                      - always
+                      - nothing
+                      - latestPushedK
+                      - latestPulledN
+                      - latestActiveK
+                      - nDaysSinceLastPush
+                      - nDaysSinceLastPull
+                      - lastXDays
+                      type: string
+                    value:
+                      description: |-
+                        Value is the template parameter: the number of artifacts for the
+                        latest* templates, or the number of days for the day-based templates.
+                      format: int64
+                      minimum: 0
+                      type: integer
+                  required:
+                  - template
+                  type: object
+                  x-kubernetes-validations:
+                  - message: value is required by the template
+                    rule: self.template in ['always', 'nothing'] || has(self.value)
+                minItems: 1
+                type: array
+              schedule:
+                description: |-
+                  Schedule is the six-field cron expression on which the policy runs,
+                  such as "0 0 0 * * *". When omitted, the policy only runs when
+                  triggered in Harbor.
+                type: string
+              scope:
+                description: Scope defines the policy scope.
+                properties:
+                  level:
+                    description: Level defines scope level, e.g. "project".
+                    type: string
+                  ref:
+                    description: Ref is the scope reference.
+                    type: integer
+                type: object
+            required:
+            - rules
+            type: object
+            x-kubernetes-validations:
+            - message: scope.ref must be empty and scope.level must be empty or 'project'
+                when projectRef is set
+              rule: '!has(self.projectRef) || !has(self.scope) || (self.scope.ref
+                == 0 && (size(self.scope.level) == 0 || self.scope.level == ''project''))'
+            - message: projectRef is immutable; delete and recreate the RetentionPolicy
+              rule: '!has(oldSelf.projectRef) || (has(self.projectRef) && self.projectRef
+                == oldSelf.projectRef)'
+          status:
+            description: RetentionPolicyStatus defines the observed state of RetentionPolicy.
+            properties:
+              conditions:
+                description: Conditions represent the latest available observations
+                  of the resource's state.
+                items:
+                  description: Condition contains details for one aspect of the current
+                    state of this API Resource.
+                  properties:
+                    lastTransitionTime:
+                      description: |-
+                        lastTransitionTime is the last time the condition transitioned from one status to another.
+                        This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+                      format: date-time
+                      type: string
+                    message:
+                      description: |-
+                        message is a human readable message indicating details about the transition.
+                        This may be an empty string.
+                      maxLength: 32768
+                      type: string
+                    observedGeneration:
+                      description: |-
+                        observedGeneration represents the .metadata.generation that the condition was set based upon.
+                        For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+                        with respect to the current state of the instance.
+                      format: int64
+                      minimum: 0
+                      type: integer
+                    reason:
+                      description: |-
+                        reason contains a programmatic identifier indicating the reason for the condition's last transition.
+                        Producers of specific condition types may define expected values and meanings for this field,
+                        and whether the values are considered a guaranteed API.
+                        The value should be a CamelCase string.
+                        This field may not be empty.
+                      maxLength: 1024
+                      minLength: 1
+                      pattern: ^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$
+                      type: string
+                    status:
+                      description: status of the condition, one of True, False, Unknown.
+                      enum:
+                      - "True"
+                      - "False"
+                      - Unknown
+                      type: string
+                    type:
+                      description: type of condition in CamelCase or in foo.example.com/CamelCase.
+                      maxLength: 316
+                      pattern: ^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$
+                      type: string
+                  required:
+                  - lastTransitionTime
+                  - message
+                  - reason
+                  - status
+                  - type
+                  type: object
+                type: array
+              drift:
+                description: |-
+                  Drift describes the most recent change made in Harbor outside the
+                  operator. It is kept after the operator corrects the change and is
+                  replaced when a different change is found.
+                properties:
+                  detectedAt:
+                    description: DetectedAt is when the difference was first found.
+                    format: date-time
+                    type: string
+                  fields:
+                    description: Fields lists the differing fields of the Harbor object.
+                    items:
+                      description: DriftField is a single field that differed between
+                        the spec and Harbor.
+                      properties:
+                        desired:
+                          description: |-
+                            Desired is the JSON value derived from the spec. Secret values are
+                            redacted.
+                          type: string
+                        observed:
+                          description: Observed is the JSON value found in Harbor.
+                            Secret values are redacted.
+                          type: string
+                        path:
+                          description: |-
+                            Path is the JSON path of the field in the Harbor API representation,
+                            such as $.metadata.public.
+                          type: string
+                      required:
+                      - path
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - detectedAt
+                - fields
+                type: object
+              harborRetentionID:
+                description: HarborRetentionID is the ID of the retention policy in
+                  Harbor.
+                type: integer
+              observedGeneration:
+                description: ObservedGeneration is the most recent generation observed
+                  by the controller.
+                format: int64
+                type: integer
+              resolvedHarborConnection:
+                description: |-
+                  ResolvedHarborConnection is the exact connection identity used for Harbor
+                  operations. Once set, changing the effective connection is refused.
+                properties:
+                  kind:
+                    description: Kind is either HarborConnection or ClusterHarborConnection.
+                    type: string
+                  name:
+                    description: Name is the name of the connection object.
+                    type: string
+                  namespace:
+                    description: Namespace is set for a namespaced HarborConnection.
+                    type: string
+                  uid:
+                    description: UID is the Kubernetes UID of the connection object.
+                    type: string
+                required:
+                - kind
+                - name
+                - uid
+                type: object
+            type: object
+        type: object
+    served: {{ .root.Values.webhook.enabled }}
+    storage: false
+    subresources:
+      status: {}
+{{- end -}}
@@ -0,0 +1,593 @@
+{{- /* Generated by hack/sync-chart-webhooks.sh from config/webhook/manifests.yaml. */ -}}
+{{- define "harbor-operator.webhooks" -}}
+webhooks:
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-clusterharborconnection
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vclusterharborconnection.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - clusterharborconnections
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-configuration
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vconfiguration.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - configurations
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-gcschedule
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vgcschedule.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - gcschedules
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-harborconnection
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vharborconnection.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - harborconnections
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-immutabletagrule
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vimmutabletagrule.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - immutabletagrules
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-label
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vlabel.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - labels
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-member
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vmember.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - members
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-project
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vproject.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - projects
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-purgeauditschedule
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vpurgeauditschedule.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - purgeauditschedules
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-quota
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vquota.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - quotas
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-registry
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vregistry.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - registries
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-replicationpolicy
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vreplicationpolicy.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - replicationpolicies
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-retentionpolicy
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vretentionpolicy.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - retentionpolicies
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-robot
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vrobot.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - robots
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-scanallschedule
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vscanallschedule.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - scanallschedules
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-scannerregistration
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vscannerregistration.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - scannerregistrations
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-user
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vuser.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - users
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-usergroupclaim
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vusergroupclaim.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - usergroupclaims
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+- admissionReviewVersions:
+  - v1
+  clientConfig:
+{{- with .caBundle }}
+    caBundle: {{ . }}
+{{- end }}
+    service:
+      name: {{ include "harbor-operator.fullname" .root }}-webhook
+      namespace: {{ .root.Release.Namespace }}
+      path: /validate-harbor-harbor-operator-io-v1alpha1-webhookpolicy
+  failurePolicy: {{ .root.Values.webhook.failurePolicy }}
+  name: vwebhookpolicy.harbor.harbor-operator.io
+  rules:
+  - apiGroups:
+    - harbor.harbor-operator.io
+    apiVersions:
+    - v1alpha1
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - webhookpolicies
+{{- with .root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: In
+      values:
+{{ toYaml . | indent 6 }}
+{{- end }}
+  sideEffects: None
+{{- end -}}
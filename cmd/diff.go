@@ -0,0 +1,232 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/controller"
+)
+
+// Exit codes of the diff subcommand.
+const (
+	diffNoChanges = 0
+	diffFailed    = 1
+	diffChanges   = 2
+)
+
+// stringsFlag collects a flag that may be repeated.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string { return strings.Join(*f, ",") }
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// runDiff implements the diff subcommand, which prints what the operator
+// would change in Harbor to reconcile a set of manifests.
+func runDiff(args []string) int {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s diff --harbor-url URL -f FILE [flags]\n\n", os.Args[0])
+		fmt.Fprintln(fs.Output(), "Prints the Harbor changes the operator would make for the manifests, without making them.")
+		fmt.Fprintln(fs.Output(), "Exits with 0 when there are no changes, 2 when there are, and 1 on failure.")
+		fs.PrintDefaults()
+	}
+	var harbor harborFlags
+	var files, deleted stringsFlag
+	var namespace, defaultCreationPolicy string
+	harbor.bind(fs)
+	fs.Var(&files, "f", "Manifest file or directory to plan, or - for standard input. May be repeated.")
+	fs.Var(&deleted, "deleted", "Manifest file or directory of resources to plan the deletion of. May be repeated.")
+	fs.StringVar(&namespace, "namespace", "default", "Namespace of manifests that do not set one.")
+	fs.StringVar(&defaultCreationPolicy, "default-creation-policy", string(harborv1alpha1.CreationPolicyCreate),
+		"Creation policy the operator uses when a resource omits spec.creationPolicy.")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return diffNoChanges
+		}
+		return diffFailed
+	}
+
+	entries, err := planManifests(context.Background(), &harbor, files, deleted, namespace, defaultCreationPolicy)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "diff: %v\n", err)
+		return diffFailed
+	}
+	return printPlan(os.Stdout, entries)
+}
+
+func planManifests(ctx context.Context, harbor *harborFlags, files, deleted []string, namespace, defaultCreationPolicy string) ([]controller.PlanEntry, error) {
+	if len(files) == 0 && len(deleted) == 0 {
+		return nil, errors.New("at least one of -f or --deleted is required")
+	}
+	hc, err := harbor.client()
+	if err != nil {
+		return nil, err
+	}
+	options, err := controller.NewOperatorOptions(controller.OperatorConfig{
+		DefaultCreationPolicy: harborv1alpha1.CreationPolicy(defaultCreationPolicy),
+		HarborRequestTimeout:  harbor.timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	objects, err := readManifests(files, namespace)
+	if err != nil {
+		return nil, err
+	}
+	removed, err := readManifests(deleted, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return controller.Plan(ctx, hc, options, objects, removed)
+}
+
+// printPlan writes one line per planned resource and a summary, and returns
+// the exit code for the plan.
+func printPlan(w io.Writer, entries []controller.PlanEntry) int {
+	counts := map[controller.PlanAction]int{}
+	for _, entry := range entries {
+		counts[entry.Action]++
+		if entry.Action == controller.PlanNoChange && len(entry.Details) == 0 {
+			continue
+		}
+		name := entry.Name
+		if entry.Namespace != "" {
+			name = entry.Namespace + "/" + name
+		}
+		if entry.Err != nil {
+			fmt.Fprintf(w, "%s %s: %s: %v\n", entry.Kind, name, entry.Action, entry.Err)
+			continue
+		}
+		fmt.Fprintf(w, "%s %s: %s\n", entry.Kind, name, entry.Action)
+		for _, detail := range entry.Details {
+			fmt.Fprintf(w, "    %s\n", detail)
+		}
+	}
+	fmt.Fprintf(w, "\nPlan: %d to create, %d to adopt, %d to update, %d to delete, %d unchanged, %d failed.\n",
+		counts[controller.PlanCreate], counts[controller.PlanAdopt], counts[controller.PlanUpdate],
+		counts[controller.PlanDelete], counts[controller.PlanNoChange], counts[controller.PlanError])
+
+	switch {
+	case counts[controller.PlanError] > 0:
+		return diffFailed
+	case len(entries) > counts[controller.PlanNoChange]:
+		return diffChanges
+	default:
+		return diffNoChanges
+	}
+}
+
+// readManifests decodes the objects in paths. Directories are read
+// recursively for .yaml, .yml, and .json files. Objects of kinds the operator
+// does not know are skipped, and v1beta1 objects are converted to v1alpha1.
+func readManifests(paths []string, namespace string) ([]client.Object, error) {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	var objects []client.Object
+	for _, path := range paths {
+		files, err := manifestFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			data, err := readManifestFile(file)
+			if err != nil {
+				return nil, err
+			}
+			reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+			for {
+				doc, err := reader.Read()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", file, err)
+				}
+				if len(bytes.TrimSpace(doc)) == 0 {
+					continue
+				}
+				obj, gvk, err := decoder.Decode(doc, nil, nil)
+				if runtime.IsNotRegisteredError(err) || runtime.IsMissingKind(err) {
+					continue
+				}
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", file, err)
+				}
+				if gvk.Group != harborv1alpha1.GroupVersion.Group {
+					continue
+				}
+				if spoke, ok := obj.(conversion.Convertible); ok {
+					hub, err := scheme.New(harborv1alpha1.GroupVersion.WithKind(gvk.Kind))
+					if err != nil {
+						return nil, fmt.Errorf("%s: %w", file, err)
+					}
+					if err := spoke.ConvertTo(hub.(conversion.Hub)); err != nil {
+						return nil, fmt.Errorf("%s: convert %s to v1alpha1: %w", file, gvk.Kind, err)
+					}
+					obj = hub
+				}
+				typed, ok := obj.(client.Object)
+				if !ok {
+					continue
+				}
+				if typed.GetNamespace() == "" {
+					typed.SetNamespace(namespace)
+				}
+				objects = append(objects, typed)
+			}
+		}
+	}
+	return objects, nil
+}
+
+func manifestFiles(path string) ([]string, error) {
+	if path == "-" {
+		return []string{path}, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	var files []string
+	err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		switch filepath.Ext(p) {
+		case ".yaml", ".yml", ".json":
+			if !d.IsDir() {
+				files = append(files, p)
+			}
+		}
+		return nil
+	})
+	return files, err
+}
+
+func readManifestFile(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
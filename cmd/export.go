@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rkthtrifork/harbor-operator/internal/export"
+)
+
+// runExport implements the export subcommand, which writes the resources that
+// describe an existing Harbor instance as YAML.
+func runExport(args []string) int {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s export --harbor-url URL [flags]\n\n", os.Args[0])
+		fmt.Fprintln(fs.Output(), "Writes Harbor objects as resources with creationPolicy Adopt.")
+		fs.PrintDefaults()
+	}
+	var harbor harborFlags
+	var connection connectionRefFlags
+	var namespace, output string
+	harbor.bind(fs)
+	connection.bind(fs)
+	fs.StringVar(&namespace, "namespace", "default", "Namespace of the exported resources.")
+	fs.StringVar(&output, "output", "-", "File to write the resources to, or - for standard output.")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	if err := exportHarbor(context.Background(), &harbor, &connection, namespace, output); err != nil {
+		fmt.Fprintf(os.Stderr, "export: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func exportHarbor(ctx context.Context, harbor *harborFlags, connection *connectionRefFlags, namespace, output string) error {
+	hc, err := harbor.client()
+	if err != nil {
+		return err
+	}
+	ref, err := connection.ref()
+	if err != nil {
+		return err
+	}
+	result, err := export.Export(ctx, hc, export.Options{Namespace: namespace, HarborConnectionRef: ref})
+	if err != nil {
+		return err
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
+	}
+
+	var w io.Writer = os.Stdout
+	if output != "-" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		w = f
+	}
+	return export.WriteYAML(w, result.Objects)
+}
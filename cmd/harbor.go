@@ -0,0 +1,101 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+)
+
+// harborFlags are the flags the CLI subcommands use to connect to Harbor.
+type harborFlags struct {
+	url                   string
+	username              string
+	passwordFile          string
+	caFile                string
+	insecureSkipTLSVerify bool
+	timeout               time.Duration
+}
+
+func (f *harborFlags) bind(fs *flag.FlagSet) {
+	fs.StringVar(&f.url, "harbor-url", "", "Base URL of the Harbor instance, such as https://harbor.example.com.")
+	fs.StringVar(&f.username, "username", "admin", "Harbor username.")
+	fs.StringVar(&f.passwordFile, "password-file", "",
+		"File containing the Harbor password. Defaults to the HARBOR_PASSWORD environment variable.")
+	fs.StringVar(&f.caFile, "ca-file", "", "PEM CA bundle to verify the Harbor certificate with.")
+	fs.BoolVar(&f.insecureSkipTLSVerify, "insecure-skip-tls-verify", false,
+		"Skip verification of the Harbor certificate.")
+	fs.DurationVar(&f.timeout, "harbor-request-timeout", 30*time.Second, "Timeout for each request to the Harbor API.")
+}
+
+func (f *harborFlags) client() (*harborclient.Client, error) {
+	if f.url == "" {
+		return nil, errors.New("--harbor-url is required")
+	}
+	password := os.Getenv("HARBOR_PASSWORD")
+	if f.passwordFile != "" {
+		b, err := os.ReadFile(f.passwordFile)
+		if err != nil {
+			return nil, fmt.Errorf("read password file: %w", err)
+		}
+		password = strings.TrimSpace(string(b))
+	}
+	if password == "" {
+		return nil, errors.New("a Harbor password is required, set --password-file or HARBOR_PASSWORD")
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: f.insecureSkipTLSVerify}
+	if f.caFile != "" {
+		pem, err := os.ReadFile(f.caFile)
+		if err != nil {
+			return nil, fmt.Errorf("read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", f.caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return harborclient.NewWithHTTPClient(f.url, f.username, password, &http.Client{
+		Transport: transport,
+		Timeout:   f.timeout,
+	}), nil
+}
+
+// connectionRefFlags select the harborConnectionRef written into generated
+// resources.
+type connectionRefFlags struct {
+	name string
+	kind string
+}
+
+func (f *connectionRefFlags) bind(fs *flag.FlagSet) {
+	fs.StringVar(&f.name, "harbor-connection", "",
+		"Name of the HarborConnection or ClusterHarborConnection the resources use. "+
+			"Leave empty to omit spec.harborConnectionRef.")
+	fs.StringVar(&f.kind, "harbor-connection-kind", string(harborv1alpha1.HarborConnectionReferenceKindNamespaced),
+		"Kind of --harbor-connection: HarborConnection or ClusterHarborConnection.")
+}
+
+func (f *connectionRefFlags) ref() (*harborv1alpha1.HarborConnectionReference, error) {
+	if f.name == "" {
+		return nil, nil
+	}
+	kind := harborv1alpha1.HarborConnectionReferenceKind(f.kind)
+	switch kind {
+	case harborv1alpha1.HarborConnectionReferenceKindNamespaced, harborv1alpha1.HarborConnectionReferenceKindCluster:
+	default:
+		return nil, fmt.Errorf("unsupported --harbor-connection-kind %q, must be HarborConnection or ClusterHarborConnection", f.kind)
+	}
+	return &harborv1alpha1.HarborConnectionReference{Name: f.name, Kind: kind}, nil
+}
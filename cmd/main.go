@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"os"
@@ -41,7 +42,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	harborv1beta1 "github.com/rkthtrifork/harbor-operator/api/v1beta1"
 	"github.com/rkthtrifork/harbor-operator/internal/controller"
+	"github.com/rkthtrifork/harbor-operator/internal/tracing"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -54,14 +57,25 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(harborv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(harborv1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
 // nolint:gocyclo
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "export":
+			os.Exit(runExport(os.Args[2:]))
+		case "diff":
+			os.Exit(runDiff(os.Args[2:]))
+		}
+	}
+
 	var metricsAddr string
 	var metricsCertPath, metricsCertName, metricsCertKey string
 	var webhookCertPath, webhookCertName, webhookCertKey string
+	var enableWebhooks bool
 	var enableLeaderElection bool
 	var probeAddr string
 	var secureMetrics bool
@@ -72,6 +86,9 @@ func main() {
 	var allowCrossNamespaceReferences bool
 	var defaultDriftDetectionInterval time.Duration
 	var harborRequestTimeout time.Duration
+	var tracingExporter, tracingEndpoint string
+	var tracingInsecure bool
+	var tracingSampleRatio float64
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -81,6 +98,8 @@ func main() {
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.BoolVar(&secureMetrics, "metrics-secure", true,
 		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"Serve the validating admission and conversion webhooks for Harbor resources.")
 	flag.StringVar(&webhookCertPath, "webhook-cert-path", "", "The directory that contains the webhook certificate.")
 	flag.StringVar(&webhookCertName, "webhook-cert-name", "tls.crt", "The name of the webhook certificate file.")
 	flag.StringVar(&webhookCertKey, "webhook-cert-key", "tls.key", "The name of the webhook key file.")
@@ -105,6 +124,14 @@ func main() {
 			"Leave at 0 to disable it by default.")
 	flag.DurationVar(&harborRequestTimeout, "harbor-request-timeout", 30*time.Second,
 		"Timeout for each request to the Harbor API. Must be greater than 0.")
+	flag.StringVar(&tracingExporter, "tracing-exporter", string(tracing.ExporterNone),
+		"Where to export OpenTelemetry traces: none, otlp, or stdout.")
+	flag.StringVar(&tracingEndpoint, "tracing-endpoint", "",
+		"OTLP gRPC collector address as host:port. Defaults to OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4317.")
+	flag.BoolVar(&tracingInsecure, "tracing-insecure", false,
+		"Connect to the OTLP collector without TLS.")
+	flag.Float64Var(&tracingSampleRatio, "tracing-sample-ratio", 1,
+		"Fraction of reconciles to trace, between 0 and 1.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -124,6 +151,17 @@ func main() {
 		os.Exit(1)
 	}
 
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Config{
+		Exporter:    tracing.Exporter(tracingExporter),
+		Endpoint:    tracingEndpoint,
+		Insecure:    tracingInsecure,
+		SampleRatio: tracingSampleRatio,
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -240,162 +278,189 @@ func main() {
 		os.Exit(1)
 	}
 
-	operatorOptions = operatorOptions.WithSecretReader(mgr.GetAPIReader())
+	operatorOptions = operatorOptions.WithAPIReader(mgr.GetAPIReader())
+	eventRecorder := mgr.GetEventRecorder(controller.EventSource)
 
 	if err = (&controller.RegistryReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Registry")
 		os.Exit(1)
 	}
 	if err = (&controller.ProjectReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Project")
 		os.Exit(1)
 	}
 	if err = (&controller.UserReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "User")
 		os.Exit(1)
 	}
 	if err = (&controller.MemberReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Member")
 		os.Exit(1)
 	}
 	if err = (&controller.RobotReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Robot")
 		os.Exit(1)
 	}
 	if err = (&controller.ConfigurationReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Configuration")
 		os.Exit(1)
 	}
 	if err = (&controller.GCScheduleReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "GCSchedule")
 		os.Exit(1)
 	}
 	if err = (&controller.PurgeAuditScheduleReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "PurgeAuditSchedule")
 		os.Exit(1)
 	}
 	if err = (&controller.RetentionPolicyReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "RetentionPolicy")
 		os.Exit(1)
 	}
 	if err = (&controller.ReplicationPolicyReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ReplicationPolicy")
 		os.Exit(1)
 	}
 	if err = (&controller.WebhookPolicyReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "WebhookPolicy")
 		os.Exit(1)
 	}
 	if err = (&controller.ImmutableTagRuleReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ImmutableTagRule")
 		os.Exit(1)
 	}
 	if err = (&controller.LabelReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Label")
 		os.Exit(1)
 	}
 	if err = (&controller.UserGroupClaimReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "UserGroupClaim")
 		os.Exit(1)
 	}
 	if err = (&controller.ScannerRegistrationReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ScannerRegistration")
 		os.Exit(1)
 	}
 	if err = (&controller.ScanAllScheduleReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ScanAllSchedule")
 		os.Exit(1)
 	}
 	if err = (&controller.QuotaReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Quota")
 		os.Exit(1)
 	}
 	if err = (&controller.HarborConnectionReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "HarborConnection")
 		os.Exit(1)
 	}
 	if err = (&controller.ClusterHarborConnectionReconciler{
-		Client:  mgr.GetClient(),
-		Scheme:  mgr.GetScheme(),
-		Options: operatorOptions,
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Options:  operatorOptions,
+		Recorder: eventRecorder,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ClusterHarborConnection")
 		os.Exit(1)
 	}
 	// +kubebuilder:scaffold:builder
 
+	if enableWebhooks {
+		if err := controller.SetupAdmissionWebhooks(mgr, operatorOptions); err != nil {
+			setupLog.Error(err, "unable to create admission webhooks")
+			os.Exit(1)
+		}
+	}
+
 	if metricsCertWatcher != nil {
 		setupLog.Info("Adding metrics certificate watcher to manager")
 		if err := mgr.Add(metricsCertWatcher); err != nil {
@@ -426,4 +491,11 @@ func main() {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+
+	// Flush spans buffered by the batch exporter before exiting.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(ctx); err != nil {
+		setupLog.Error(err, "unable to flush traces")
+	}
 }
@@ -4,11 +4,16 @@ set -euo pipefail
 repo_root="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
 generated_paths=(
   api/v1alpha1/zz_generated.deepcopy.go
+  api/v1beta1/zz_generated.deepcopy.go
   config/crd/bases
   config/rbac/role.yaml
+  config/webhook
   charts/harbor-operator/crds
   charts/harbor-operator/templates/clusterrole.yaml
+  charts/harbor-operator/templates/_conversion_crds.tpl
+  charts/harbor-operator/templates/_webhooks.tpl
   docs/reference/api.md
+  internal/harborclient/harborapi
 )
 
 before="$(mktemp)"
@@ -4,10 +4,72 @@ set -euo pipefail
 repo_root="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
 src_dir="$repo_root/config/crd/bases"
 dst_dir="$repo_root/charts/harbor-operator/crds"
+tpl_file="$repo_root/charts/harbor-operator/templates/_conversion_crds.tpl"
 
 mkdir -p "$dst_dir"
 
 find "$dst_dir" -maxdepth 1 -type f -name '*.yaml' -delete
-cp "$src_dir"/*.yaml "$dst_dir/"
 
-echo "Synced CRDs from $src_dir to $dst_dir"
+# CRDs that also serve v1beta1 need the conversion webhook, whose Service and
+# CA bundle are only known at install time. Helm does not template crds/, so
+# they are rendered from a template instead.
+converted=()
+for src in "$src_dir"/*.yaml; do
+  if grep -q '^    name: v1beta1$' "$src"; then
+    converted+=("$src")
+  else
+    cp "$src" "$dst_dir/"
+  fi
+done
+
+{
+  cat <<'EOF_HEADER'
+{{- /* Generated by hack/sync-chart-crds.sh from config/crd/bases. */ -}}
+{{- define "harbor-operator.crdConversion" -}}
+{{- if .root.Values.webhook.enabled }}
+  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+{{- with .caBundle }}
+        caBundle: {{ . }}
+{{- end }}
+        service:
+          name: {{ include "harbor-operator.fullname" .root }}-webhook
+          namespace: {{ .root.Release.Namespace }}
+          path: /convert
+          port: 443
+      conversionReviewVersions:
+      - v1
+{{- end }}
+{{- end -}}
+{{- define "harbor-operator.convertedCRDs" -}}
+EOF_HEADER
+  for src in "${converted[@]}"; do
+    awk '
+      /^    controller-gen.kubebuilder.io\/version: / {
+        print
+        print "    helm.sh/resource-policy: keep"
+        print "{{- if and .root.Values.webhook.enabled .root.Values.webhook.certManager.enabled }}"
+        print "    cert-manager.io/inject-ca-from: {{ .root.Release.Namespace }}/{{ include \"harbor-operator.fullname\" .root }}-webhook"
+        print "{{- end }}"
+        next
+      }
+      /^spec:$/ {
+        print
+        print "{{- include \"harbor-operator.crdConversion\" . }}"
+        next
+      }
+      /^    name: v1beta1$/ { beta = 1 }
+      beta && /^    served: true$/ {
+        print "    served: {{ .root.Values.webhook.enabled }}"
+        beta = 0
+        next
+      }
+      { print }
+    ' "$src"
+  done
+  echo '{{- end -}}'
+} >"$tpl_file"
+
+echo "Synced CRDs from $src_dir to $dst_dir and $tpl_file"
@@ -0,0 +1,24 @@
+#!/usr/bin/env bash
+set -euo pipefail
+
+repo_root="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+src_file="$repo_root/config/webhook/manifests.yaml"
+dst_file="$repo_root/charts/harbor-operator/templates/_webhooks.tpl"
+
+grep -q '^webhooks:$' "$src_file"
+
+{
+  cat <<'EOF_HEADER'
+{{- /* Generated by hack/sync-chart-webhooks.sh from config/webhook/manifests.yaml. */ -}}
+{{- define "harbor-operator.webhooks" -}}
+EOF_HEADER
+  sed -n '/^webhooks:$/,$p' "$src_file" | sed \
+    -e 's|^    service:$|{{- with .caBundle }}\n    caBundle: {{ . }}\n{{- end }}\n    service:|' \
+    -e 's|name: webhook-service$|name: {{ include "harbor-operator.fullname" .root }}-webhook|' \
+    -e 's|namespace: system$|namespace: {{ .root.Release.Namespace }}|' \
+    -e 's|failurePolicy: Fail$|failurePolicy: {{ .root.Values.webhook.failurePolicy }}|' \
+    -e 's|^  sideEffects: None$|{{- with .root.Values.watchNamespaces }}\n  namespaceSelector:\n    matchExpressions:\n    - key: kubernetes.io/metadata.name\n      operator: In\n      values:\n{{ toYaml . \| indent 6 }}\n{{- end }}\n  sideEffects: None|'
+  echo '{{- end -}}'
+} >"$dst_file"
+
+echo "Synced webhooks from $src_file to $dst_file"
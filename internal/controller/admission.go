@@ -0,0 +1,464 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-harborconnection,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=harborconnections,verbs=create;update,versions=v1alpha1,name=vharborconnection.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-clusterharborconnection,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=clusterharborconnections,verbs=create;update,versions=v1alpha1,name=vclusterharborconnection.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-configuration,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=configurations,verbs=create;update,versions=v1alpha1,name=vconfiguration.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-gcschedule,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=gcschedules,verbs=create;update,versions=v1alpha1,name=vgcschedule.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-immutabletagrule,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=immutabletagrules,verbs=create;update,versions=v1alpha1,name=vimmutabletagrule.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-label,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=labels,verbs=create;update,versions=v1alpha1,name=vlabel.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-member,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=members,verbs=create;update,versions=v1alpha1,name=vmember.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-project,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=projects,verbs=create;update,versions=v1alpha1,name=vproject.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-purgeauditschedule,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=purgeauditschedules,verbs=create;update,versions=v1alpha1,name=vpurgeauditschedule.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-quota,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=quotas,verbs=create;update,versions=v1alpha1,name=vquota.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-registry,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=registries,verbs=create;update,versions=v1alpha1,name=vregistry.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-replicationpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=replicationpolicies,verbs=create;update,versions=v1alpha1,name=vreplicationpolicy.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-retentionpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=retentionpolicies,verbs=create;update,versions=v1alpha1,name=vretentionpolicy.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-robot,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=robots,verbs=create;update,versions=v1alpha1,name=vrobot.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-scanallschedule,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=scanallschedules,verbs=create;update,versions=v1alpha1,name=vscanallschedule.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-scannerregistration,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=scannerregistrations,verbs=create;update,versions=v1alpha1,name=vscannerregistration.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-user,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=users,verbs=create;update,versions=v1alpha1,name=vuser.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-usergroupclaim,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=usergroupclaims,verbs=create;update,versions=v1alpha1,name=vusergroupclaim.harbor.harbor-operator.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-harbor-harbor-operator-io-v1alpha1-webhookpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=harbor.harbor-operator.io,resources=webhookpolicies,verbs=create;update,versions=v1alpha1,name=vwebhookpolicy.harbor.harbor-operator.io,admissionReviewVersions=v1
+
+// SetupAdmissionWebhooks registers a validating webhook for every Harbor
+// kind. The webhooks reject specs the reconcilers would fail on, and warn
+// about referenced resources that do not exist yet.
+func SetupAdmissionWebhooks(mgr ctrl.Manager, options OperatorOptions) error {
+	return errors.Join(
+		registerValidator(mgr, options, &harborv1alpha1.HarborConnection{}, admissionRules[*harborv1alpha1.HarborConnection]{
+			validate: func(cr *harborv1alpha1.HarborConnection) field.ErrorList {
+				return validateConnectionSpec(&cr.Spec)
+			},
+		}),
+		registerValidator(mgr, options, &harborv1alpha1.ClusterHarborConnection{}, admissionRules[*harborv1alpha1.ClusterHarborConnection]{
+			validate: func(cr *harborv1alpha1.ClusterHarborConnection) field.ErrorList {
+				return validateConnectionSpec(&cr.Spec)
+			},
+		}),
+		registerValidator(mgr, options, &harborv1alpha1.Configuration{}, admissionRules[*harborv1alpha1.Configuration]{
+			validate: validateConfigurationAdmission,
+		}),
+		registerValidator(mgr, options, &harborv1alpha1.GCSchedule{}, admissionRules[*harborv1alpha1.GCSchedule]{
+			validate: func(cr *harborv1alpha1.GCSchedule) field.ErrorList {
+				return validateScheduleSpec(cr.Spec.Schedule)
+			},
+		}),
+		registerValidator(mgr, options, &harborv1alpha1.ImmutableTagRule{}, admissionRules[*harborv1alpha1.ImmutableTagRule]{}),
+		registerValidator(mgr, options, &harborv1alpha1.Label{}, admissionRules[*harborv1alpha1.Label]{}),
+		registerValidator(mgr, options, &harborv1alpha1.Member{}, admissionRules[*harborv1alpha1.Member]{}),
+		registerValidator(mgr, options, &harborv1alpha1.Project{}, admissionRules[*harborv1alpha1.Project]{}),
+		registerValidator(mgr, options, &harborv1alpha1.PurgeAuditSchedule{}, admissionRules[*harborv1alpha1.PurgeAuditSchedule]{
+			validate: func(cr *harborv1alpha1.PurgeAuditSchedule) field.ErrorList {
+				return validateScheduleSpec(cr.Spec.Schedule)
+			},
+		}),
+		registerValidator(mgr, options, &harborv1alpha1.Quota{}, admissionRules[*harborv1alpha1.Quota]{}),
+		registerValidator(mgr, options, &harborv1alpha1.Registry{}, admissionRules[*harborv1alpha1.Registry]{
+			validate: validateRegistryAdmission,
+		}),
+		registerValidator(mgr, options, &harborv1alpha1.ReplicationPolicy{}, admissionRules[*harborv1alpha1.ReplicationPolicy]{
+			validate: validateReplicationPolicyAdmission,
+		}),
+		registerValidator(mgr, options, &harborv1alpha1.RetentionPolicy{}, admissionRules[*harborv1alpha1.RetentionPolicy]{
+			validate: validateRetentionPolicyAdmission,
+		}),
+		registerValidator(mgr, options, &harborv1alpha1.Robot{}, admissionRules[*harborv1alpha1.Robot]{
+			validate:       validateRobotAdmission,
+			validateUpdate: validateRobotUpdate,
+		}),
+		registerValidator(mgr, options, &harborv1alpha1.ScanAllSchedule{}, admissionRules[*harborv1alpha1.ScanAllSchedule]{
+			validate: func(cr *harborv1alpha1.ScanAllSchedule) field.ErrorList {
+				return validateScheduleSpec(cr.Spec.Schedule)
+			},
+		}),
+		registerValidator(mgr, options, &harborv1alpha1.ScannerRegistration{}, admissionRules[*harborv1alpha1.ScannerRegistration]{}),
+		registerValidator(mgr, options, &harborv1alpha1.User{}, admissionRules[*harborv1alpha1.User]{}),
+		registerValidator(mgr, options, &harborv1alpha1.UserGroupClaim{}, admissionRules[*harborv1alpha1.UserGroupClaim]{}),
+		registerValidator(mgr, options, &harborv1alpha1.WebhookPolicy{}, admissionRules[*harborv1alpha1.WebhookPolicy]{}),
+	)
+}
+
+// admissionRules are the kind-specific rules of a resourceValidator.
+type admissionRules[T client.Object] struct {
+	// validate checks the spec of a created or updated object.
+	validate func(obj T) field.ErrorList
+	// validateUpdate checks the changes of an updated object.
+	validateUpdate func(old, obj T) field.ErrorList
+}
+
+func registerValidator[T client.Object](mgr ctrl.Manager, options OperatorOptions, obj T, rules admissionRules[T]) error {
+	validator := &resourceValidator[T]{
+		reader:  mgr.GetAPIReader(),
+		options: options,
+		kind:    schema.GroupKind{Group: harborv1alpha1.GroupVersion.Group, Kind: mustKind(mgr.GetScheme(), obj)},
+		rules:   rules,
+	}
+	return ctrl.NewWebhookManagedBy(mgr, obj).WithValidator(validator).Complete()
+}
+
+// resourceValidator applies the rules shared by all Harbor kinds, the
+// connection and reference rules, and the rules of its kind.
+type resourceValidator[T client.Object] struct {
+	reader  client.Reader
+	options OperatorOptions
+	kind    schema.GroupKind
+	rules   admissionRules[T]
+}
+
+func (v *resourceValidator[T]) ValidateCreate(ctx context.Context, obj T) (admission.Warnings, error) {
+	errs, warnings := v.validate(ctx, obj)
+	return warnings, v.invalid(obj, errs)
+}
+
+// ValidateUpdate only validates spec changes. Metadata and status updates,
+// such as the operator adding or removing its finalizer, are admitted even
+// when the stored spec no longer passes the current rules, and so are updates
+// of an object that is being deleted.
+func (v *resourceValidator[T]) ValidateUpdate(ctx context.Context, old, obj T) (admission.Warnings, error) {
+	if !obj.GetDeletionTimestamp().IsZero() || specUnchanged(old, obj) {
+		return nil, nil
+	}
+	errs, warnings := v.validate(ctx, obj)
+	errs = append(errs, validateConnectionRefUpdate(v.options, old, obj)...)
+	if v.rules.validateUpdate != nil {
+		errs = append(errs, v.rules.validateUpdate(old, obj)...)
+	}
+	return warnings, v.invalid(obj, errs)
+}
+
+func (v *resourceValidator[T]) ValidateDelete(context.Context, T) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *resourceValidator[T]) validate(ctx context.Context, obj T) (field.ErrorList, admission.Warnings) {
+	var errs field.ErrorList
+	if v.rules.validate != nil {
+		errs = v.rules.validate(obj)
+	}
+	connectionErrs, warnings := v.validateConnectionRef(ctx, obj)
+	errs = append(errs, connectionErrs...)
+	referenceErrs, referenceWarnings := v.validateReferences(ctx, obj)
+	return append(errs, referenceErrs...), append(warnings, referenceWarnings...)
+}
+
+func (v *resourceValidator[T]) invalid(obj T, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(v.kind, obj.GetName(), errs)
+}
+
+// validateConnectionRef applies the --harbor-connection rule to
+// spec.harborConnectionRef, and warns when the connection does not exist.
+func (v *resourceValidator[T]) validateConnectionRef(ctx context.Context, obj T) (field.ErrorList, admission.Warnings) {
+	ref, ok := specHarborConnectionRef(obj)
+	if !ok {
+		return nil, nil
+	}
+	path := field.NewPath("spec", "harborConnectionRef")
+	if forced := v.options.forcedHarborConnection; forced != "" {
+		if ref == nil || ref.Name == "" {
+			return nil, nil
+		}
+		if normalized := normalizedHarborConnectionRef(ref); normalized.Kind != harborv1alpha1.HarborConnectionReferenceKindCluster || normalized.Name != forced {
+			return field.ErrorList{field.Forbidden(path, fmt.Sprintf("must be omitted or reference ClusterHarborConnection %q when the operator is started with --harbor-connection", forced))}, nil
+		}
+		return nil, nil
+	}
+	if ref == nil || ref.Name == "" {
+		return field.ErrorList{field.Required(path, "required unless the operator is started with --harbor-connection")}, nil
+	}
+
+	normalized := normalizedHarborConnectionRef(ref)
+	var conn client.Object = &harborv1alpha1.HarborConnection{}
+	key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: normalized.Name}
+	if normalized.Kind == harborv1alpha1.HarborConnectionReferenceKindCluster {
+		conn = &harborv1alpha1.ClusterHarborConnection{}
+		key.Namespace = ""
+	}
+	if err := v.reader.Get(ctx, key, conn); apierrors.IsNotFound(err) {
+		return nil, admission.Warnings{fmt.Sprintf("%s: %s %s does not exist", path, normalized.Kind, key.Name)}
+	}
+	return nil, nil
+}
+
+// referencedObjects creates the objects that references can point to. Secrets
+// are not checked, since the operator creates some of them.
+var referencedObjects = map[string]func() client.Object{
+	"Project":        func() client.Object { return &harborv1alpha1.Project{} },
+	"Registry":       func() client.Object { return &harborv1alpha1.Registry{} },
+	"User":           func() client.Object { return &harborv1alpha1.User{} },
+	"UserGroupClaim": func() client.Object { return &harborv1alpha1.UserGroupClaim{} },
+}
+
+// validateReferences applies --allow-cross-namespace-references to the
+// references in the spec, and warns about referenced resources that do not
+// exist. Resources are often applied together, so a missing reference is not
+// an error.
+func (v *resourceValidator[T]) validateReferences(ctx context.Context, obj T) (field.ErrorList, admission.Warnings) {
+	var errs field.ErrorList
+	var warnings admission.Warnings
+	walkSpecReferences(obj, func(path *field.Path, kind, namespace, name string) {
+		if obj.GetNamespace() == "" {
+			if namespace == "" {
+				errs = append(errs, field.Required(path.Child("namespace"), "required for references from cluster-scoped resources"))
+			}
+			return
+		}
+		if namespace == "" {
+			namespace = obj.GetNamespace()
+		}
+		if err := validateReferenceNamespace(v.options, obj.GetNamespace(), namespace, kind); err != nil {
+			errs = append(errs, field.Forbidden(path.Child("namespace"), err.Error()))
+			return
+		}
+		newObject, ok := referencedObjects[kind]
+		if !ok || name == "" {
+			return
+		}
+		if err := v.reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, newObject()); apierrors.IsNotFound(err) {
+			warnings = append(warnings, fmt.Sprintf("%s: %s %s/%s does not exist", path, kind, namespace, name))
+		}
+	})
+	return errs, warnings
+}
+
+// validateConnectionRefUpdate rejects changing the connection of a resource
+// that is bound to one, which the reconcilers refuse to act on.
+func validateConnectionRefUpdate(options OperatorOptions, old, obj client.Object) field.ErrorList {
+	if options.forcedHarborConnection != "" {
+		return nil
+	}
+	base := harborStatusBase(old)
+	if base == nil || base.ResolvedHarborConnection == nil {
+		return nil
+	}
+	oldRef, _ := specHarborConnectionRef(old)
+	ref, ok := specHarborConnectionRef(obj)
+	if !ok || harborConnectionRefsEqual(oldRef, ref) {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(field.NewPath("spec", "harborConnectionRef"),
+		"cannot be changed after the resource is bound to a Harbor connection; delete and recreate the resource")}
+}
+
+func specUnchanged(old, obj client.Object) bool {
+	oldSpec := reflect.ValueOf(old).Elem().FieldByName("Spec")
+	spec := reflect.ValueOf(obj).Elem().FieldByName("Spec")
+	return oldSpec.IsValid() && spec.IsValid() && equality.Semantic.DeepEqual(oldSpec.Interface(), spec.Interface())
+}
+
+func specHarborConnectionRef(obj client.Object) (*harborv1alpha1.HarborConnectionReference, bool) {
+	spec := reflect.ValueOf(obj).Elem().FieldByName("Spec")
+	if !spec.IsValid() {
+		return nil, false
+	}
+	refField := spec.FieldByName("HarborConnectionRef")
+	if !refField.IsValid() {
+		return nil, false
+	}
+	return refField.Interface().(*harborv1alpha1.HarborConnectionReference), true
+}
+
+func validateConnectionSpec(spec *harborv1alpha1.HarborConnectionSpec) field.ErrorList {
+	if err := validateBaseURL(spec.BaseURL); err != nil {
+		return field.ErrorList{field.Invalid(field.NewPath("spec", "baseURL"), spec.BaseURL, err.Error())}
+	}
+	return nil
+}
+
+func validateConfigurationAdmission(cr *harborv1alpha1.Configuration) field.ErrorList {
+	var errs field.ErrorList
+	for key, setting := range cr.Spec.Settings {
+		path := field.NewPath("spec", "settings").Key(key)
+		switch {
+		case setting.Value == nil && setting.ValueFrom == nil:
+			errs = append(errs, field.Required(path, "one of value or valueFrom must be set"))
+		case setting.Value != nil && setting.ValueFrom != nil:
+			errs = append(errs, field.Forbidden(path, "value and valueFrom are mutually exclusive"))
+		}
+	}
+	return errs
+}
+
+func validateScheduleSpec(schedule harborv1alpha1.ScheduleSpec) field.ErrorList {
+	if schedule.Type == harborv1alpha1.ScheduleTypeManual || schedule.Type == harborv1alpha1.ScheduleTypeNone || schedule.Cron == "" {
+		return nil
+	}
+	if err := validateCron(schedule.Cron); err != nil {
+		return field.ErrorList{field.Invalid(field.NewPath("spec", "schedule", "cron"), schedule.Cron, err.Error())}
+	}
+	return nil
+}
+
+func validateRegistryAdmission(cr *harborv1alpha1.Registry) field.ErrorList {
+	if err := validateBaseURL(cr.Spec.URL); err != nil {
+		return field.ErrorList{field.Invalid(field.NewPath("spec", "url"), cr.Spec.URL, err.Error())}
+	}
+	return nil
+}
+
+func validateReplicationPolicyAdmission(cr *harborv1alpha1.ReplicationPolicy) field.ErrorList {
+	trigger := cr.Spec.Trigger
+	if trigger == nil || trigger.Type != "scheduled" || trigger.Settings == nil {
+		return nil
+	}
+	if err := validateCron(trigger.Settings.Cron); err != nil {
+		return field.ErrorList{field.Invalid(field.NewPath("spec", "trigger", "settings", "cron"), trigger.Settings.Cron, err.Error())}
+	}
+	return nil
+}
+
+// retentionTemplateParams maps Harbor retention rule templates to the
+// parameter they require, if any.
+var retentionTemplateParams = map[string]string{
+	"always":             "",
+	"nothing":            "",
+	"latestPushedK":      "latestPushedK",
+	"latestPulledN":      "latestPulledN",
+	"latestActiveK":      "latestActiveK",
+	"nDaysSinceLastPush": "nDaysSinceLastPush",
+	"nDaysSinceLastPull": "nDaysSinceLastPull",
+	"lastXDays":          "lastXDays",
+}
+
+// retentionParamInt decodes a retention template parameter, which Harbor
+// accepts either as a number or wrapped as {"value": number}.
+func retentionParamInt(raw apiextensionsv1.JSON) (int, bool) {
+	var wrapped struct {
+		Value *int `json:"value"`
+	}
+	if json.Unmarshal(raw.Raw, &wrapped) == nil && wrapped.Value != nil {
+		return *wrapped.Value, true
+	}
+	var n int
+	if err := json.Unmarshal(raw.Raw, &n); err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+func validateRetentionPolicyAdmission(cr *harborv1alpha1.RetentionPolicy) field.ErrorList {
+	var errs field.ErrorList
+	spec := field.NewPath("spec")
+	for i, rule := range cr.Spec.Rules {
+		path := spec.Child("rules").Index(i)
+		if rule.Action != "" && rule.Action != "retain" {
+			errs = append(errs, field.NotSupported(path.Child("action"), rule.Action, []string{"retain"}))
+		}
+		param, ok := retentionTemplateParams[rule.Template]
+		if !ok {
+			errs = append(errs, field.NotSupported(path.Child("template"), rule.Template, slices.Sorted(maps.Keys(retentionTemplateParams))))
+			continue
+		}
+		if param == "" {
+			continue
+		}
+		raw, ok := rule.Params[param]
+		if !ok {
+			errs = append(errs, field.Required(path.Child("params").Key(param), fmt.Sprintf("required by template %s", rule.Template)))
+			continue
+		}
+		if n, ok := retentionParamInt(raw); !ok || n < 0 {
+			errs = append(errs, field.Invalid(path.Child("params").Key(param), string(raw.Raw), "must be a non-negative integer"))
+		}
+	}
+
+	if cr.Spec.ProjectRef == nil && (cr.Spec.Scope == nil || cr.Spec.Scope.Ref == 0) {
+		errs = append(errs, field.Required(spec.Child("projectRef"), "one of projectRef or scope.ref must be set"))
+	}
+
+	if trigger := cr.Spec.Trigger; trigger != nil && trigger.Kind == harborv1alpha1.ScheduleTypeSchedule {
+		path := spec.Child("trigger", "settings").Key("cron")
+		var cron string
+		raw, ok := trigger.Settings["cron"]
+		switch {
+		case !ok || len(raw.Raw) == 0:
+			errs = append(errs, field.Required(path, "required for Schedule triggers"))
+		case json.Unmarshal(raw.Raw, &cron) != nil:
+			errs = append(errs, field.Invalid(path, string(raw.Raw), "must be a string"))
+		case cron != "":
+			if err := validateCron(cron); err != nil {
+				errs = append(errs, field.Invalid(path, cron, err.Error()))
+			}
+		}
+	}
+	return errs
+}
+
+func validateRobotAdmission(cr *harborv1alpha1.Robot) field.ErrorList {
+	var errs field.ErrorList
+	spec := field.NewPath("spec")
+	projectLevel := strings.EqualFold(cr.Spec.Level, "project")
+	if projectLevel && len(cr.Spec.Permissions) != 1 {
+		errs = append(errs, field.Invalid(spec.Child("permissions"), len(cr.Spec.Permissions), "project-level robots must have exactly one permission"))
+	}
+	for i, perm := range cr.Spec.Permissions {
+		path := spec.Child("permissions").Index(i)
+		switch {
+		case strings.EqualFold(perm.Kind, "project"):
+			if projectLevel && perm.ProjectRef == nil {
+				errs = append(errs, field.Required(path.Child("projectRef"), "project-level robots must reference their project"))
+			}
+		case strings.EqualFold(perm.Kind, "system"):
+			if projectLevel {
+				errs = append(errs, field.Forbidden(path.Child("kind"), "project-level robots cannot have system permissions"))
+			}
+			if perm.ProjectRef != nil {
+				errs = append(errs, field.Forbidden(path.Child("projectRef"), "only valid for project permissions"))
+			}
+		default:
+			errs = append(errs, field.NotSupported(path.Child("kind"), perm.Kind, []string{"project", "system"}))
+		}
+	}
+	if ref := cr.Spec.SecretRef; ref != nil && ref.Key == "username" {
+		errs = append(errs, field.Invalid(spec.Child("secretRef", "key"), ref.Key, "is reserved for the canonical Harbor robot username"))
+	}
+	if ref := cr.Spec.SecretRef; ref != nil && ref.Key == corev1.DockerConfigJsonKey {
+		errs = append(errs, field.Invalid(spec.Child("secretRef", "key"), ref.Key, "is reserved for the image pull configuration"))
+	}
+	errs = append(errs, validateRobotRotation(cr)...)
+	for i, target := range cr.Spec.RestartWorkloads {
+		if _, err := metav1.LabelSelectorAsSelector(&target.Selector); err != nil {
+			errs = append(errs, field.Invalid(spec.Child("restartWorkloads").Index(i).Child("selector"), target.Selector, err.Error()))
+		}
+	}
+	if dist := cr.Spec.Distribution; dist != nil {
+		if _, err := metav1.LabelSelectorAsSelector(&dist.NamespaceSelector); err != nil {
+			errs = append(errs, field.Invalid(spec.Child("distribution", "namespaceSelector"), dist.NamespaceSelector, err.Error()))
+		}
+	}
+	return errs
+}
+
+func validateRobotUpdate(old, cr *harborv1alpha1.Robot) field.ErrorList {
+	if old.Status.HarborRobotID != 0 && !robotLevelMatches(old.Spec.Level, cr.Spec.Level) {
+		return field.ErrorList{field.Forbidden(field.NewPath("spec", "level"), "cannot be changed after the robot is created in Harbor; delete and recreate the Robot")}
+	}
+	return nil
+}
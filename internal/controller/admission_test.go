@@ -0,0 +1,243 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestValidator[T client.Object](t *testing.T, config OperatorConfig, rules admissionRules[T], objs ...client.Object) *resourceValidator[T] {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := harborv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add harbor scheme: %v", err)
+	}
+	config.DefaultCreationPolicy = harborv1alpha1.CreationPolicyCreate
+	config.HarborRequestTimeout = defaultHarborRequestTimeout
+	options, err := NewOperatorOptions(config)
+	if err != nil {
+		t.Fatalf("NewOperatorOptions returned error: %v", err)
+	}
+	return &resourceValidator[T]{
+		reader:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+		options: options,
+		kind:    schema.GroupKind{Group: harborv1alpha1.GroupVersion.Group, Kind: "Test"},
+		rules:   rules,
+	}
+}
+
+func expectFieldError(t *testing.T, err error, substr string) {
+	t.Helper()
+	if !apierrors.IsInvalid(err) {
+		t.Fatalf("expected an Invalid error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), substr) {
+		t.Fatalf("expected the error to contain %q, got %v", substr, err)
+	}
+}
+
+func TestAdmissionRejectsInvalidRobotPermissions(t *testing.T) {
+	t.Parallel()
+	v := newTestValidator(t, OperatorConfig{}, admissionRules[*harborv1alpha1.Robot]{
+		validate:       validateRobotAdmission,
+		validateUpdate: validateRobotUpdate,
+	})
+	access := []harborv1alpha1.RobotAccess{{Resource: harborv1alpha1.RobotResourceRepository, Action: harborv1alpha1.RobotActionPull}}
+	robot := &harborv1alpha1.Robot{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci", Namespace: "team"},
+		Spec: harborv1alpha1.RobotSpec{
+			HarborSpecBase: harborv1alpha1.HarborSpecBase{HarborConnectionRef: &harborv1alpha1.HarborConnectionReference{Name: "harbor"}},
+			Level:          "project",
+			Duration:       -1,
+			Permissions: []harborv1alpha1.RobotPermission{
+				{Kind: "project", Access: access},
+				{Kind: "system", ProjectRef: &harborv1alpha1.ProjectReference{Name: "demo"}, Access: access},
+			},
+			SecretRef: &harborv1alpha1.SecretReference{Name: "ci", Key: "username"},
+		},
+	}
+
+	_, err := v.ValidateCreate(context.Background(), robot)
+	for _, want := range []string{
+		"spec.permissions: Invalid value: 2",
+		"spec.permissions[0].projectRef: Required value",
+		"spec.permissions[1].kind: Forbidden",
+		"spec.permissions[1].projectRef: Forbidden",
+		"spec.secretRef.key: Invalid value",
+	} {
+		expectFieldError(t, err, want)
+	}
+
+	valid := robot.DeepCopy()
+	valid.Spec.Permissions = valid.Spec.Permissions[:1]
+	valid.Spec.Permissions[0].ProjectRef = &harborv1alpha1.ProjectReference{Name: "demo"}
+	valid.Spec.SecretRef = nil
+	warnings, err := v.ValidateCreate(context.Background(), valid)
+	if err != nil {
+		t.Fatalf("expected the robot to be valid, got %v", err)
+	}
+	if len(warnings) != 2 || !strings.Contains(warnings[0], "HarborConnection harbor does not exist") || !strings.Contains(warnings[1], "Project team/demo does not exist") {
+		t.Fatalf("expected warnings for the missing connection and project, got %v", warnings)
+	}
+
+	created := valid.DeepCopy()
+	created.Status.HarborRobotID = 7
+	changed := valid.DeepCopy()
+	changed.Spec.Level = "system"
+	changed.Spec.Permissions = []harborv1alpha1.RobotPermission{{Kind: "system", Access: access}}
+	_, err = v.ValidateUpdate(context.Background(), created, changed)
+	expectFieldError(t, err, "spec.level: Forbidden")
+}
+
+func TestAdmissionAppliesConnectionAndNamespacePolicies(t *testing.T) {
+	t.Parallel()
+	project := &harborv1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "shared"}}
+
+	forced := newTestValidator(t, OperatorConfig{ForcedHarborConnection: "shared"}, admissionRules[*harborv1alpha1.Member]{}, project)
+	member := &harborv1alpha1.Member{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice", Namespace: "team"},
+		Spec: harborv1alpha1.MemberSpec{
+			HarborSpecBase: harborv1alpha1.HarborSpecBase{HarborConnectionRef: &harborv1alpha1.HarborConnectionReference{Name: "mine"}},
+			ProjectRef:     harborv1alpha1.ProjectReference{Name: "demo", Namespace: "shared"},
+		},
+	}
+	_, err := forced.ValidateCreate(context.Background(), member)
+	expectFieldError(t, err, `spec.harborConnectionRef: Forbidden: must be omitted or reference ClusterHarborConnection "shared"`)
+
+	member.Spec.HarborConnectionRef = nil
+	if warnings, err := forced.ValidateCreate(context.Background(), member); err != nil || len(warnings) != 0 {
+		t.Fatalf("expected the member to be valid without warnings, got %v, %v", warnings, err)
+	}
+
+	tenant := newTestValidator(t, OperatorConfig{ForcedHarborConnection: "shared", AllowCrossNamespaceReferences: ptr.To(false)}, admissionRules[*harborv1alpha1.Member]{}, project)
+	_, err = tenant.ValidateCreate(context.Background(), member)
+	expectFieldError(t, err, "spec.projectRef.namespace: Forbidden: cross-namespace Project reference")
+
+	unforced := newTestValidator(t, OperatorConfig{}, admissionRules[*harborv1alpha1.Member]{}, project)
+	_, err = unforced.ValidateCreate(context.Background(), member)
+	expectFieldError(t, err, "spec.harborConnectionRef: Required value")
+
+	bound := member.DeepCopy()
+	bound.Spec.HarborConnectionRef = &harborv1alpha1.HarborConnectionReference{Name: "old"}
+	bound.Status.ResolvedHarborConnection = &harborv1alpha1.HarborConnectionBinding{Kind: harborv1alpha1.HarborConnectionReferenceKindNamespaced, Name: "old", UID: "1"}
+	moved := bound.DeepCopy()
+	moved.Spec.HarborConnectionRef.Name = "new"
+	_, err = unforced.ValidateUpdate(context.Background(), bound, moved)
+	expectFieldError(t, err, "spec.harborConnectionRef: Forbidden: cannot be changed")
+}
+
+func TestAdmissionRequiresSecretNamespacesOnClusterConnections(t *testing.T) {
+	t.Parallel()
+	v := newTestValidator(t, OperatorConfig{}, admissionRules[*harborv1alpha1.ClusterHarborConnection]{
+		validate: func(cr *harborv1alpha1.ClusterHarborConnection) field.ErrorList {
+			return validateConnectionSpec(&cr.Spec)
+		},
+	})
+	conn := &harborv1alpha1.ClusterHarborConnection{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+		Spec: harborv1alpha1.HarborConnectionSpec{
+			BaseURL:           "harbor.example.com",
+			CABundleSecretRef: &harborv1alpha1.SecretReference{Name: "ca"},
+		},
+	}
+	_, err := v.ValidateCreate(context.Background(), conn)
+	expectFieldError(t, err, "spec.baseURL: Invalid value")
+	expectFieldError(t, err, "spec.caBundleSecretRef.namespace: Required value")
+}
+
+func TestAdmissionValidatesRetentionRulesAndCron(t *testing.T) {
+	t.Parallel()
+	policy := &harborv1alpha1.RetentionPolicy{
+		Spec: harborv1alpha1.RetentionPolicySpec{
+			ProjectRef: &harborv1alpha1.ProjectReference{Name: "demo"},
+			Rules: []harborv1alpha1.RetentionRule{
+				{Action: "retain", Template: "latestPushedK"},
+				{Action: "delete", Template: "latestPushedK", Params: map[string]apiextensionsv1.JSON{"latestPushedK": {Raw: []byte(`"ten"`)}}},
+				{Template: "newest"},
+				{Template: "always"},
+				{Template: "nDaysSinceLastPush", Params: map[string]apiextensionsv1.JSON{"nDaysSinceLastPush": {Raw: []byte(`{"value":7}`)}}},
+			},
+			Trigger: &harborv1alpha1.RetentionTrigger{
+				Kind:     "Schedule",
+				Settings: map[string]apiextensionsv1.JSON{"cron": {Raw: []byte(`"0 0 * * *"`)}},
+			},
+		},
+	}
+	errs := validateRetentionPolicyAdmission(policy).ToAggregate().Error()
+	for _, want := range []string{
+		"spec.rules[0].params[latestPushedK]: Required value",
+		`spec.rules[1].action: Unsupported value: "delete"`,
+		"spec.rules[1].params[latestPushedK]: Invalid value",
+		`spec.rules[2].template: Unsupported value: "newest"`,
+		"spec.trigger.settings[cron]: Invalid value",
+	} {
+		if !strings.Contains(errs, want) {
+			t.Fatalf("expected %q in %s", want, errs)
+		}
+	}
+	if strings.Contains(errs, "spec.rules[4]") {
+		t.Fatalf("expected the wrapped parameter to be accepted, got %s", errs)
+	}
+}
+
+func TestValidateCron(t *testing.T) {
+	t.Parallel()
+	for _, expr := range []string{"0 0 0 * * *", "0 */15 9-17 ? JAN-MAR mon,wed,FRI", "30 0 0 1,15 * 0"} {
+		if err := validateCron(expr); err != nil {
+			t.Errorf("validateCron(%q) returned error: %v", expr, err)
+		}
+	}
+	for _, expr := range []string{"0 0 * * *", "0 60 0 * * *", "0 0 0 * * 7", "0 0 0 * * */0", "0 0 5-1 * * *", "@daily"} {
+		if err := validateCron(expr); err == nil {
+			t.Errorf("validateCron(%q) returned no error", expr)
+		}
+	}
+}
+
+func TestAdmissionOnlyValidatesSpecUpdates(t *testing.T) {
+	t.Parallel()
+	v := newTestValidator(t, OperatorConfig{}, admissionRules[*harborv1alpha1.Robot]{
+		validate:       validateRobotAdmission,
+		validateUpdate: validateRobotUpdate,
+	})
+	// The stored spec has no connection and a reserved Secret key, as if it
+	// predates the webhook.
+	stored := &harborv1alpha1.Robot{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci", Namespace: "team", Generation: 1},
+		Spec: harborv1alpha1.RobotSpec{
+			Level:     "system",
+			SecretRef: &harborv1alpha1.SecretReference{Name: "ci", Key: "username"},
+		},
+	}
+
+	finalized := stored.DeepCopy()
+	finalized.Finalizers = []string{finalizerName}
+	if _, err := v.ValidateUpdate(context.Background(), stored, finalized); err != nil {
+		t.Fatalf("adding the finalizer was rejected: %v", err)
+	}
+
+	deleting := finalized.DeepCopy()
+	deleting.DeletionTimestamp = ptr.To(metav1.Now())
+	released := deleting.DeepCopy()
+	released.Finalizers = nil
+	if _, err := v.ValidateUpdate(context.Background(), deleting, released); err != nil {
+		t.Fatalf("removing the finalizer was rejected: %v", err)
+	}
+
+	changed := finalized.DeepCopy()
+	changed.Generation = 2
+	changed.Spec.Description = "ci robot"
+	_, err := v.ValidateUpdate(context.Background(), finalized, changed)
+	expectFieldError(t, err, "spec.harborConnectionRef: Required value")
+}
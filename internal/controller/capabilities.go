@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const harborAuthModeOIDC = "oidc_auth"
+
+// harborFeatureVersions lists the first Harbor release that ships each
+// version-gated feature.
+var harborFeatureVersions = []struct {
+	feature      harborv1alpha1.HarborFeature
+	major, minor int
+}{
+	{feature: harborv1alpha1.HarborFeatureSecurityHub, major: 2, minor: 10},
+	{feature: harborv1alpha1.HarborFeatureSBOM, major: 2, minor: 11},
+}
+
+// harborSystemInfo converts the systeminfo response into connection status.
+func harborSystemInfo(info *harborclient.SystemInfo) *harborv1alpha1.HarborSystemInfo {
+	out := &harborv1alpha1.HarborSystemInfo{
+		Version:  ptr.Deref(info.HarborVersion, ""),
+		AuthMode: ptr.Deref(info.AuthMode, ""),
+		ReadOnly: ptr.Deref(info.ReadOnly, false),
+	}
+	if major, minor, ok := parseHarborVersion(out.Version); ok {
+		for _, gate := range harborFeatureVersions {
+			if major > gate.major || major == gate.major && minor >= gate.minor {
+				out.Features = append(out.Features, gate.feature)
+			}
+		}
+	}
+	if out.AuthMode == harborAuthModeOIDC {
+		out.Features = append(out.Features, harborv1alpha1.HarborFeatureOIDC)
+	}
+	return out
+}
+
+// discoverHarborSystem records the Harbor version and capabilities in the
+// connection status and reports whether they changed.
+func discoverHarborSystem(ctx context.Context, hc *harborclient.Client, status *harborv1alpha1.HarborConnectionStatus) (bool, error) {
+	info, err := hc.GetSystemInfo(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to read Harbor systeminfo: %w", err)
+	}
+	system := harborSystemInfo(info)
+	changed := !equality.Semantic.DeepEqual(status.Harbor, system)
+	status.Harbor = system
+	return changed, nil
+}
+
+// parseHarborVersion reads the major and minor release from a Harbor build
+// version such as v2.11.0-a1b2c3d.
+func parseHarborVersion(version string) (int, int, bool) {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	minorDigits := strings.TrimRightFunc(parts[1], func(r rune) bool { return r < '0' || r > '9' })
+	minor, err := strconv.Atoi(minorDigits)
+	if err != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
+
+type harborFeatureRequirement struct {
+	feature harborv1alpha1.HarborFeature
+	field   string
+}
+
+// requiredHarborFeatures returns the optional Harbor features used by the
+// spec of obj.
+func requiredHarborFeatures(obj client.Object) []harborFeatureRequirement {
+	switch o := obj.(type) {
+	case *harborv1alpha1.Project:
+		if metadata := o.Spec.Metadata; metadata != nil && metadata.AutoSBOMGeneration != "" {
+			return []harborFeatureRequirement{{feature: harborv1alpha1.HarborFeatureSBOM, field: "spec.metadata.auto_sbom_generation"}}
+		}
+	}
+	return nil
+}
+
+type unsupportedHarborFeatureError struct {
+	field      string
+	feature    harborv1alpha1.HarborFeature
+	connection string
+	version    string
+}
+
+func (e *unsupportedHarborFeatureError) Error() string {
+	return fmt.Sprintf("%s requires Harbor feature %s, which Harbor %s behind %s does not support", e.field, e.feature, e.version, e.connection)
+}
+
+// checkHarborFeatures refuses specs that use features the connected Harbor
+// lacks. Checks are skipped until the connection has discovered the Harbor
+// version, so an anonymous connection never blocks a resource.
+func checkHarborFeatures(conn *connectionConfig, obj client.Object) error {
+	system := conn.system
+	if system == nil {
+		return nil
+	}
+	if _, _, ok := parseHarborVersion(system.Version); !ok {
+		return nil
+	}
+	for _, requirement := range requiredHarborFeatures(obj) {
+		if !slices.Contains(system.Features, requirement.feature) {
+			return &unsupportedHarborFeatureError{
+				field:      requirement.field,
+				feature:    requirement.feature,
+				connection: conn.displayName,
+				version:    system.Version,
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+	"k8s.io/utils/ptr"
+)
+
+func TestHarborSystemInfoFeatures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		info harborclient.SystemInfo
+		want []harborv1alpha1.HarborFeature
+	}{
+		{
+			name: "older Harbor",
+			info: harborclient.SystemInfo{HarborVersion: ptr.To("v2.9.4-abc"), AuthMode: ptr.To("db_auth")},
+			want: nil,
+		},
+		{
+			name: "security hub release",
+			info: harborclient.SystemInfo{HarborVersion: ptr.To("v2.10.0-abc"), AuthMode: ptr.To("db_auth")},
+			want: []harborv1alpha1.HarborFeature{harborv1alpha1.HarborFeatureSecurityHub},
+		},
+		{
+			name: "SBOM release with OIDC",
+			info: harborclient.SystemInfo{HarborVersion: ptr.To("v2.11.1-abc"), AuthMode: ptr.To("oidc_auth")},
+			want: []harborv1alpha1.HarborFeature{
+				harborv1alpha1.HarborFeatureSecurityHub,
+				harborv1alpha1.HarborFeatureSBOM,
+				harborv1alpha1.HarborFeatureOIDC,
+			},
+		},
+		{
+			name: "anonymous caller",
+			info: harborclient.SystemInfo{AuthMode: ptr.To("oidc_auth")},
+			want: []harborv1alpha1.HarborFeature{harborv1alpha1.HarborFeatureOIDC},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := harborSystemInfo(&tt.info).Features
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("features = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckHarborFeaturesRefusesAutoSBOMGenerationOnOlderHarbor(t *testing.T) {
+	t.Parallel()
+
+	project := &harborv1alpha1.Project{
+		Spec: harborv1alpha1.ProjectSpec{
+			Metadata: &harborv1alpha1.ProjectMetadata{AutoSBOMGeneration: "true"},
+		},
+	}
+	conn := &connectionConfig{
+		displayName: "HarborConnection default/harbor",
+		system:      harborSystemInfo(&harborclient.SystemInfo{HarborVersion: ptr.To("v2.10.2-abc")}),
+	}
+
+	err := checkHarborFeatures(conn, project)
+	var unsupported *unsupportedHarborFeatureError
+	if !errors.As(err, &unsupported) {
+		t.Fatalf("checkHarborFeatures error = %v, want unsupportedHarborFeatureError", err)
+	}
+	base := &harborv1alpha1.HarborStatusBase{}
+	markError(base, 1, err)
+	if got := base.Conditions[0].Reason; got != "UnsupportedHarborFeature" {
+		t.Fatalf("reason = %q, want %q", got, "UnsupportedHarborFeature")
+	}
+
+	conn.system = harborSystemInfo(&harborclient.SystemInfo{HarborVersion: ptr.To("v2.11.0-abc")})
+	if err := checkHarborFeatures(conn, project); err != nil {
+		t.Fatalf("checkHarborFeatures returned error on a supporting Harbor: %v", err)
+	}
+
+	conn.system = harborSystemInfo(&harborclient.SystemInfo{})
+	if err := checkHarborFeatures(conn, project); err != nil {
+		t.Fatalf("checkHarborFeatures returned error for an unknown version: %v", err)
+	}
+}
@@ -0,0 +1,218 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+	"github.com/rkthtrifork/harbor-operator/internal/metrics"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// harborConnectionSecretRefIndex indexes connections by the namespace/name
+	// of every Secret they read credentials or TLS material from.
+	harborConnectionSecretRefIndex = "harbor.harbor-operator.io/connectionSecretRef"
+
+	// harborClientCacheTTL bounds how long a cached client is reused without
+	// re-reading its Secrets. The Secret watch normally invalidates entries
+	// sooner; the TTL covers events missed while the watch was disconnected.
+	harborClientCacheTTL = 5 * time.Minute
+)
+
+// harborClientKey identifies the material a cached client was built from.
+type harborClientKey struct {
+	uid            string
+	credentialHash string
+	tlsHash        string
+	limits         harborv1alpha1.ConnectionLimits
+}
+
+type harborClientCacheEntry struct {
+	key        harborClientKey
+	kind       harborv1alpha1.HarborConnectionReferenceKind
+	namespace  string
+	name       string
+	generation int64
+	validated  time.Time
+	stale      bool
+	client     *harborclient.Client
+	breaker    *harborclient.Breaker
+}
+
+// harborClientCache shares one Harbor client, and therefore one HTTP transport
+// and its connection pool, between all reconcilers that use a connection.
+// A nil cache disables sharing and every lookup misses.
+type harborClientCache struct {
+	mu      sync.Mutex
+	entries map[string]*harborClientCacheEntry
+	now     func() time.Time
+}
+
+func newHarborClientCache() *harborClientCache {
+	return &harborClientCache{
+		entries: map[string]*harborClientCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+// lookup returns the cached client for conn when the connection generation is
+// unchanged and its Secrets were read recently enough to trust.
+func (c *harborClientCache) lookup(conn *connectionConfig) *harborclient.Client {
+	if c == nil || conn.uid == "" {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[conn.uid]
+	hit := ok && !entry.stale &&
+		entry.generation == conn.generation &&
+		c.now().Sub(entry.validated) < harborClientCacheTTL
+	metrics.ObserveHarborClientCache(hit)
+	if !hit {
+		return nil
+	}
+	return entry.client
+}
+
+// store records the client for conn after its Secrets have been read. The
+// existing client is kept when the credentials and TLS material are unchanged,
+// so a connection spec edit that only touches unrelated fields does not drop
+// pooled connections.
+func (c *harborClientCache) store(conn *connectionConfig, auth harborAuth, tlsMaterial harborTLS, build func() (*harborclient.Client, error)) (*harborclient.Client, error) {
+	if c == nil || conn.uid == "" {
+		return build()
+	}
+	key := harborClientKey{
+		uid:            conn.uid,
+		credentialHash: hashParts(conn.baseURL, auth.hash()),
+		tlsHash:        tlsMaterial.hash(),
+	}
+	if conn.limits != nil {
+		key.limits = *conn.limits
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[conn.uid]
+	if !ok || entry.key != key {
+		hc, err := build()
+		if err != nil {
+			return nil, err
+		}
+		// Limits and the circuit breaker are enforced per cached client, which
+		// is what makes them apply across all controllers using the
+		// connection. The breaker outlives client rebuilds: new credentials do
+		// not make an unreachable Harbor reachable.
+		breaker := harborclient.NewBreaker(connectionMetricName(conn), harborclient.DefaultBreakerThreshold, harborclient.DefaultBreakerCooldown)
+		if ok {
+			breaker = entry.breaker
+		}
+		hc.Limiter = newConnectionLimiter(conn)
+		hc.Breaker = breaker
+		entry = &harborClientCacheEntry{key: key, client: hc, breaker: breaker}
+		c.entries[conn.uid] = entry
+	}
+	entry.kind = conn.kind
+	entry.namespace = conn.namespace
+	entry.name = conn.name
+	entry.generation = conn.generation
+	entry.validated = c.now()
+	entry.stale = false
+	return entry.client, nil
+}
+
+// invalidate forces the next lookup for the connection to re-read its Secrets.
+func (c *harborClientCache) invalidate(uid string) {
+	if c == nil || uid == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[uid]; ok {
+		entry.stale = true
+	}
+}
+
+// forget drops the client of a deleted connection.
+func (c *harborClientCache) forget(kind harborv1alpha1.HarborConnectionReferenceKind, namespace, name string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for uid, entry := range c.entries {
+		if entry.kind == kind && entry.namespace == namespace && entry.name == name {
+			delete(c.entries, uid)
+		}
+	}
+}
+
+// connectionMetricName identifies a connection in the limiter and circuit
+// breaker metrics.
+func connectionMetricName(conn *connectionConfig) string {
+	if conn.namespace == "" {
+		return string(conn.kind) + "/" + conn.name
+	}
+	return string(conn.kind) + "/" + conn.namespace + "/" + conn.name
+}
+
+func newConnectionLimiter(conn *connectionConfig) *harborclient.Limiter {
+	limits := conn.limits
+	if limits == nil {
+		return nil
+	}
+	burst := limits.Burst
+	if burst == 0 {
+		burst = limits.RequestsPerSecond
+	}
+	return harborclient.NewLimiter(connectionMetricName(conn), int(limits.MaxInFlight), float64(limits.RequestsPerSecond), int(burst))
+}
+
+// connectionSecretKeys returns the namespace/name of every Secret a connection
+// reads. References without a namespace resolve to defaultNamespace, which is
+// empty for cluster-scoped connections.
+func connectionSecretKeys(defaultNamespace string, spec *harborv1alpha1.HarborConnectionSpec) []string {
+	refs := []*harborv1alpha1.SecretReference{spec.CABundleSecretRef, spec.ClientCertificateSecretRef}
+	if credentials := spec.Credentials; credentials != nil {
+		refs = append(refs, credentials.UsernameSecretRef, credentials.PasswordSecretRef, credentials.TokenSecretRef)
+		if credentials.TokenEndpoint != nil {
+			refs = append(refs, &credentials.TokenEndpoint.ClientSecretRef)
+		}
+	}
+
+	return secretRefKeys(defaultNamespace, refs...)
+}
+
+// requestsForConnectionSecret invalidates the cached clients of connections
+// that read secret and enqueues them so their status reflects the new material.
+func requestsForConnectionSecret(ctx context.Context, c client.Client, clients *harborClientCache, list client.ObjectList, secret client.Object) []reconcile.Request {
+	key := client.ObjectKeyFromObject(secret).String()
+	if err := c.List(ctx, list, client.MatchingFields{harborConnectionSecretRefIndex: key}); err != nil {
+		ctrl.Log.WithName("harbor-connection-secret-watch").Error(err, "Failed to list Harbor connections", "secret", key)
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	if err := apimeta.EachListItem(list, func(item runtime.Object) error {
+		obj, ok := item.(client.Object)
+		if !ok {
+			return nil
+		}
+		clients.invalidate(string(obj.GetUID()))
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)})
+		return nil
+	}); err != nil {
+		ctrl.Log.WithName("harbor-connection-secret-watch").Error(err, "Failed to walk Harbor connections", "secret", key)
+		return nil
+	}
+	return requests
+}
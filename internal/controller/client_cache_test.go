@@ -0,0 +1,230 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newClientCacheTestFixture(t *testing.T) (client.Client, OperatorOptions, *connectionConfig) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add core scheme: %v", err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "harbor-admin", Namespace: "connections"},
+		Data:       map[string][]byte{"access_secret": []byte("first")},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+	options, err := NewOperatorOptions(OperatorConfig{
+		DefaultCreationPolicy: harborv1alpha1.CreationPolicyCreate,
+		HarborRequestTimeout:  time.Second,
+	})
+	if err != nil {
+		t.Fatalf("NewOperatorOptions returned error: %v", err)
+	}
+	conn := &connectionConfig{
+		baseURL:     "https://harbor.example.com",
+		kind:        harborv1alpha1.HarborConnectionReferenceKindNamespaced,
+		name:        "harbor",
+		namespace:   "connections",
+		uid:         "uid-1",
+		generation:  1,
+		displayName: "test connection",
+		credentials: &harborv1alpha1.Credentials{
+			Username:          "admin",
+			PasswordSecretRef: &harborv1alpha1.SecretReference{Name: "harbor-admin"},
+		},
+	}
+	return c, options, conn
+}
+
+func TestBuildHarborClientReusesCachedClient(t *testing.T) {
+	t.Parallel()
+
+	c, options, conn := newClientCacheTestFixture(t)
+	first, err := buildHarborClient(context.Background(), options, c, conn, true)
+	if err != nil {
+		t.Fatalf("buildHarborClient returned error: %v", err)
+	}
+	second, err := buildHarborClient(context.Background(), options, c, conn, true)
+	if err != nil {
+		t.Fatalf("buildHarborClient returned error: %v", err)
+	}
+	if first != second {
+		t.Fatalf("expected the cached client to be reused")
+	}
+
+	// A generation bump re-reads the Secrets but keeps the client when the
+	// material is unchanged.
+	conn.generation = 2
+	third, err := buildHarborClient(context.Background(), options, c, conn, true)
+	if err != nil {
+		t.Fatalf("buildHarborClient returned error: %v", err)
+	}
+	if third != first {
+		t.Fatalf("expected the client to survive a generation change with unchanged Secrets")
+	}
+}
+
+func TestBuildHarborClientRebuildsAfterSecretChange(t *testing.T) {
+	t.Parallel()
+
+	c, options, conn := newClientCacheTestFixture(t)
+	first, err := buildHarborClient(context.Background(), options, c, conn, true)
+	if err != nil {
+		t.Fatalf("buildHarborClient returned error: %v", err)
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(context.Background(), client.ObjectKey{Namespace: "connections", Name: "harbor-admin"}, secret); err != nil {
+		t.Fatalf("get secret: %v", err)
+	}
+	secret.Data["access_secret"] = []byte("second")
+	if err := c.Update(context.Background(), secret); err != nil {
+		t.Fatalf("update secret: %v", err)
+	}
+
+	cached, err := buildHarborClient(context.Background(), options, c, conn, true)
+	if err != nil {
+		t.Fatalf("buildHarborClient returned error: %v", err)
+	}
+	if cached != first {
+		t.Fatalf("expected the cached client until the connection is invalidated")
+	}
+
+	options.harborClients.invalidate(conn.uid)
+	rebuilt, err := buildHarborClient(context.Background(), options, c, conn, true)
+	if err != nil {
+		t.Fatalf("buildHarborClient returned error: %v", err)
+	}
+	if rebuilt == first {
+		t.Fatalf("expected a new client after the password changed")
+	}
+}
+
+func TestBuildHarborClientSharesConnectionLimiter(t *testing.T) {
+	t.Parallel()
+
+	c, options, conn := newClientCacheTestFixture(t)
+	conn.limits = &harborv1alpha1.ConnectionLimits{MaxInFlight: 4, RequestsPerSecond: 10}
+	first, err := buildHarborClient(context.Background(), options, c, conn, true)
+	if err != nil {
+		t.Fatalf("buildHarborClient returned error: %v", err)
+	}
+	if first.Limiter == nil {
+		t.Fatalf("expected the client of a limited connection to have a limiter")
+	}
+	second, err := buildHarborClient(context.Background(), options, c, conn, true)
+	if err != nil {
+		t.Fatalf("buildHarborClient returned error: %v", err)
+	}
+	if second.Limiter != first.Limiter {
+		t.Fatalf("expected clients of one connection to share its limiter")
+	}
+
+	conn.generation = 2
+	conn.limits = nil
+	unlimited, err := buildHarborClient(context.Background(), options, c, conn, true)
+	if err != nil {
+		t.Fatalf("buildHarborClient returned error: %v", err)
+	}
+	if unlimited.Limiter != nil {
+		t.Fatalf("expected removing the limits to drop the limiter")
+	}
+}
+
+func TestBuildHarborClientKeepsBreakerAcrossRebuilds(t *testing.T) {
+	t.Parallel()
+
+	c, options, conn := newClientCacheTestFixture(t)
+	first, err := buildHarborClient(context.Background(), options, c, conn, true)
+	if err != nil {
+		t.Fatalf("buildHarborClient returned error: %v", err)
+	}
+	if first.Breaker == nil {
+		t.Fatalf("expected the shared client to have a circuit breaker")
+	}
+
+	conn.generation = 2
+	conn.limits = &harborv1alpha1.ConnectionLimits{MaxInFlight: 1}
+	rebuilt, err := buildHarborClient(context.Background(), options, c, conn, true)
+	if err != nil {
+		t.Fatalf("buildHarborClient returned error: %v", err)
+	}
+	if rebuilt == first || rebuilt.Breaker != first.Breaker {
+		t.Fatalf("expected a rebuilt client that keeps the connection breaker")
+	}
+
+	base := &harborv1alpha1.HarborStatusBase{}
+	markError(base, 1, &harborclient.UnavailableError{Connection: "HarborConnection/connections/harbor", Failures: 5})
+	if got := base.Conditions[0].Reason; got != "HarborUnavailable" {
+		t.Fatalf("reason = %q, want %q", got, "HarborUnavailable")
+	}
+}
+
+func TestHarborClientCacheExpiresAndForgets(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := newHarborClientCache()
+	cache.now = func() time.Time { return now }
+	_, _, conn := newClientCacheTestFixture(t)
+
+	hc, err := cache.store(conn, harborAuth{username: "admin", password: "first"}, harborTLS{}, func() (*harborclient.Client, error) {
+		return harborclient.New(conn.baseURL, "admin", "first"), nil
+	})
+	if err != nil {
+		t.Fatalf("store returned error: %v", err)
+	}
+	if got := cache.lookup(conn); got != hc {
+		t.Fatalf("expected a cache hit right after store")
+	}
+
+	now = now.Add(harborClientCacheTTL)
+	if got := cache.lookup(conn); got != nil {
+		t.Fatalf("expected a cache miss after the TTL elapsed")
+	}
+
+	cache.forget(conn.kind, conn.namespace, conn.name)
+	if len(cache.entries) != 0 {
+		t.Fatalf("expected forget to drop the entry, got %d entries", len(cache.entries))
+	}
+}
+
+func TestConnectionSecretKeys(t *testing.T) {
+	t.Parallel()
+
+	spec := &harborv1alpha1.HarborConnectionSpec{
+		CABundleSecretRef: &harborv1alpha1.SecretReference{Name: "harbor-ca", Namespace: "pki"},
+		Credentials: &harborv1alpha1.Credentials{
+			UsernameSecretRef: &harborv1alpha1.SecretReference{Name: "harbor-admin"},
+			PasswordSecretRef: &harborv1alpha1.SecretReference{Name: "harbor-admin"},
+		},
+	}
+
+	got := connectionSecretKeys("connections", spec)
+	want := []string{"pki/harbor-ca", "connections/harbor-admin", "connections/harbor-admin"}
+	if len(got) != len(want) {
+		t.Fatalf("connectionSecretKeys = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("connectionSecretKeys = %v, want %v", got, want)
+		}
+	}
+
+	if got := connectionSecretKeys("", spec); len(got) != 1 || got[0] != "pki/harbor-ca" {
+		t.Fatalf("cluster-scoped connectionSecretKeys = %v, want only the namespaced reference", got)
+	}
+}
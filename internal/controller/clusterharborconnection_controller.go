@@ -4,10 +4,16 @@ import (
 	"context"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/go-logr/logr"
 	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
@@ -15,9 +21,10 @@ import (
 
 type ClusterHarborConnectionReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=clusterharborconnections,verbs=get;list;watch;create;update;patch;delete
@@ -32,66 +39,62 @@ func (r *ClusterHarborConnectionReconciler) Reconcile(ctx context.Context, req c
 	if err := r.Get(ctx, req.NamespacedName, &conn); err != nil {
 		if client.IgnoreNotFound(err) == nil {
 			r.logger.V(1).Info("ClusterHarborConnection resource not found")
+			r.Options.harborClients.forget(harborv1alpha1.HarborConnectionReferenceKindCluster, "", req.Name)
 			return ctrl.Result{}, nil
 		}
 		r.logger.Error(err, "Failed to get ClusterHarborConnection")
 		return ctrl.Result{}, err
 	}
+	traceObject(ctx, &conn)
 
 	if err := markReconcilingIfNeeded(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	if err := validateBaseURL(conn.Spec.BaseURL); err != nil {
-		r.logger.Error(err, "Invalid baseURL")
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
+	// A paused connection is not checked, and pauses its dependents.
+	if err := checkPaused(&conn, nil); err != nil {
+		return ctrl.Result{}, setPausedStatus(ctx, r.Client, r.Recorder, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
 	}
-
-	cfg := &connectionConfig{
-		baseURL:           conn.Spec.BaseURL,
-		kind:              harborv1alpha1.HarborConnectionReferenceKindCluster,
-		name:              conn.Name,
-		namespace:         "",
-		uid:               string(conn.UID),
-		credentials:       conn.Spec.Credentials,
-		caBundle:          conn.Spec.CABundle,
-		caBundleSecretRef: conn.Spec.CABundleSecretRef,
-		displayName:       fmt.Sprintf("ClusterHarborConnection %s", conn.Name),
+	if err := setResumedStatus(ctx, r.Client, r.Recorder, &conn, &conn.Status.HarborStatusBase, conn.Generation); err != nil {
+		return ctrl.Result{}, err
 	}
 
-	if conn.Spec.Credentials == nil {
-		hc, err := buildHarborClient(ctx, r.Options, r.Client, cfg, false)
-		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
-		}
-		if err := hc.Ping(ctx); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
-		}
-		conn.Status.Authenticated = false
-		if err := setReadyStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, "Reachable", "Harbor reachable without credentials"); err != nil {
-			return ctrl.Result{}, err
-		}
-		return ctrl.Result{}, nil
+	if err := validateBaseURL(conn.Spec.BaseURL); err != nil {
+		r.logger.Error(err, "Invalid baseURL")
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
 	}
 
-	hc, err := buildHarborClient(ctx, r.Options, r.Client, cfg, true)
-	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
-	}
-	if err := hc.CheckAuthentication(ctx); err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
-	}
+	cfg := clusterConnectionConfig(&conn)
+	// Check the current Secret material rather than a cached client. This also
+	// refreshes the client shared with dependent resources.
+	r.Options.harborClients.invalidate(cfg.uid)
 
-	conn.Status.Authenticated = true
-	if err := setReadyStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, "Authenticated", "Successfully authenticated with Harbor API"); err != nil {
-		return ctrl.Result{}, err
+	reason, message, statusChanged, err := checkHarborConnection(ctx, r.Options, r.Client, cfg, &conn.Status)
+	if err != nil {
+		r.logger.Error(err, "Harbor connection check failed")
+	} else {
+		r.logger.Info(message)
 	}
-	return ctrl.Result{}, nil
+	return finishConnectionCheck(ctx, r.Client, r.Recorder, &conn, &conn.Spec, &conn.Status, conn.Generation, reason, message, statusChanged, err)
 }
 
 func (r *ClusterHarborConnectionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &harborv1alpha1.ClusterHarborConnection{}, harborConnectionSecretRefIndex, func(raw client.Object) []string {
+		conn := raw.(*harborv1alpha1.ClusterHarborConnection)
+		return connectionSecretKeys("", &conn.Spec)
+	}); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&harborv1alpha1.ClusterHarborConnection{}).
+		For(&harborv1alpha1.ClusterHarborConnection{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
+				return requestsForConnectionSecret(ctx, mgr.GetClient(), r.Options.harborClients, &harborv1alpha1.ClusterHarborConnectionList{}, object)
+			}),
+			builder.OnlyMetadata,
+		).
 		Named("clusterharborconnection").
-		Complete(r)
+		Complete(traceReconciler("ClusterHarborConnection", r))
 }
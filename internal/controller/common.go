@@ -16,12 +16,15 @@ import (
 	"github.com/go-logr/logr"
 	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
 	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,6 +39,10 @@ const (
 
 	harborConnectionRefNamespacedIndex = "harbor.harbor-operator.io/harborConnectionRefNamespaced"
 	harborConnectionRefClusterIndex    = "harbor.harbor-operator.io/harborConnectionRefCluster"
+
+	// harborSecretRefIndex indexes Harbor-backed resources by the
+	// namespace/name of every Secret they read.
+	harborSecretRefIndex = "harbor.harbor-operator.io/secretRef"
 )
 
 type connectionConfig struct {
@@ -44,10 +51,16 @@ type connectionConfig struct {
 	name              string
 	namespace         string
 	uid               string
+	generation        int64
 	credentials       *harborv1alpha1.Credentials
 	caBundle          string
 	caBundleSecretRef *harborv1alpha1.SecretReference
+	clientCertificate *harborv1alpha1.SecretReference
+	system            *harborv1alpha1.HarborSystemInfo
+	healthFailure     string
+	limits            *harborv1alpha1.ConnectionLimits
 	displayName       string
+	paused            bool
 }
 
 type harborConnectionChangedError struct {
@@ -59,7 +72,10 @@ func (e *harborConnectionChangedError) Error() string {
 	return fmt.Sprintf("resolved Harbor connection changed from %s %s/%s (uid %s) to %s %s/%s (uid %s); refusing to modify Harbor", e.previous.Kind, e.previous.Namespace, e.previous.Name, e.previous.UID, e.current.Kind, e.current.Namespace, e.current.Name, e.current.UID)
 }
 
-func requireCreationAllowed(options OperatorOptions, policy harborv1alpha1.CreationPolicy) error {
+func requireCreationAllowed(options OperatorOptions, policy harborv1alpha1.CreationPolicy, management harborv1alpha1.ManagementPolicy) error {
+	if !management.AllowsWrites() {
+		return fmt.Errorf("no matching Harbor resource to observe, and managementPolicy %q does not allow creating one", management)
+	}
 	policy = options.effectiveCreationPolicy(policy)
 	if policy.AllowsCreation() {
 		return nil
@@ -67,8 +83,24 @@ func requireCreationAllowed(options OperatorOptions, policy harborv1alpha1.Creat
 	return fmt.Errorf("creationPolicy %q requires an existing Harbor resource to adopt", policy)
 }
 
-func allowsAdoption(options OperatorOptions, policy harborv1alpha1.CreationPolicy) bool {
-	return options.effectiveCreationPolicy(policy).AllowsAdoption()
+// allowsAdoption reports whether an existing Harbor resource may be taken over.
+// A resource that is only observed is always matched by name.
+func allowsAdoption(options OperatorOptions, policy harborv1alpha1.CreationPolicy, management harborv1alpha1.ManagementPolicy) bool {
+	return !management.AllowsWrites() || options.effectiveCreationPolicy(policy).AllowsAdoption()
+}
+
+func observedDriftMessage(kind string, management harborv1alpha1.ManagementPolicy) string {
+	return fmt.Sprintf("%s in Harbor differs from the spec; managementPolicy %q leaves it unchanged", kind, management)
+}
+
+// reportObservedDrift marks a resource whose Harbor object differs from its
+// spec, but whose management policy forbids the update, as Ready with reason
+// DriftDetected and schedules the next drift check.
+func reportObservedDrift(ctx context.Context, options OperatorOptions, c client.Client, obj client.Object, base *harborv1alpha1.HarborStatusBase, generation int64, spec *harborv1alpha1.HarborSpecBase, kind string) (reconcile.Result, error) {
+	if err := setReadyStatus(ctx, c, obj, base, generation, ReasonDriftDetected, observedDriftMessage(kind, spec.ManagementPolicy)); err != nil {
+		return reconcile.Result{}, err
+	}
+	return returnWithDriftDetection(options, spec)
 }
 
 func validateBaseURL(baseURL string) error {
@@ -84,13 +116,48 @@ func validateBaseURL(baseURL string) error {
 
 type harborConnectionRefAccessor func(client.Object) *harborv1alpha1.HarborConnectionReference
 
+// secretRefAccessor returns the namespace/name keys of the Secrets a resource
+// reads, as built by secretRefKeys. Secrets the operator writes, such as robot
+// credentials, are not included.
+type secretRefAccessor func(client.Object) []string
+
+// setupHarborBackedController builds a controller for obj that is also
+// triggered by changes to its Harbor connection and, when getSecretRefs is not
+// nil, to the Secrets it reads.
 func setupHarborBackedController(
 	mgr ctrl.Manager,
 	options OperatorOptions,
 	obj client.Object,
 	newList func() client.ObjectList,
 	getRef harborConnectionRefAccessor,
+	getSecretRefs secretRefAccessor,
 	name string,
+) (*builder.TypedBuilder[reconcile.Request], error) {
+	b, err := watchHarborConnections(mgr, options, obj, newList, getRef)
+	if err != nil {
+		return nil, err
+	}
+	if getSecretRefs != nil {
+		if err := mgr.GetFieldIndexer().IndexField(context.Background(), obj, harborSecretRefIndex, client.IndexerFunc(getSecretRefs)); err != nil {
+			return nil, err
+		}
+		b = b.Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
+				return requestsForIndexedDependents(ctx, mgr, newList, harborSecretRefIndex, client.ObjectKeyFromObject(object).String())
+			}),
+			builder.OnlyMetadata,
+		)
+	}
+	return b.Named(name), nil
+}
+
+func watchHarborConnections(
+	mgr ctrl.Manager,
+	options OperatorOptions,
+	obj client.Object,
+	newList func() client.ObjectList,
+	getRef harborConnectionRefAccessor,
 ) (*builder.TypedBuilder[reconcile.Request], error) {
 	if forcedName := options.forcedHarborConnection; forcedName != "" {
 		// In operator-wide connection mode, every Harbor-backed object depends on
@@ -106,8 +173,8 @@ func setupHarborBackedController(
 					}
 					return requestsForAllHarborBackedObjects(ctx, mgr, newList)
 				}),
-			).
-			Named(name), nil
+				builder.WithPredicates(dependentConnectionPredicate()),
+			), nil
 	}
 
 	if err := mgr.GetFieldIndexer().IndexField(context.Background(), obj, harborConnectionRefNamespacedIndex, func(raw client.Object) []string {
@@ -135,19 +202,22 @@ func setupHarborBackedController(
 		Watches(
 			&harborv1alpha1.HarborConnection{},
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
-				return requestsForIndexedHarborConnection(ctx, mgr, newList, harborConnectionRefNamespacedIndex, client.ObjectKeyFromObject(object).String())
+				return requestsForIndexedDependents(ctx, mgr, newList, harborConnectionRefNamespacedIndex, client.ObjectKeyFromObject(object).String())
 			}),
+			builder.WithPredicates(dependentConnectionPredicate()),
 		).
 		Watches(
 			&harborv1alpha1.ClusterHarborConnection{},
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
-				return requestsForIndexedHarborConnection(ctx, mgr, newList, harborConnectionRefClusterIndex, object.GetName())
+				return requestsForIndexedDependents(ctx, mgr, newList, harborConnectionRefClusterIndex, object.GetName())
 			}),
-		).
-		Named(name), nil
+			builder.WithPredicates(dependentConnectionPredicate()),
+		), nil
 }
 
-func requestsForIndexedHarborConnection(
+// requestsForIndexedDependents enqueues the objects whose indexName index
+// contains indexValue.
+func requestsForIndexedDependents(
 	ctx context.Context,
 	mgr ctrl.Manager,
 	newList func() client.ObjectList,
@@ -155,7 +225,7 @@ func requestsForIndexedHarborConnection(
 ) []reconcile.Request {
 	list := newList()
 	if err := mgr.GetClient().List(ctx, list, client.MatchingFields{indexName: indexValue}); err != nil {
-		ctrl.Log.WithName("harbor-connection-watch").Error(err, "Failed to list Harbor dependents", "index", indexName, "value", indexValue)
+		ctrl.Log.WithName("harbor-dependents-watch").Error(err, "Failed to list Harbor dependents", "index", indexName, "value", indexValue)
 		return nil
 	}
 
@@ -168,7 +238,7 @@ func requestsForIndexedHarborConnection(
 		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)})
 		return nil
 	}); err != nil {
-		ctrl.Log.WithName("harbor-connection-watch").Error(err, "Failed to walk Harbor dependents", "index", indexName, "value", indexValue)
+		ctrl.Log.WithName("harbor-dependents-watch").Error(err, "Failed to walk Harbor dependents", "index", indexName, "value", indexValue)
 		return nil
 	}
 	return requests
@@ -201,6 +271,9 @@ func requestsForAllHarborBackedObjects(
 }
 
 func resolveHarborConnection(ctx context.Context, options OperatorOptions, c client.Client, namespace string, ref *harborv1alpha1.HarborConnectionReference) (*connectionConfig, error) {
+	if hc := options.harborClient; hc != nil {
+		return &connectionConfig{baseURL: hc.BaseURL, displayName: hc.BaseURL}, nil
+	}
 	if forcedName := options.forcedHarborConnection; forcedName != "" {
 		if ref != nil && ref.Name != "" {
 			normalized := normalizedHarborConnectionRef(ref)
@@ -231,39 +304,59 @@ func resolveHarborConnection(ctx context.Context, options OperatorOptions, c cli
 		if err := c.Get(ctx, key, &harborConn); err != nil {
 			return nil, err
 		}
-		return &connectionConfig{
-			baseURL:           harborConn.Spec.BaseURL,
-			kind:              harborv1alpha1.HarborConnectionReferenceKindNamespaced,
-			name:              harborConn.Name,
-			namespace:         harborConn.Namespace,
-			uid:               string(harborConn.UID),
-			credentials:       harborConn.Spec.Credentials,
-			caBundle:          harborConn.Spec.CABundle,
-			caBundleSecretRef: harborConn.Spec.CABundleSecretRef,
-			displayName:       fmt.Sprintf("HarborConnection %s/%s", harborConn.Namespace, harborConn.Name),
-		}, nil
+		return namespacedConnectionConfig(&harborConn), nil
 	case harborv1alpha1.HarborConnectionReferenceKindCluster:
 		var harborConn harborv1alpha1.ClusterHarborConnection
 		key := types.NamespacedName{Name: ref.Name}
 		if err := c.Get(ctx, key, &harborConn); err != nil {
 			return nil, err
 		}
-		return &connectionConfig{
-			baseURL:           harborConn.Spec.BaseURL,
-			kind:              harborv1alpha1.HarborConnectionReferenceKindCluster,
-			name:              harborConn.Name,
-			namespace:         "",
-			uid:               string(harborConn.UID),
-			credentials:       harborConn.Spec.Credentials,
-			caBundle:          harborConn.Spec.CABundle,
-			caBundleSecretRef: harborConn.Spec.CABundleSecretRef,
-			displayName:       fmt.Sprintf("ClusterHarborConnection %s", harborConn.Name),
-		}, nil
+		return clusterConnectionConfig(&harborConn), nil
 	default:
 		return nil, fmt.Errorf("unsupported harborConnectionRef.kind %q", ref.Kind)
 	}
 }
 
+func namespacedConnectionConfig(conn *harborv1alpha1.HarborConnection) *connectionConfig {
+	return &connectionConfig{
+		baseURL:           conn.Spec.BaseURL,
+		kind:              harborv1alpha1.HarborConnectionReferenceKindNamespaced,
+		name:              conn.Name,
+		namespace:         conn.Namespace,
+		uid:               string(conn.UID),
+		generation:        conn.Generation,
+		credentials:       conn.Spec.Credentials,
+		caBundle:          conn.Spec.CABundle,
+		caBundleSecretRef: conn.Spec.CABundleSecretRef,
+		clientCertificate: conn.Spec.ClientCertificateSecretRef,
+		system:            conn.Status.Harbor,
+		healthFailure:     connectionHealthFailure(&conn.Status),
+		limits:            conn.Spec.Limits,
+		displayName:       fmt.Sprintf("HarborConnection %s/%s", conn.Namespace, conn.Name),
+		paused:            hasPausedAnnotation(conn),
+	}
+}
+
+func clusterConnectionConfig(conn *harborv1alpha1.ClusterHarborConnection) *connectionConfig {
+	return &connectionConfig{
+		baseURL:           conn.Spec.BaseURL,
+		kind:              harborv1alpha1.HarborConnectionReferenceKindCluster,
+		name:              conn.Name,
+		namespace:         "",
+		uid:               string(conn.UID),
+		generation:        conn.Generation,
+		credentials:       conn.Spec.Credentials,
+		caBundle:          conn.Spec.CABundle,
+		caBundleSecretRef: conn.Spec.CABundleSecretRef,
+		clientCertificate: conn.Spec.ClientCertificateSecretRef,
+		system:            conn.Status.Harbor,
+		healthFailure:     connectionHealthFailure(&conn.Status),
+		limits:            conn.Spec.Limits,
+		displayName:       fmt.Sprintf("ClusterHarborConnection %s", conn.Name),
+		paused:            hasPausedAnnotation(conn),
+	}
+}
+
 func getHarborAuth(ctx context.Context, options OperatorOptions, c client.Client, conn *connectionConfig) (string, string, error) {
 	if conn.credentials == nil {
 		return "", "", fmt.Errorf("%s has no credentials configured", conn.displayName)
@@ -276,33 +369,117 @@ func getHarborAuth(ctx context.Context, options OperatorOptions, c client.Client
 			return "", "", err
 		}
 	}
-	pass, err := readSecretValue(ctx, options, c, harborv1alpha1.SecretReference{
-		Name:      conn.credentials.PasswordSecretRef.Name,
-		Key:       conn.credentials.PasswordSecretRef.Key,
-		Namespace: conn.credentials.PasswordSecretRef.Namespace,
-	}, conn.namespace, "access_secret")
+	if conn.credentials.PasswordSecretRef == nil {
+		return "", "", fmt.Errorf("%s has no passwordSecretRef configured", conn.displayName)
+	}
+	pass, err := readSecretValue(ctx, options, c, *conn.credentials.PasswordSecretRef, conn.namespace, "access_secret")
 	if err != nil {
 		return "", "", err
 	}
 	return username, pass, nil
 }
 
+// harborAuth is the authentication material resolved from a connection's
+// credentials and Secrets.
+type harborAuth struct {
+	username      string
+	password      string
+	token         string
+	tokenEndpoint *harborv1alpha1.TokenEndpoint
+	clientSecret  string
+}
+
+func resolveHarborAuth(ctx context.Context, options OperatorOptions, c client.Client, conn *connectionConfig) (harborAuth, error) {
+	if conn.credentials == nil {
+		return harborAuth{}, nil
+	}
+	if conn.credentials.Type != harborv1alpha1.CredentialTypeBearerToken {
+		user, pass, err := getHarborAuth(ctx, options, c, conn)
+		if err != nil {
+			return harborAuth{}, err
+		}
+		return harborAuth{username: user, password: pass}, nil
+	}
+
+	if ref := conn.credentials.TokenSecretRef; ref != nil {
+		token, err := readSecretValue(ctx, options, c, *ref, conn.namespace, "token")
+		if err != nil {
+			return harborAuth{}, fmt.Errorf("failed to read tokenSecretRef: %w", err)
+		}
+		return harborAuth{token: token}, nil
+	}
+	if endpoint := conn.credentials.TokenEndpoint; endpoint != nil {
+		secret, err := readSecretValue(ctx, options, c, endpoint.ClientSecretRef, conn.namespace, "clientSecret")
+		if err != nil {
+			return harborAuth{}, fmt.Errorf("failed to read tokenEndpoint.clientSecretRef: %w", err)
+		}
+		return harborAuth{tokenEndpoint: endpoint, clientSecret: secret}, nil
+	}
+	return harborAuth{}, fmt.Errorf("%s bearerToken credentials need tokenSecretRef or tokenEndpoint", conn.displayName)
+}
+
+// hash identifies the material so cached clients are rebuilt when it changes.
+func (a harborAuth) hash() string {
+	parts := []string{a.username, a.password, a.token}
+	if endpoint := a.tokenEndpoint; endpoint != nil {
+		parts = append(parts, endpoint.URL, endpoint.ClientID, a.clientSecret, endpoint.Audience, strings.Join(endpoint.Scopes, " "))
+	}
+	return hashParts(parts...)
+}
+
+// tokenSource returns the bearer token source for a, or nil for basic auth.
+func (a harborAuth) tokenSource(options OperatorOptions) oauth2.TokenSource {
+	if a.token != "" {
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: a.token})
+	}
+	endpoint := a.tokenEndpoint
+	if endpoint == nil {
+		return nil
+	}
+	config := clientcredentials.Config{
+		ClientID:     endpoint.ClientID,
+		ClientSecret: a.clientSecret,
+		TokenURL:     endpoint.URL,
+		Scopes:       endpoint.Scopes,
+	}
+	if endpoint.Audience != "" {
+		config.EndpointParams = url.Values{"audience": {endpoint.Audience}}
+	}
+	// The identity provider is usually not signed by the Harbor CA bundle, so
+	// token requests use the system trust store. The returned source caches the
+	// token and fetches a new one shortly before it expires.
+	tokenClient := &http.Client{Timeout: options.requestTimeout()}
+	return config.TokenSource(context.WithValue(context.Background(), oauth2.HTTPClient, tokenClient))
+}
+
 // getHarborClientForObject resolves and binds the connection before building a
 // client. The binding is persisted before the client is returned, so a Harbor
 // API mutation can never happen before the object has recorded its identity.
+// It returns a reconciliationPausedError when the object or its connection is
+// paused, and otherwise clears a Paused condition left by an earlier reconcile.
 func getHarborClientForObject(
 	ctx context.Context,
 	options OperatorOptions,
 	c client.Client,
+	recorder events.EventRecorder,
 	obj client.Object,
 	base *harborv1alpha1.HarborStatusBase,
 	namespace string,
 	ref *harborv1alpha1.HarborConnectionReference,
 ) (*harborclient.Client, error) {
+	if err := checkPaused(obj, nil); err != nil {
+		return nil, err
+	}
 	conn, err := resolveHarborConnection(ctx, options, c, namespace, ref)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkPaused(obj, conn); err != nil {
+		return nil, err
+	}
+	if err := setResumedStatus(ctx, c, recorder, obj, base, obj.GetGeneration()); err != nil {
+		return nil, err
+	}
 	current := harborv1alpha1.HarborConnectionBinding{
 		Kind:      conn.kind,
 		Name:      conn.name,
@@ -320,6 +497,15 @@ func getHarborClientForObject(
 		}
 	}
 
+	if err := requireHealthyConnection(conn); err != nil {
+		return nil, err
+	}
+	if obj.GetDeletionTimestamp().IsZero() {
+		if err := checkHarborFeatures(conn, obj); err != nil {
+			return nil, err
+		}
+	}
+
 	hc, err := buildHarborClient(ctx, options, c, conn, true)
 	if err != nil {
 		return nil, err
@@ -327,23 +513,93 @@ func getHarborClientForObject(
 	return hc, nil
 }
 
+// buildHarborClient returns the shared client for conn when the operator has a
+// client cache, and otherwise builds a new client from the referenced Secrets.
 func buildHarborClient(ctx context.Context, options OperatorOptions, c client.Client, conn *connectionConfig, requireCredentials bool) (*harborclient.Client, error) {
-	user, pass := "", ""
-	if conn.credentials != nil {
-		var err error
-		user, pass, err = getHarborAuth(ctx, options, c, conn)
-		if err != nil {
-			return nil, err
-		}
-	} else if requireCredentials {
+	if options.harborClient != nil {
+		return options.harborClient, nil
+	}
+	if conn.credentials == nil && requireCredentials {
 		return nil, fmt.Errorf("%s has no credentials configured", conn.displayName)
 	}
+	if hc := options.harborClients.lookup(conn); hc != nil {
+		return hc, nil
+	}
 
+	auth, err := resolveHarborAuth(ctx, options, c, conn)
+	if err != nil {
+		return nil, err
+	}
+	tlsMaterial, err := resolveConnectionTLS(ctx, options, c, conn)
+	if err != nil {
+		return nil, err
+	}
+	return options.harborClients.store(conn, auth, tlsMaterial, func() (*harborclient.Client, error) {
+		return newHarborClient(options, conn.baseURL, auth, tlsMaterial)
+	})
+}
+
+// harborTLS is the TLS material resolved from a connection.
+type harborTLS struct {
+	caBundle  string
+	clientPEM string
+	clientKey string
+}
+
+func resolveConnectionTLS(ctx context.Context, options OperatorOptions, c client.Client, conn *connectionConfig) (harborTLS, error) {
 	caBundle, err := resolveConnectionCABundle(ctx, options, c, conn)
+	if err != nil {
+		return harborTLS{}, err
+	}
+	material := harborTLS{caBundle: caBundle}
+	if ref := conn.clientCertificate; ref != nil {
+		certRef := *ref
+		certRef.Key = corev1.TLSCertKey
+		if material.clientPEM, err = readSecretValue(ctx, options, c, certRef, conn.namespace, ""); err != nil {
+			return harborTLS{}, fmt.Errorf("failed to read clientCertificateSecretRef: %w", err)
+		}
+		keyRef := *ref
+		keyRef.Key = corev1.TLSPrivateKeyKey
+		if material.clientKey, err = readSecretValue(ctx, options, c, keyRef, conn.namespace, ""); err != nil {
+			return harborTLS{}, fmt.Errorf("failed to read clientCertificateSecretRef: %w", err)
+		}
+	}
+	return material, nil
+}
+
+// clientCertificate parses the client certificate, or returns nil when the
+// connection does not use one.
+func (t harborTLS) clientCertificate() (*tls.Certificate, error) {
+	if t.clientPEM == "" {
+		return nil, nil
+	}
+	cert, err := tls.X509KeyPair([]byte(t.clientPEM), []byte(t.clientKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid client certificate: %w", err)
+	}
+	return &cert, nil
+}
+
+func (t harborTLS) hash() string {
+	return hashParts(t.caBundle, t.clientPEM, t.clientKey)
+}
+
+// clientCertificateNotAfter returns the expiry of the connection's client
+// certificate, or nil when it has none.
+func clientCertificateNotAfter(ctx context.Context, options OperatorOptions, c client.Client, conn *connectionConfig) (*metav1.Time, error) {
+	if conn.clientCertificate == nil {
+		return nil, nil
+	}
+	material, err := resolveConnectionTLS(ctx, options, c, conn)
 	if err != nil {
 		return nil, err
 	}
-	return newHarborClient(options, conn.baseURL, user, pass, caBundle)
+	cert, err := material.clientCertificate()
+	if err != nil {
+		return nil, err
+	}
+	notAfter := metav1.NewTime(cert.Leaf.NotAfter)
+	return &notAfter, nil
 }
 
 func resolveConnectionCABundle(ctx context.Context, options OperatorOptions, c client.Client, conn *connectionConfig) (string, error) {
@@ -361,21 +617,34 @@ func resolveConnectionCABundle(ctx context.Context, options OperatorOptions, c c
 	return caBundle, nil
 }
 
-func newHarborClient(options OperatorOptions, baseURL, user, pass, caBundle string) (*harborclient.Client, error) {
+func newHarborClient(options OperatorOptions, baseURL string, auth harborAuth, tlsMaterial harborTLS) (*harborclient.Client, error) {
 	transport := http.DefaultTransport.(*http.Transport).Clone()
-	if caBundle != "" {
+	if tlsMaterial.caBundle != "" {
 		pool := x509.NewCertPool()
-		if !pool.AppendCertsFromPEM([]byte(caBundle)) {
+		if !pool.AppendCertsFromPEM([]byte(tlsMaterial.caBundle)) {
 			return nil, fmt.Errorf("invalid caBundle: no certificates found")
 		}
 		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
 	}
+	cert, err := tlsMaterial.clientCertificate()
+	if err != nil {
+		return nil, err
+	}
+	if cert != nil {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.Certificates = []tls.Certificate{*cert}
+	}
 
 	httpClient := &http.Client{
 		Timeout:   options.requestTimeout(),
 		Transport: transport,
 	}
-	return harborclient.NewWithHTTPClient(baseURL, user, pass, httpClient), nil
+	if tokens := auth.tokenSource(options); tokens != nil {
+		return harborclient.NewWithTokenSource(baseURL, tokens, httpClient), nil
+	}
+	return harborclient.NewWithHTTPClient(baseURL, auth.username, auth.password, httpClient), nil
 }
 
 func ensureFinalizer(ctx context.Context, c client.Client, obj client.Object) error {
@@ -451,6 +720,28 @@ func hashParts(parts ...string) string {
 	return hashSecret(strings.Join(parts, "\n"))
 }
 
+// optional returns nil for the zero value, so optional Harbor API fields the
+// spec leaves unset are omitted from requests.
+func optional[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+// scheduleObjEqual compares the schedule type and cron Harbor reports with the
+// desired ones.
+func scheduleObjEqual(current, desired harborclient.ScheduleObj) bool {
+	return current.Type == desired.Type && current.Cron == desired.Cron
+}
+
+// scheduleObjState keeps the fields of a schedule that scheduleObjEqual
+// compares.
+func scheduleObjState(s harborclient.ScheduleObj) harborclient.ScheduleObj {
+	return harborclient.ScheduleObj{Type: s.Type, Cron: s.Cron}
+}
+
 func scheduleParameters(in map[string]apiextensionsv1.JSON, kind string) (map[string]any, string, error) {
 	if in == nil {
 		return nil, "", nil
@@ -503,6 +794,7 @@ func loadResource(ctx context.Context, c client.Client, key types.NamespacedName
 		}
 		return false, err
 	}
+	traceObject(ctx, obj)
 	return true, nil
 }
 
@@ -523,8 +815,17 @@ func requeueOnRemoteNotFound(ctx context.Context, c client.Client, obj client.Ob
 	return reconcile.Result{Requeue: true}, nil
 }
 
+// finalizeWithoutHarborConnection removes the finalizer of a resource being
+// deleted when its connection cannot be used. A paused resource is always
+// finalized as if its deletionPolicy were Orphan.
 func finalizeWithoutHarborConnection(ctx context.Context, c client.Client, obj client.Object, deletionPolicy harborv1alpha1.DeletionPolicy, requiresRemoteCleanup bool, err error) (bool, error) {
-	if obj.GetDeletionTimestamp().IsZero() || !apierrors.IsNotFound(err) {
+	if obj.GetDeletionTimestamp().IsZero() {
+		return false, nil
+	}
+	if isReconciliationPaused(err) {
+		return true, removeFinalizer(ctx, c, obj)
+	}
+	if !isConnectionUnavailable(err) {
 		return false, nil
 	}
 	if !requiresRemoteCleanup || deletionPolicy == harborv1alpha1.DeletionPolicyOrphan {
@@ -599,7 +900,7 @@ func resolveProjectName(ctx context.Context, options OperatorOptions, c client.C
 	if project.Status.HarborProjectID == 0 {
 		return "", fmt.Errorf("referenced Project %s/%s does not have harborProjectID yet", ns, ref.Name)
 	}
-	return project.Name, nil
+	return project.HarborName(), nil
 }
 
 func resolveUserName(ctx context.Context, options OperatorOptions, c client.Client, namespace string, ref harborv1alpha1.UserReference) (string, error) {
@@ -614,7 +915,7 @@ func resolveUserName(ctx context.Context, options OperatorOptions, c client.Clie
 	if err := c.Get(ctx, types.NamespacedName{Namespace: ns, Name: ref.Name}, &user); err != nil {
 		return "", err
 	}
-	return user.Name, nil
+	return user.HarborName(), nil
 }
 
 func resolveUserGroup(ctx context.Context, options OperatorOptions, c client.Client, namespace string, ref harborv1alpha1.UserGroupClaimReference, expectedConnection *harborv1alpha1.HarborConnectionBinding) (*harborclient.MemberGroup, error) {
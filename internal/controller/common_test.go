@@ -2,7 +2,15 @@ package controller
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
+	"math/big"
+	"net/http"
 	"testing"
 	"time"
 
@@ -36,7 +44,7 @@ func TestGetHarborAuthReadsUsernameAndPasswordFromSecret(t *testing.T) {
 		displayName: "test connection",
 		credentials: &harborv1alpha1.Credentials{
 			UsernameSecretRef: &harborv1alpha1.SecretReference{Name: "robot-credentials"},
-			PasswordSecretRef: harborv1alpha1.SecretReference{Name: "robot-credentials", Key: "secret"},
+			PasswordSecretRef: &harborv1alpha1.SecretReference{Name: "robot-credentials", Key: "secret"},
 		},
 	}
 
@@ -251,3 +259,129 @@ func TestFinalizeIfDeleting(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveHarborAuthBearerToken(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add core scheme: %v", err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "harbor-token", Namespace: "connections"},
+		Data: map[string][]byte{
+			"token":        []byte("id-token"),
+			"clientSecret": []byte("client-secret"),
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	conn := &connectionConfig{
+		namespace:   "connections",
+		displayName: "test connection",
+		credentials: &harborv1alpha1.Credentials{
+			Type:           harborv1alpha1.CredentialTypeBearerToken,
+			TokenSecretRef: &harborv1alpha1.SecretReference{Name: "harbor-token"},
+		},
+	}
+	auth, err := resolveHarborAuth(context.Background(), OperatorOptions{}, c, conn)
+	if err != nil {
+		t.Fatalf("resolveHarborAuth returned error: %v", err)
+	}
+	token, err := auth.tokenSource(OperatorOptions{}).Token()
+	if err != nil {
+		t.Fatalf("Token returned error: %v", err)
+	}
+	if token.AccessToken != "id-token" {
+		t.Fatalf("token = %q, want %q", token.AccessToken, "id-token")
+	}
+
+	conn.credentials = &harborv1alpha1.Credentials{
+		Type: harborv1alpha1.CredentialTypeBearerToken,
+		TokenEndpoint: &harborv1alpha1.TokenEndpoint{
+			URL:             "https://idp.example.com/token",
+			ClientID:        "harbor-operator",
+			ClientSecretRef: harborv1alpha1.SecretReference{Name: "harbor-token"},
+		},
+	}
+	auth, err = resolveHarborAuth(context.Background(), OperatorOptions{}, c, conn)
+	if err != nil {
+		t.Fatalf("resolveHarborAuth returned error: %v", err)
+	}
+	if auth.clientSecret != "client-secret" || auth.tokenEndpoint == nil {
+		t.Fatalf("resolveHarborAuth = %+v, want the token endpoint and client secret", auth)
+	}
+	if auth.hash() == (harborAuth{token: "id-token"}).hash() {
+		t.Fatal("expected different credential material to hash differently")
+	}
+}
+
+func TestClientCertificateIsLoadedFromTLSSecret(t *testing.T) {
+	t.Parallel()
+
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	certPEM, keyPEM := newTestClientCertificate(t, notAfter)
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add core scheme: %v", err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "harbor-client", Namespace: "connections"},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+	conn := &connectionConfig{
+		baseURL:           "https://harbor.example.com",
+		namespace:         "connections",
+		displayName:       "test connection",
+		clientCertificate: &harborv1alpha1.SecretReference{Name: "harbor-client"},
+	}
+
+	got, err := clientCertificateNotAfter(context.Background(), OperatorOptions{}, c, conn)
+	if err != nil {
+		t.Fatalf("clientCertificateNotAfter returned error: %v", err)
+	}
+	if got == nil || !got.Time.Equal(notAfter) {
+		t.Fatalf("clientCertificateNotAfter = %v, want %v", got, notAfter)
+	}
+
+	hc, err := buildHarborClient(context.Background(), OperatorOptions{}, c, conn, false)
+	if err != nil {
+		t.Fatalf("buildHarborClient returned error: %v", err)
+	}
+	transport := hc.HTTPClient.Transport.(*http.Transport)
+	if transport.TLSClientConfig == nil || len(transport.TLSClientConfig.Certificates) != 1 {
+		t.Fatalf("expected the client certificate in the TLS config, got %+v", transport.TLSClientConfig)
+	}
+}
+
+func newTestClientCertificate(t *testing.T, notAfter time.Time) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "harbor-operator"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
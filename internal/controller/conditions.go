@@ -2,19 +2,51 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
 )
 
 const (
 	ConditionReady       = "Ready"
 	ConditionReconciling = "Reconciling"
 	ConditionStalled     = "Stalled"
+	// ConditionConnectionReady is added to dependent resources when their
+	// Harbor connection becomes unhealthy, and set back to True once it
+	// recovers.
+	ConditionConnectionReady = "ConnectionReady"
+	// ConditionPaused is True while the paused annotation stops reconciliation,
+	// and set back to False once it resumes.
+	ConditionPaused = "Paused"
+)
+
+// Reasons shared by conditions and Events, so an Event can be matched with the
+// condition it explains.
+const (
+	ReasonReconciling        = "Reconciling"
+	ReasonReconciled         = "Reconciled"
+	ReasonCreated            = "Created"
+	ReasonUpdated            = "Updated"
+	ReasonDeleted            = "Deleted"
+	ReasonAdopted            = "Adopted"
+	ReasonDriftCorrected     = "DriftCorrected"
+	ReasonDriftDetected      = "DriftDetected"
+	ReasonSecretRotated      = "SecretRotated"
+	ReasonWorkloadsRestarted = "WorkloadsRestarted"
+	ReasonPaused             = "Paused"
+	ReasonResumed            = "Resumed"
+
+	ReasonReconcileError           = "ReconcileError"
+	ReasonUnsupportedHarborFeature = "UnsupportedHarborFeature"
+	ReasonHarborUnavailable        = "HarborUnavailable"
+	ReasonConnectionNotReady       = "ConnectionNotReady"
 )
 
 func setCondition(conditions *[]metav1.Condition, cond metav1.Condition) bool {
@@ -37,7 +69,7 @@ func markReconciling(base *harborv1alpha1.HarborStatusBase, generation int64, re
 		changed = true
 	}
 	if reason == "" {
-		reason = "Reconciling"
+		reason = ReasonReconciling
 	}
 	if message == "" {
 		message = "Reconciling resource"
@@ -76,7 +108,7 @@ func markReady(base *harborv1alpha1.HarborStatusBase, generation int64, reason,
 		changed = true
 	}
 	if reason == "" {
-		reason = "Reconciled"
+		reason = ReasonReconciled
 	}
 	if message == "" {
 		message = "Resource is ready"
@@ -92,11 +124,22 @@ func markReady(base *harborv1alpha1.HarborStatusBase, generation int64, reason,
 	changed = setCondition(&base.Conditions, metav1.Condition{
 		Type:               ConditionReconciling,
 		Status:             metav1.ConditionFalse,
-		Reason:             "Reconciled",
+		Reason:             ReasonReconciled,
 		Message:            "Reconciliation complete",
 		ObservedGeneration: generation,
 		LastTransitionTime: metav1.Now(),
 	}) || changed
+	if meta.FindStatusCondition(base.Conditions, ConditionConnectionReady) != nil {
+		changed = setCondition(&base.Conditions, metav1.Condition{
+			Type:               ConditionConnectionReady,
+			Status:             metav1.ConditionTrue,
+			Reason:             "ConnectionHealthy",
+			Message:            "Harbor connection is healthy",
+			ObservedGeneration: generation,
+			LastTransitionTime: metav1.Now(),
+		}) || changed
+	}
+	changed = markResumed(base, generation) || changed
 	changed = setCondition(&base.Conditions, metav1.Condition{
 		Type:               ConditionStalled,
 		Status:             metav1.ConditionFalse,
@@ -109,6 +152,11 @@ func markReady(base *harborv1alpha1.HarborStatusBase, generation int64, reason,
 }
 
 func markError(base *harborv1alpha1.HarborStatusBase, generation int64, err error) bool {
+	if harborclient.IsThrottled(err) {
+		// The request was never sent. Keep the current conditions and let the
+		// returned error requeue the resource with backoff.
+		return false
+	}
 	msg := ""
 	if err != nil {
 		msg = err.Error()
@@ -116,7 +164,27 @@ func markError(base *harborv1alpha1.HarborStatusBase, generation int64, err erro
 	if msg == "" {
 		msg = "Reconcile error"
 	}
-	return markReconciling(base, generation, "ReconcileError", fmt.Sprintf("Reconcile error: %s", msg))
+	reason := ReasonReconcileError
+	var changed bool
+	var unsupported *unsupportedHarborFeatureError
+	var unhealthy *harborConnectionUnhealthyError
+	switch {
+	case errors.As(err, &unsupported):
+		reason = ReasonUnsupportedHarborFeature
+	case harborclient.IsUnavailable(err):
+		reason = ReasonHarborUnavailable
+	case errors.As(err, &unhealthy):
+		reason = ReasonConnectionNotReady
+		changed = setCondition(&base.Conditions, metav1.Condition{
+			Type:               ConditionConnectionReady,
+			Status:             metav1.ConditionFalse,
+			Reason:             reasonHealthCheckFailed,
+			Message:            unhealthy.Error(),
+			ObservedGeneration: generation,
+			LastTransitionTime: metav1.Now(),
+		})
+	}
+	return markReconciling(base, generation, reason, fmt.Sprintf("Reconcile error: %s", msg)) || changed
 }
 
 func setReadyStatus(ctx context.Context, c client.Client, obj client.Object, base *harborv1alpha1.HarborStatusBase, generation int64, reason, message string) error {
@@ -135,12 +203,16 @@ func setReconcilingStatus(ctx context.Context, c client.Client, obj client.Objec
 	return nil
 }
 
-func setErrorStatus(ctx context.Context, c client.Client, obj client.Object, base *harborv1alpha1.HarborStatusBase, generation int64, err error) error {
+func setErrorStatus(ctx context.Context, c client.Client, recorder events.EventRecorder, obj client.Object, base *harborv1alpha1.HarborStatusBase, generation int64, err error) error {
+	if isReconciliationPaused(err) {
+		return setPausedStatus(ctx, c, recorder, obj, base, generation, err)
+	}
 	if changed := markError(base, generation, err); changed {
 		sanitizeOptionalHarborConnectionRef(obj)
 		if updateErr := c.Status().Update(ctx, obj); updateErr != nil {
 			return updateErr
 		}
+		recordFailure(recorder, obj, base)
 	}
 	return err
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -18,9 +19,10 @@ import (
 
 type ConfigurationReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=configurations,verbs=get;list;watch;create;update;patch;delete
@@ -43,12 +45,12 @@ func (r *ConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, err
 	}
 
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), false, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if done, err := finalizeIfDeleting(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), nil); done {
@@ -59,12 +61,12 @@ func (r *ConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, err
 	}
 	if err := ensureConfigurationSingletonOwner(ctx, r.Options, r.Client, &cr); err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	desired, err := r.buildDesiredSettings(ctx, &cr)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 	if len(desired) == 0 {
 		r.logger.V(1).Info("No configuration settings specified; nothing to apply")
@@ -76,21 +78,29 @@ func (r *ConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 	current, err := hc.GetConfigurations(ctx)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if err := ensureEditableSettings(desired, current); err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if configurationNeedsUpdate(desired, current) {
+		if err := recordDrift(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "Configuration",
+			desired, observedConfigurations(desired, current)); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !cr.Spec.ManagementPolicy.AllowsWrites() {
+			return reportObservedDrift(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Configuration")
+		}
 		if err := hc.UpdateConfigurations(ctx, desired); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		r.logger.Info("Updated Harbor configurations")
+		recordUpdate(r.Recorder, &cr, &cr.Status.HarborStatusBase, "Updated %d Harbor configuration settings", len(desired))
 	}
 
-	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Reconciled", "Configuration reconciled"); err != nil {
+	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "Configuration reconciled"); err != nil {
 		return ctrl.Result{}, err
 	}
 	return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
@@ -166,6 +176,17 @@ func configurationNeedsUpdate(desired map[string]any, current map[string]harborc
 	return false
 }
 
+// observedConfigurations returns the current values of the desired settings.
+func observedConfigurations(desired map[string]any, current map[string]harborclient.ConfigurationItem) map[string]any {
+	observed := make(map[string]any, len(desired))
+	for key := range desired {
+		if item, ok := current[key]; ok {
+			observed[key] = item.Value
+		}
+	}
+	return observed
+}
+
 func jsonValuesEqual(desired any, current json.RawMessage) bool {
 	desiredJSON, err := json.Marshal(desired)
 	if err != nil {
@@ -191,10 +212,23 @@ func (r *ConfigurationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.Configuration).Spec.HarborConnectionRef
 		},
+		configurationSecretKeys,
 		"configuration",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("Configuration", r))
+}
+
+// configurationSecretKeys returns the Secrets a configuration reads.
+func configurationSecretKeys(obj client.Object) []string {
+	configuration := obj.(*harborv1alpha1.Configuration)
+	refs := make([]*harborv1alpha1.SecretReference, 0, len(configuration.Spec.Settings))
+	for _, setting := range configuration.Spec.Settings {
+		if setting.ValueFrom != nil {
+			refs = append(refs, &setting.ValueFrom.SecretKeyRef)
+		}
+	}
+	return secretRefKeys(configuration.Namespace, refs...)
 }
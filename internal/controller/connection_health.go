@@ -0,0 +1,204 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	defaultConnectionFailureThreshold = 3
+
+	// reasonHealthCheckFailed marks a connection whose health checks failed
+	// failureThreshold times in a row. Dependent resources short-circuit
+	// while their connection reports it.
+	reasonHealthCheckFailed = "HealthCheckFailed"
+)
+
+// harborConnectionUnhealthyError is returned to dependent resources instead
+// of calling a Harbor that the connection has already found unhealthy.
+type harborConnectionUnhealthyError struct {
+	connection string
+	message    string
+}
+
+func (e *harborConnectionUnhealthyError) Error() string {
+	return fmt.Sprintf("%s is unhealthy: %s", e.connection, e.message)
+}
+
+// connectionHealthFailure returns the Ready message of a connection that
+// reached its health check failure threshold, or an empty string.
+func connectionHealthFailure(status *harborv1alpha1.HarborConnectionStatus) string {
+	cond := meta.FindStatusCondition(status.Conditions, ConditionReady)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != reasonHealthCheckFailed {
+		return ""
+	}
+	return cond.Message
+}
+
+func requireHealthyConnection(conn *connectionConfig) error {
+	if conn.healthFailure == "" {
+		return nil
+	}
+	return &harborConnectionUnhealthyError{connection: conn.displayName, message: conn.healthFailure}
+}
+
+// isConnectionUnavailable reports whether err means the Harbor connection
+// cannot be used, either because it is gone or because it is unhealthy.
+func isConnectionUnavailable(err error) bool {
+	var unhealthy *harborConnectionUnhealthyError
+	return apierrors.IsNotFound(err) || errors.As(err, &unhealthy)
+}
+
+// dependentConnectionPredicate drops connection updates that dependent
+// resources do not act on. A failing periodic health check rewrites
+// status.consecutiveFailures and the Ready message on every interval; only
+// the Ready status and reason decide whether dependents may call Harbor.
+func dependentConnectionPredicate() predicate.Funcs {
+	return predicate.Funcs{UpdateFunc: func(e event.UpdateEvent) bool {
+		return connectionChangedForDependents(e.ObjectOld, e.ObjectNew)
+	}}
+}
+
+func connectionChangedForDependents(oldObj, newObj client.Object) bool {
+	oldStatus, newStatus := connectionStatusOf(oldObj), connectionStatusOf(newObj)
+	if oldStatus == nil || newStatus == nil {
+		return true
+	}
+	if oldObj.GetGeneration() != newObj.GetGeneration() ||
+		!oldObj.GetDeletionTimestamp().Equal(newObj.GetDeletionTimestamp()) ||
+		!maps.Equal(oldObj.GetLabels(), newObj.GetLabels()) ||
+		!maps.Equal(oldObj.GetAnnotations(), newObj.GetAnnotations()) {
+		return true
+	}
+	oldReady := meta.FindStatusCondition(oldStatus.Conditions, ConditionReady)
+	newReady := meta.FindStatusCondition(newStatus.Conditions, ConditionReady)
+	if (oldReady == nil) != (newReady == nil) ||
+		oldReady != nil && (oldReady.Status != newReady.Status || oldReady.Reason != newReady.Reason) {
+		return true
+	}
+	return oldStatus.Authenticated != newStatus.Authenticated ||
+		!equality.Semantic.DeepEqual(oldStatus.Harbor, newStatus.Harbor) ||
+		!equality.Semantic.DeepEqual(oldStatus.ClientCertificateNotAfter, newStatus.ClientCertificateNotAfter)
+}
+
+func connectionStatusOf(obj client.Object) *harborv1alpha1.HarborConnectionStatus {
+	switch conn := obj.(type) {
+	case *harborv1alpha1.HarborConnection:
+		return &conn.Status
+	case *harborv1alpha1.ClusterHarborConnection:
+		return &conn.Status
+	}
+	return nil
+}
+
+// checkHarborConnection verifies that Harbor is reachable and, when
+// credentials are configured, that they authenticate. It records the client
+// certificate expiry and the discovered Harbor system in status and reports
+// whether either changed.
+func checkHarborConnection(ctx context.Context, options OperatorOptions, c client.Client, cfg *connectionConfig, status *harborv1alpha1.HarborConnectionStatus) (string, string, bool, error) {
+	notAfter, err := clientCertificateNotAfter(ctx, options, c, cfg)
+	if err != nil {
+		return "", "", false, err
+	}
+	statusChanged := !notAfter.Equal(status.ClientCertificateNotAfter)
+	status.ClientCertificateNotAfter = notAfter
+
+	authenticated := cfg.credentials != nil
+	hc, err := buildHarborClient(ctx, options, c, cfg, authenticated)
+	if err != nil {
+		return "", "", statusChanged, err
+	}
+	if authenticated {
+		err = hc.CheckAuthentication(ctx)
+	} else {
+		err = hc.Ping(ctx)
+	}
+	if err != nil {
+		return "", "", statusChanged, err
+	}
+
+	systemChanged, err := discoverHarborSystem(ctx, hc, status)
+	if err != nil {
+		return "", "", statusChanged, err
+	}
+	statusChanged = statusChanged || systemChanged || status.Authenticated != authenticated
+	status.Authenticated = authenticated
+	if authenticated {
+		return "Authenticated", "Successfully authenticated with Harbor API", statusChanged, nil
+	}
+	return "Reachable", "Harbor reachable without credentials", statusChanged, nil
+}
+
+// finishConnectionCheck writes the outcome of a connection check and
+// schedules the next periodic check. Without spec.healthCheck every failure
+// is reported as a reconcile error, as for any other resource.
+func finishConnectionCheck(
+	ctx context.Context,
+	c client.Client,
+	recorder events.EventRecorder,
+	obj client.Object,
+	spec *harborv1alpha1.HarborConnectionSpec,
+	status *harborv1alpha1.HarborConnectionStatus,
+	generation int64,
+	reason, message string,
+	statusChanged bool,
+	checkErr error,
+) (ctrl.Result, error) {
+	base := &status.HarborStatusBase
+	check := spec.HealthCheck
+	result := ctrl.Result{}
+	if check != nil && check.Interval.Duration > 0 {
+		result.RequeueAfter = check.Interval.Duration
+	}
+
+	if checkErr == nil {
+		statusChanged = statusChanged || status.ConsecutiveFailures != 0
+		status.ConsecutiveFailures = 0
+		condChanged := markReady(base, generation, reason, message)
+		if statusChanged || condChanged {
+			if err := c.Status().Update(ctx, obj); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return result, nil
+	}
+
+	if check == nil || harborclient.IsThrottled(checkErr) {
+		// A throttled check never reached Harbor, so it does not count as a
+		// failure.
+		return ctrl.Result{}, setErrorStatus(ctx, c, recorder, obj, base, generation, checkErr)
+	}
+
+	status.ConsecutiveFailures++
+	threshold := check.FailureThreshold
+	if threshold < 1 {
+		threshold = defaultConnectionFailureThreshold
+	}
+	switch {
+	case status.ConsecutiveFailures >= threshold:
+		markReconciling(base, generation, reasonHealthCheckFailed,
+			fmt.Sprintf("%d consecutive health checks failed: %s", status.ConsecutiveFailures, checkErr))
+	case !meta.IsStatusConditionTrue(base.Conditions, ConditionReady):
+		markError(base, generation, checkErr)
+	}
+	// A healthy connection below the threshold stays Ready so dependents keep
+	// working through a single failed check.
+	if err := c.Status().Update(ctx, obj); err != nil {
+		return ctrl.Result{}, err
+	}
+	return result, nil
+}
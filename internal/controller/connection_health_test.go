@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestFinishConnectionCheckAppliesFailureThreshold(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := harborv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add harbor scheme: %v", err)
+	}
+	conn := &harborv1alpha1.HarborConnection{
+		ObjectMeta: metav1.ObjectMeta{Name: "harbor", Namespace: "default", Generation: 1},
+		Spec: harborv1alpha1.HarborConnectionSpec{
+			BaseURL: "https://harbor.example.com",
+			HealthCheck: &harborv1alpha1.ConnectionHealthCheck{
+				Interval:         metav1.Duration{Duration: time.Minute},
+				FailureThreshold: 2,
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(conn).WithStatusSubresource(conn).Build()
+	ctx := context.Background()
+
+	result, err := finishConnectionCheck(ctx, c, nil, conn, &conn.Spec, &conn.Status, conn.Generation, "Reachable", "Harbor reachable without credentials", false, nil)
+	if err != nil {
+		t.Fatalf("finishConnectionCheck returned error: %v", err)
+	}
+	if result.RequeueAfter != time.Minute {
+		t.Fatalf("RequeueAfter = %v, want %v", result.RequeueAfter, time.Minute)
+	}
+
+	checkErr := errors.New("connection refused")
+	if _, err := finishConnectionCheck(ctx, c, nil, conn, &conn.Spec, &conn.Status, conn.Generation, "", "", false, checkErr); err != nil {
+		t.Fatalf("finishConnectionCheck returned error: %v", err)
+	}
+	if !meta.IsStatusConditionTrue(conn.Status.Conditions, ConditionReady) {
+		t.Fatalf("expected the connection to stay Ready below the failure threshold")
+	}
+	if got := connectionHealthFailure(&conn.Status); got != "" {
+		t.Fatalf("connectionHealthFailure = %q, want none below the threshold", got)
+	}
+
+	if _, err := finishConnectionCheck(ctx, c, nil, conn, &conn.Spec, &conn.Status, conn.Generation, "", "", false, checkErr); err != nil {
+		t.Fatalf("finishConnectionCheck returned error: %v", err)
+	}
+	if conn.Status.ConsecutiveFailures != 2 {
+		t.Fatalf("ConsecutiveFailures = %d, want 2", conn.Status.ConsecutiveFailures)
+	}
+	failure := connectionHealthFailure(&conn.Status)
+	if failure == "" {
+		t.Fatalf("expected the connection to be unhealthy at the failure threshold")
+	}
+
+	dependent := &harborv1alpha1.HarborStatusBase{}
+	markError(dependent, 1, requireHealthyConnection(&connectionConfig{displayName: "HarborConnection default/harbor", healthFailure: failure}))
+	cond := meta.FindStatusCondition(dependent.Conditions, ConditionConnectionReady)
+	if cond == nil || cond.Status != metav1.ConditionFalse {
+		t.Fatalf("ConnectionReady = %+v, want False", cond)
+	}
+	markReady(dependent, 1, "", "")
+	if !meta.IsStatusConditionTrue(dependent.Conditions, ConditionConnectionReady) {
+		t.Fatalf("expected ConnectionReady to recover once the dependent is ready")
+	}
+
+	if _, err := finishConnectionCheck(ctx, c, nil, conn, &conn.Spec, &conn.Status, conn.Generation, "Reachable", "Harbor reachable without credentials", false, nil); err != nil {
+		t.Fatalf("finishConnectionCheck returned error: %v", err)
+	}
+	if conn.Status.ConsecutiveFailures != 0 || connectionHealthFailure(&conn.Status) != "" {
+		t.Fatalf("expected a successful check to clear the failure state, got %+v", conn.Status)
+	}
+}
+
+func TestDependentsIgnoreRepeatedHealthCheckFailures(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := harborv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add harbor scheme: %v", err)
+	}
+	conn := &harborv1alpha1.ClusterHarborConnection{
+		ObjectMeta: metav1.ObjectMeta{Name: "harbor", Generation: 1},
+		Spec: harborv1alpha1.HarborConnectionSpec{
+			BaseURL: "https://harbor.example.com",
+			HealthCheck: &harborv1alpha1.ConnectionHealthCheck{
+				Interval:         metav1.Duration{Duration: time.Minute},
+				FailureThreshold: 2,
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(conn).WithStatusSubresource(conn).Build()
+	ctx := context.Background()
+	check := func(checkErr error, wantChanged bool) {
+		t.Helper()
+		old := conn.DeepCopy()
+		if _, err := finishConnectionCheck(ctx, c, nil, conn, &conn.Spec, &conn.Status, conn.Generation, "Reachable", "Harbor reachable without credentials", false, checkErr); err != nil {
+			t.Fatalf("finishConnectionCheck returned error: %v", err)
+		}
+		if got := connectionChangedForDependents(old, conn); got != wantChanged {
+			t.Fatalf("after %d failures connectionChangedForDependents = %v, want %v", conn.Status.ConsecutiveFailures, got, wantChanged)
+		}
+	}
+
+	check(nil, true)
+	checkErr := errors.New("connection refused")
+	check(checkErr, false)
+	check(checkErr, true)
+	check(checkErr, false)
+	check(checkErr, false)
+	check(nil, true)
+
+	old := conn.DeepCopy()
+	conn.Generation++
+	if !connectionChangedForDependents(old, conn) {
+		t.Fatal("a spec change was not passed to dependents")
+	}
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// cronField is one field of a Harbor cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+// harborCronFields are the fields of the cron expressions Harbor accepts,
+// which start with a seconds field.
+var harborCronFields = []cronField{
+	{name: "second", min: 0, max: 59},
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}},
+	{name: "day of week", min: 0, max: 6, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}},
+}
+
+// validateCron reports whether expr is a six-field cron expression that
+// Harbor can schedule.
+func validateCron(expr string) error {
+	fields := strings.Fields(expr)
+	if len(fields) != len(harborCronFields) {
+		return fmt.Errorf("cron expression must have 6 fields (second minute hour day-of-month month day-of-week), got %d", len(fields))
+	}
+	for i, f := range harborCronFields {
+		if err := f.validate(fields[i]); err != nil {
+			return fmt.Errorf("invalid %s %q: %w", f.name, fields[i], err)
+		}
+	}
+	return nil
+}
+
+func (f cronField) validate(expr string) error {
+	for part := range strings.SplitSeq(expr, ",") {
+		span, step, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n <= 0 {
+				return fmt.Errorf("step %q must be a positive integer", step)
+			}
+		}
+		if span == "*" || span == "?" {
+			continue
+		}
+		low, high, isRange := strings.Cut(span, "-")
+		start, err := f.value(low)
+		if err != nil {
+			return err
+		}
+		if !isRange {
+			continue
+		}
+		end, err := f.value(high)
+		if err != nil {
+			return err
+		}
+		if end < start {
+			return fmt.Errorf("range %q ends before it starts", span)
+		}
+	}
+	return nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if n, ok := f.names[strings.ToLower(s)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%d is outside %d-%d", n, f.min, f.max)
+	}
+	return n, nil
+}
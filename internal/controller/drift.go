@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/metrics"
+)
+
+const (
+	// redactedValue replaces secret values in drift reports.
+	redactedValue = "<redacted>"
+
+	// maxDriftFields and maxDriftValueLength bound the size of the drift
+	// report so large Harbor objects cannot push the status past etcd limits.
+	maxDriftFields      = 32
+	maxDriftValueLength = 256
+)
+
+// sensitiveDriftKeys are substrings of Harbor API field names whose values
+// are never copied into status.
+var sensitiveDriftKeys = []string{"password", "secret", "credential", "token", "auth_header"}
+
+// readyAtGeneration reports whether obj was Ready at its current generation,
+// so any difference found in Harbor was not caused by a spec change.
+func readyAtGeneration(obj client.Object, base *harborv1alpha1.HarborStatusBase) bool {
+	ready := meta.FindStatusCondition(base.Conditions, ConditionReady)
+	return ready != nil && ready.Status == metav1.ConditionTrue && ready.ObservedGeneration == obj.GetGeneration()
+}
+
+// recordDrift stores the differences between desired and observed in
+// status.drift and counts the detection. Both values are compared in their
+// Harbor API JSON form. Differences after a spec change are not drift and are
+// ignored, except with managementPolicy ObserveOnly where every difference is
+// reported. Drift that is still outstanding from an earlier reconcile is
+// counted once.
+func recordDrift(ctx context.Context, c client.Client, obj client.Object, base *harborv1alpha1.HarborStatusBase,
+	spec *harborv1alpha1.HarborSpecBase, kind string, desired, observed any) error {
+
+	if spec.ManagementPolicy != harborv1alpha1.ManagementPolicyObserveOnly && !readyAtGeneration(obj, base) {
+		return nil
+	}
+	fields := diffDrift(desired, observed)
+	if len(fields) == 0 {
+		return nil
+	}
+	if base.Drift != nil && reflect.DeepEqual(base.Drift.Fields, fields) {
+		if ready := meta.FindStatusCondition(base.Conditions, ConditionReady); ready != nil && ready.Reason == ReasonDriftDetected {
+			return nil
+		}
+	}
+	base.Drift = &harborv1alpha1.DriftStatus{DetectedAt: metav1.Now(), Fields: fields}
+	metrics.ObserveDrift(kind)
+	sanitizeOptionalHarborConnectionRef(obj)
+	return c.Status().Update(ctx, obj)
+}
+
+// diffDrift returns the JSON paths at which desired and observed differ.
+// Objects are compared key by key and arrays of equal length element by
+// element; any other difference is reported for the whole value.
+func diffDrift(desired, observed any) []harborv1alpha1.DriftField {
+	var fields []harborv1alpha1.DriftField
+	walkDrift(&fields, "$", false, toJSONValue(desired), toJSONValue(observed))
+	if len(fields) > maxDriftFields {
+		fields = fields[:maxDriftFields]
+	}
+	return fields
+}
+
+func walkDrift(fields *[]harborv1alpha1.DriftField, path string, sensitive bool, desired, observed any) {
+	switch d := desired.(type) {
+	case map[string]any:
+		if o, ok := observed.(map[string]any); ok {
+			keys := make([]string, 0, len(d)+len(o))
+			for k := range d {
+				keys = append(keys, k)
+			}
+			for k := range o {
+				if _, ok := d[k]; !ok {
+					keys = append(keys, k)
+				}
+			}
+			slices.Sort(keys)
+			for _, k := range keys {
+				walkDrift(fields, path+"."+k, sensitive || isSensitiveDriftKey(k), d[k], o[k])
+			}
+			return
+		}
+	case []any:
+		if o, ok := observed.([]any); ok && len(d) == len(o) {
+			for i := range d {
+				walkDrift(fields, fmt.Sprintf("%s[%d]", path, i), sensitive, d[i], o[i])
+			}
+			return
+		}
+	}
+	if reflect.DeepEqual(desired, observed) {
+		return
+	}
+	*fields = append(*fields, harborv1alpha1.DriftField{
+		Path:     path,
+		Desired:  driftValue(desired, sensitive),
+		Observed: driftValue(observed, sensitive),
+	})
+}
+
+func isSensitiveDriftKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveDriftKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// toJSONValue converts v to the generic form encoding/json decodes into, so
+// values of different Go types compare by their JSON representation.
+func toJSONValue(v any) any {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var out any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil
+	}
+	return out
+}
+
+// driftValue renders v as compact JSON. Missing values render empty, and
+// secret values, including objects that contain one, are redacted.
+func driftValue(v any, sensitive bool) string {
+	if v == nil {
+		return ""
+	}
+	if sensitive {
+		return redactedValue
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(redactDriftValue(v)); err != nil {
+		return ""
+	}
+	raw := strings.TrimSuffix(buf.String(), "\n")
+	if len(raw) > maxDriftValueLength {
+		return raw[:maxDriftValueLength] + "..."
+	}
+	return raw
+}
+
+func redactDriftValue(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, val := range t {
+			if isSensitiveDriftKey(k) && val != nil {
+				out[k] = redactedValue
+				continue
+			}
+			out[k] = redactDriftValue(val)
+		}
+		return out
+	case []any:
+		out := make([]any, len(t))
+		for i, val := range t {
+			out[i] = redactDriftValue(val)
+		}
+		return out
+	}
+	return v
+}
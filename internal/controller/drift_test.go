@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDiffDriftReportsChangedPathsAndRedactsSecrets(t *testing.T) {
+	t.Parallel()
+
+	desired := harborclient.Project{
+		Name:     "demo",
+		Metadata: harborclient.ProjectMetadata{Public: "false", AutoScan: ptr.To("true")},
+	}
+	observed := harborclient.Project{
+		Name:     "demo",
+		Metadata: harborclient.ProjectMetadata{Public: "true"},
+	}
+	got := diffDrift(desired, observed)
+	want := []harborv1alpha1.DriftField{
+		{Path: "$.metadata.auto_scan", Desired: `"true"`},
+		{Path: "$.metadata.public", Desired: `"false"`, Observed: `"true"`},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("diffDrift() = %+v, want %+v", got, want)
+	}
+
+	got = diffDrift(
+		map[string]any{"ldap_search_password": "a", "targets": []any{map[string]any{"address": "x", "auth_header": "Bearer a"}}},
+		map[string]any{"ldap_search_password": "b", "targets": []any{}},
+	)
+	want = []harborv1alpha1.DriftField{
+		{Path: "$.ldap_search_password", Desired: redactedValue, Observed: redactedValue},
+		{Path: "$.targets", Desired: `[{"address":"x","auth_header":"<redacted>"}]`, Observed: `[]`},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("diffDrift() = %+v, want %+v", got, want)
+	}
+
+	if got := diffDrift(desired, desired); len(got) != 0 {
+		t.Fatalf("expected no drift for equal values, got %+v", got)
+	}
+}
+
+func TestRecordDriftKeepsOutstandingDrift(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := harborv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	project := &harborv1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", Generation: 2}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(project).WithStatusSubresource(project).Build()
+	ctx := context.Background()
+	base := &project.Status.HarborStatusBase
+	spec := &project.Spec.HarborSpecBase
+	desired := map[string]string{"public": "false"}
+	observed := map[string]string{"public": "true"}
+
+	markReconciling(base, project.Generation, ReasonReconciling, "Spec changed")
+	if err := recordDrift(ctx, c, project, base, spec, "Project", desired, observed); err != nil {
+		t.Fatalf("recordDrift returned error: %v", err)
+	}
+	if base.Drift != nil {
+		t.Fatalf("expected a difference after a spec change not to be drift, got %+v", base.Drift)
+	}
+
+	markReady(base, project.Generation, ReasonReconciled, "Project reconciled")
+	if err := recordDrift(ctx, c, project, base, spec, "Project", desired, observed); err != nil {
+		t.Fatalf("recordDrift returned error: %v", err)
+	}
+	if base.Drift == nil || len(base.Drift.Fields) != 1 || base.Drift.Fields[0].Path != "$.public" {
+		t.Fatalf("expected drift of $.public, got %+v", base.Drift)
+	}
+
+	// The operator could not correct the drift, so the same difference is
+	// found again and must keep its original detection time.
+	markReady(base, project.Generation, ReasonDriftDetected, "Project differs from spec")
+	detectedAt := metav1.NewTime(base.Drift.DetectedAt.Add(-time.Hour))
+	base.Drift.DetectedAt = detectedAt
+	if err := recordDrift(ctx, c, project, base, spec, "Project", desired, observed); err != nil {
+		t.Fatalf("recordDrift returned error: %v", err)
+	}
+	if !base.Drift.DetectedAt.Equal(&detectedAt) {
+		t.Fatalf("expected outstanding drift to keep its detection time %v, got %v", detectedAt, base.Drift.DetectedAt)
+	}
+}
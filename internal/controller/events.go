@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// Event actions describe what the operator did to the Harbor object.
+const (
+	actionCreate    = "Create"
+	actionUpdate    = "Update"
+	actionDelete    = "Delete"
+	actionAdopt     = "Adopt"
+	actionRotate    = "Rotate"
+	actionRestart   = "Restart"
+	actionReconcile = "Reconcile"
+)
+
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+// EventSource is the reporting controller of every Event the operator emits.
+const EventSource = "harbor-operator"
+
+// recordEvent emits a Normal Event on obj. A nil recorder, as in reconcilers
+// built without one in tests, emits nothing.
+func recordEvent(recorder events.EventRecorder, obj client.Object, reason, action, note string, args ...any) {
+	emitEvent(recorder, obj, corev1.EventTypeNormal, reason, action, note, args...)
+}
+
+// recordUpdate emits the Event for an update made to match the spec. When the
+// resource was already Ready at its current generation the spec did not
+// change, so the update corrected drift in Harbor instead.
+func recordUpdate(recorder events.EventRecorder, obj client.Object, base *harborv1alpha1.HarborStatusBase, note string, args ...any) {
+	reason := ReasonUpdated
+	if readyAtGeneration(obj, base) {
+		reason = ReasonDriftCorrected
+	}
+	recordEvent(recorder, obj, reason, actionUpdate, note, args...)
+}
+
+// recordDeleted emits a Deleted Event when the Harbor delete that returned err
+// succeeded, and returns err unchanged.
+func recordDeleted(recorder events.EventRecorder, obj client.Object, err error, note string, args ...any) error {
+	if err == nil {
+		recordEvent(recorder, obj, ReasonDeleted, actionDelete, note, args...)
+	}
+	return err
+}
+
+// recordFailure emits a Warning Event with the reason and message of the Ready
+// condition. setErrorStatus only calls it when that condition changed, so a
+// failure that repeats on every retry produces a single Event.
+func recordFailure(recorder events.EventRecorder, obj client.Object, base *harborv1alpha1.HarborStatusBase) {
+	ready := meta.FindStatusCondition(base.Conditions, ConditionReady)
+	if ready == nil || ready.Status == metav1.ConditionTrue {
+		return
+	}
+	emitEvent(recorder, obj, corev1.EventTypeWarning, ready.Reason, actionReconcile, "%s", ready.Message)
+}
+
+func emitEvent(recorder events.EventRecorder, obj client.Object, eventtype, reason, action, note string, args ...any) {
+	if recorder == nil {
+		return
+	}
+	if len(args) > 0 {
+		note = fmt.Sprintf(note, args...)
+	}
+	recorder.Eventf(obj, nil, eventtype, reason, action, "%s", note)
+}
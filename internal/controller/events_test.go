@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSetErrorStatusRecordsRepeatedFailureOnce(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := harborv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	project := &harborv1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", Generation: 1}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(project).WithStatusSubresource(project).Build()
+	recorder := events.NewFakeRecorder(10)
+	ctx := context.Background()
+
+	unavailable := errors.New("harbor unavailable")
+	for range 3 {
+		if err := setErrorStatus(ctx, c, recorder, project, &project.Status.HarborStatusBase, project.Generation, unavailable); !errors.Is(err, unavailable) {
+			t.Fatalf("setErrorStatus returned %v, want %v", err, unavailable)
+		}
+	}
+	forbidden := errors.New("forbidden")
+	_ = setErrorStatus(ctx, c, recorder, project, &project.Status.HarborStatusBase, project.Generation, forbidden)
+
+	close(recorder.Events)
+	var got []string
+	for event := range recorder.Events {
+		got = append(got, event)
+	}
+	want := []string{
+		"Warning " + ReasonReconcileError + " Reconcile error: harbor unavailable",
+		"Warning " + ReasonReconcileError + " Reconcile error: forbidden",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("events = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("events = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestRecordUpdateDistinguishesDriftFromSpecChanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		generation int64
+		want       string
+	}{
+		{name: "ready at current generation", generation: 2, want: ReasonDriftCorrected},
+		{name: "spec changed since ready", generation: 3, want: ReasonUpdated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			project := &harborv1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "demo", Generation: tt.generation}}
+			markReady(&project.Status.HarborStatusBase, 2, ReasonReconciled, "Project reconciled")
+			recorder := events.NewFakeRecorder(1)
+
+			recordUpdate(recorder, project, &project.Status.HarborStatusBase, "Updated Harbor project %d", 5)
+
+			if got, want := <-recorder.Events, "Normal "+tt.want+" Updated Harbor project 5"; got != want {
+				t.Fatalf("event = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -16,9 +17,10 @@ import (
 
 type GCScheduleReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=gcschedules,verbs=get;list;watch;create;update;patch;delete
@@ -41,12 +43,12 @@ func (r *GCScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), false, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if done, err := finalizeIfDeleting(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), nil); done {
@@ -57,12 +59,12 @@ func (r *GCScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 	if err := ensureGCScheduleSingletonOwner(ctx, r.Options, r.Client, &cr); err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	params, paramsHash, err := scheduleParameters(cr.Spec.Parameters, "gc")
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 	sched := harborclient.Schedule{
 		Schedule: harborclient.ScheduleObj{
@@ -72,7 +74,7 @@ func (r *GCScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		Parameters: params,
 	}
 	if sched.Schedule.Type != harborv1alpha1.ScheduleTypeManual && sched.Schedule.Type != harborv1alpha1.ScheduleTypeNone && sched.Schedule.Cron == "" {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("schedule.cron is required for schedule type %q", sched.Schedule.Type))
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("schedule.cron is required for schedule type %q", sched.Schedule.Type))
 	}
 	hash := hashParts(
 		fmt.Sprintf("type=%s", cr.Spec.Schedule.Type),
@@ -80,28 +82,52 @@ func (r *GCScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		fmt.Sprintf("params=%s", paramsHash),
 	)
 
+	if !cr.Spec.ManagementPolicy.AllowsWrites() {
+		current, err := hc.GetGCSchedule(ctx)
+		if err != nil && !harborclient.IsNotFound(err) {
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		}
+		if current == nil || !scheduleObjEqual(current.Schedule, sched.Schedule) {
+			var observed any
+			if current != nil {
+				observed = scheduleObjState(current.Schedule)
+			}
+			if err := recordDrift(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "GCSchedule",
+				scheduleObjState(sched.Schedule), observed); err != nil {
+				return ctrl.Result{}, err
+			}
+			return reportObservedDrift(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "GC schedule")
+		}
+		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "GC schedule reconciled"); err != nil {
+			return ctrl.Result{}, err
+		}
+		return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
+	}
+
 	if cr.Status.LastAppliedScheduleHash == "" {
 		if err := hc.CreateGCSchedule(ctx, sched); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.LastAppliedScheduleHash = hash
-		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Created", "GC schedule created"); err != nil {
+		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonCreated, "GC schedule created"); err != nil {
 			return ctrl.Result{}, err
 		}
+		recordEvent(r.Recorder, &cr, ReasonCreated, actionCreate, "Created Harbor garbage collection schedule")
 		return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
 	}
 
 	statusChanged := false
 	if cr.Status.LastAppliedScheduleHash != hash {
 		if err := hc.UpdateGCSchedule(ctx, sched); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.LastAppliedScheduleHash = hash
 		statusChanged = true
 		r.logger.Info("Updated GC schedule")
+		recordUpdate(r.Recorder, &cr, &cr.Status.HarborStatusBase, "Updated Harbor garbage collection schedule")
 	}
 
-	condChanged := markReady(&cr.Status.HarborStatusBase, cr.Generation, "Reconciled", "GC schedule reconciled")
+	condChanged := markReady(&cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "GC schedule reconciled")
 	if statusChanged || condChanged {
 		if err := r.Status().Update(ctx, &cr); err != nil {
 			return ctrl.Result{}, err
@@ -119,10 +145,11 @@ func (r *GCScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.GCSchedule).Spec.HarborConnectionRef
 		},
+		nil,
 		"gcschedule",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("GCSchedule", r))
 }
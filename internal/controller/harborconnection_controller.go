@@ -4,10 +4,16 @@ import (
 	"context"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/go-logr/logr"
 	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
@@ -16,9 +22,10 @@ import (
 // HarborConnectionReconciler reconciles a HarborConnection object.
 type HarborConnectionReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=harborconnections,verbs=get;list;watch;create;update;patch;delete
@@ -37,6 +44,7 @@ func (r *HarborConnectionReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		r.logger.Error(err, "Failed to get HarborConnection")
 		return ctrl.Result{}, err
 	} else if !found {
+		r.Options.harborClients.forget(harborv1alpha1.HarborConnectionReferenceKindNamespaced, req.Namespace, req.Name)
 		return ctrl.Result{}, nil
 	}
 
@@ -44,72 +52,52 @@ func (r *HarborConnectionReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, err
 	}
 
+	// A paused connection is not checked, and pauses its dependents.
+	if err := checkPaused(&conn, nil); err != nil {
+		return ctrl.Result{}, setPausedStatus(ctx, r.Client, r.Recorder, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
+	}
+	if err := setResumedStatus(ctx, r.Client, r.Recorder, &conn, &conn.Status.HarborStatusBase, conn.Generation); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Validate the BaseURL.
 	if err := validateBaseURL(conn.Spec.BaseURL); err != nil {
 		r.logger.Error(err, "Invalid baseURL")
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
 	}
 
-	// If no credentials are provided, perform a non-authenticated connectivity check.
-	if conn.Spec.Credentials == nil {
-		hc, err := buildHarborClient(ctx, r.Options, r.Client, &connectionConfig{
-			baseURL:           conn.Spec.BaseURL,
-			kind:              harborv1alpha1.HarborConnectionReferenceKindNamespaced,
-			name:              conn.Name,
-			namespace:         conn.Namespace,
-			uid:               string(conn.UID),
-			credentials:       conn.Spec.Credentials,
-			caBundle:          conn.Spec.CABundle,
-			caBundleSecretRef: conn.Spec.CABundleSecretRef,
-			displayName:       fmt.Sprintf("HarborConnection %s/%s", conn.Namespace, conn.Name),
-		}, false)
-		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
-		}
-		if err := hc.Ping(ctx); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
-		}
-		conn.Status.Authenticated = false
-		if err := setReadyStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, "Reachable", "Harbor reachable without credentials"); err != nil {
-			return ctrl.Result{}, err
-		}
-		r.logger.Info("Harbor reachable without credentials")
-		return ctrl.Result{}, nil
-	}
+	cfg := namespacedConnectionConfig(&conn)
+	// Check the current Secret material rather than a cached client. This also
+	// refreshes the client shared with dependent resources.
+	r.Options.harborClients.invalidate(cfg.uid)
 
-	// Otherwise, perform an authenticated check.
-	hc, err := buildHarborClient(ctx, r.Options, r.Client, &connectionConfig{
-		baseURL:           conn.Spec.BaseURL,
-		kind:              harborv1alpha1.HarborConnectionReferenceKindNamespaced,
-		name:              conn.Name,
-		namespace:         conn.Namespace,
-		uid:               string(conn.UID),
-		credentials:       conn.Spec.Credentials,
-		caBundle:          conn.Spec.CABundle,
-		caBundleSecretRef: conn.Spec.CABundleSecretRef,
-		displayName:       fmt.Sprintf("HarborConnection %s/%s", conn.Namespace, conn.Name),
-	}, true)
+	reason, message, statusChanged, err := checkHarborConnection(ctx, r.Options, r.Client, cfg, &conn.Status)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
-	}
-
-	if err := hc.CheckAuthentication(ctx); err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, err)
-	}
-
-	conn.Status.Authenticated = true
-	if err := setReadyStatus(ctx, r.Client, &conn, &conn.Status.HarborStatusBase, conn.Generation, "Authenticated", "Successfully authenticated with Harbor API"); err != nil {
-		return ctrl.Result{}, err
+		r.logger.Error(err, "Harbor connection check failed")
+	} else {
+		r.logger.Info(message)
 	}
-
-	r.logger.Info("Successfully authenticated with Harbor API")
-	return ctrl.Result{}, nil
+	return finishConnectionCheck(ctx, r.Client, r.Recorder, &conn, &conn.Spec, &conn.Status, conn.Generation, reason, message, statusChanged, err)
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *HarborConnectionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &harborv1alpha1.HarborConnection{}, harborConnectionSecretRefIndex, func(raw client.Object) []string {
+		conn := raw.(*harborv1alpha1.HarborConnection)
+		return connectionSecretKeys(conn.Namespace, &conn.Spec)
+	}); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&harborv1alpha1.HarborConnection{}).
+		For(&harborv1alpha1.HarborConnection{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
+				return requestsForConnectionSecret(ctx, mgr.GetClient(), r.Options.harborClients, &harborv1alpha1.HarborConnectionList{}, object)
+			}),
+			builder.OnlyMetadata,
+		).
 		Named("harborconnection").
-		Complete(r)
+		Complete(traceReconciler("HarborConnection", r))
 }
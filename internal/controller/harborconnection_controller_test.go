@@ -46,11 +46,15 @@ var _ = Describe("HarborConnection Controller", func() {
 
 		BeforeEach(func() {
 			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				if r.URL.Path == "/api/v2.0/ping" {
+				switch r.URL.Path {
+				case "/api/v2.0/ping":
 					w.WriteHeader(http.StatusOK)
-					return
+				case "/api/v2.0/systeminfo":
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{"harbor_version":"v2.10.2-1a2b3c4d","auth_mode":"oidc_auth"}`))
+				default:
+					http.NotFound(w, r)
 				}
-				http.NotFound(w, r)
 			}))
 
 			By("creating the custom resource for the Kind HarborConnection")
@@ -91,6 +95,12 @@ var _ = Describe("HarborConnection Controller", func() {
 			cond := meta.FindStatusCondition(out.Status.Conditions, ConditionReady)
 			Expect(cond).NotTo(BeNil())
 			Expect(cond.Reason).To(Equal("Reachable"))
+			Expect(out.Status.Harbor).NotTo(BeNil())
+			Expect(out.Status.Harbor.Version).To(Equal("v2.10.2-1a2b3c4d"))
+			Expect(out.Status.Harbor.Features).To(ConsistOf(
+				harborv1alpha1.HarborFeatureSecurityHub,
+				harborv1alpha1.HarborFeatureOIDC,
+			))
 		})
 	})
 })
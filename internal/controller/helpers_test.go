@@ -41,7 +41,7 @@ func createHarborConnection(ctx context.Context, c client.Client, name, baseURL,
 			Credentials: &harborv1alpha1.Credentials{
 				Type:     "basic",
 				Username: testAdminUser,
-				PasswordSecretRef: harborv1alpha1.SecretReference{
+				PasswordSecretRef: &harborv1alpha1.SecretReference{
 					Name: secretName,
 					Key:  testPassword,
 				},
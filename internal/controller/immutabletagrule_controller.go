@@ -10,6 +10,7 @@ import (
 	"github.com/go-logr/logr"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -20,9 +21,10 @@ import (
 
 type ImmutableTagRuleReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=immutabletagrules,verbs=get;list;watch;create;update;patch;delete
@@ -46,12 +48,12 @@ func (r *ImmutableTagRuleReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, err
 	}
 
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), true, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if done, err := finalizeIfDeleting(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), func() error {
@@ -62,7 +64,8 @@ func (r *ImmutableTagRuleReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		if resolveErr != nil {
 			return resolveErr
 		}
-		return hc.DeleteImmutableRule(ctx, projectKey, cr.Status.HarborImmutableRuleID)
+		err := hc.DeleteImmutableRule(ctx, projectKey, cr.Status.HarborImmutableRuleID)
+		return recordDeleted(r.Recorder, &cr, err, "Deleted Harbor immutable tag rule %d", cr.Status.HarborImmutableRuleID)
 	}); done {
 		return ctrl.Result{}, err
 	}
@@ -73,12 +76,12 @@ func (r *ImmutableTagRuleReconciler) Reconcile(ctx context.Context, req ctrl.Req
 
 	projectKey, _, err := resolveProject(ctx, r.Options, r.Client, cr.Namespace, cr.Spec.ProjectRef)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	params, err := jsonMapToAnyImmutable(cr.Spec.Params)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 	desired := harborclient.ImmutableRule{
 		Priority:       cr.Spec.Priority,
@@ -90,32 +93,34 @@ func (r *ImmutableTagRuleReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		ScopeSelectors: toImmutableScopeSelectors(cr.Spec.ScopeSelectors),
 	}
 
-	if cr.Status.HarborImmutableRuleID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy) {
+	if cr.Status.HarborImmutableRuleID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy) {
 		adopted, err := r.adoptExisting(ctx, hc, projectKey, &cr, desired)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		if adopted {
 			r.logger.Info("Adopted existing immutable tag rule", "ID", cr.Status.HarborImmutableRuleID)
+			recordEvent(r.Recorder, &cr, ReasonAdopted, actionAdopt, "Adopted existing Harbor immutable tag rule %d", cr.Status.HarborImmutableRuleID)
 			return ctrl.Result{Requeue: true}, nil
 		}
 	}
 
 	if cr.Status.HarborImmutableRuleID == 0 {
-		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy); err != nil {
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		if err := hc.CreateImmutableRule(ctx, projectKey, desired); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		id, err := r.findMatchingRuleID(ctx, hc, projectKey, desired)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.HarborImmutableRuleID = id
-		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Created", "Immutable tag rule created"); err != nil {
+		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonCreated, "Immutable tag rule created"); err != nil {
 			return ctrl.Result{}, err
 		}
+		recordEvent(r.Recorder, &cr, ReasonCreated, actionCreate, "Created Harbor immutable tag rule %d", id)
 		return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
 	}
 
@@ -126,17 +131,25 @@ func (r *ImmutableTagRuleReconciler) Reconcile(ctx context.Context, req ctrl.Req
 				cr.Status.HarborImmutableRuleID = 0
 			}, "Immutable tag rule not found in Harbor")
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if immutableRuleNeedsUpdate(desired, current) {
+		if err := recordDrift(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "ImmutableTagRule",
+			normalizeImmutableRule(desired), normalizeImmutableRule(*current)); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !cr.Spec.ManagementPolicy.AllowsWrites() {
+			return reportObservedDrift(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Immutable tag rule")
+		}
 		if err := hc.UpdateImmutableRule(ctx, projectKey, cr.Status.HarborImmutableRuleID, desired); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		r.logger.Info("Updated immutable tag rule", "ID", cr.Status.HarborImmutableRuleID)
+		recordUpdate(r.Recorder, &cr, &cr.Status.HarborStatusBase, "Updated Harbor immutable tag rule %d", cr.Status.HarborImmutableRuleID)
 	}
 
-	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Reconciled", "Immutable tag rule reconciled"); err != nil {
+	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "Immutable tag rule reconciled"); err != nil {
 		return ctrl.Result{}, err
 	}
 	return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
@@ -151,12 +164,13 @@ func (r *ImmutableTagRuleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.ImmutableTagRule).Spec.HarborConnectionRef
 		},
+		nil,
 		"immutabletagrule",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("ImmutableTagRule", r))
 }
 
 func (r *ImmutableTagRuleReconciler) adoptExisting(ctx context.Context, hc *harborclient.Client, projectKey string, cr *harborv1alpha1.ImmutableTagRule, desired harborclient.ImmutableRule) (bool, error) {
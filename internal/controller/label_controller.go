@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -18,9 +19,10 @@ import (
 
 type LabelReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=labels,verbs=get;list;watch;create;update;patch;delete
@@ -44,19 +46,20 @@ func (r *LabelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return ctrl.Result{}, err
 	}
 
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), true, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if done, err := finalizeIfDeleting(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), func() error {
 		if cr.Status.HarborLabelID == 0 {
 			return nil
 		}
-		return hc.DeleteLabel(ctx, cr.Status.HarborLabelID)
+		err := hc.DeleteLabel(ctx, cr.Status.HarborLabelID)
+		return recordDeleted(r.Recorder, &cr, err, "Deleted Harbor label %d", cr.Status.HarborLabelID)
 	}); done {
 		return ctrl.Result{}, err
 	}
@@ -71,51 +74,53 @@ func (r *LabelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		if scope == "" {
 			scope = "p"
 		} else if scope != "p" {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("spec.scope must be 'p' when projectRef is set"))
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("spec.scope must be 'p' when projectRef is set"))
 		}
 		_, pid, err := resolveProject(ctx, r.Options, r.Client, cr.Namespace, cr.Spec.ProjectRef)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		projectID = pid
 	} else if scope == "" {
 		scope = "g"
 	}
 	if scope == "p" && projectID == 0 {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("project-scoped labels require projectRef"))
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("project-scoped labels require projectRef"))
 	}
 
 	desired := harborclient.Label{
-		Name:        cr.Name,
+		Name:        cr.HarborName(),
 		Description: cr.Spec.Description,
 		Color:       cr.Spec.Color,
 		Scope:       scope,
 		ProjectID:   projectID,
 	}
 
-	if cr.Status.HarborLabelID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy) {
+	if cr.Status.HarborLabelID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy) {
 		adopted, err := r.adoptExisting(ctx, hc, &cr, desired)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		if adopted {
 			r.logger.Info("Adopted existing label", "ID", cr.Status.HarborLabelID)
+			recordEvent(r.Recorder, &cr, ReasonAdopted, actionAdopt, "Adopted existing Harbor label %d", cr.Status.HarborLabelID)
 			return ctrl.Result{Requeue: true}, nil
 		}
 	}
 
 	if cr.Status.HarborLabelID == 0 {
-		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy); err != nil {
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		id, err := hc.CreateLabel(ctx, desired)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.HarborLabelID = id
-		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Created", "Label created"); err != nil {
+		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonCreated, "Label created"); err != nil {
 			return ctrl.Result{}, err
 		}
+		recordEvent(r.Recorder, &cr, ReasonCreated, actionCreate, "Created Harbor label %d", id)
 		return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
 	}
 
@@ -126,17 +131,25 @@ func (r *LabelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 				cr.Status.HarborLabelID = 0
 			}, "Label not found in Harbor")
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if labelNeedsUpdate(desired, current) {
+		if err := recordDrift(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "Label",
+			normalizeLabel(desired), normalizeLabel(*current)); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !cr.Spec.ManagementPolicy.AllowsWrites() {
+			return reportObservedDrift(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Label")
+		}
 		if err := hc.UpdateLabel(ctx, cr.Status.HarborLabelID, desired); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		r.logger.Info("Updated label", "ID", cr.Status.HarborLabelID)
+		recordUpdate(r.Recorder, &cr, &cr.Status.HarborStatusBase, "Updated Harbor label %d", cr.Status.HarborLabelID)
 	}
 
-	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Reconciled", "Label reconciled"); err != nil {
+	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "Label reconciled"); err != nil {
 		return ctrl.Result{}, err
 	}
 	return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
@@ -171,12 +184,13 @@ func (r *LabelReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.Label).Spec.HarborConnectionRef
 		},
+		nil,
 		"label",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("Label", r))
 }
 
 func labelNeedsUpdate(desired harborclient.Label, current *harborclient.Label) bool {
@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -22,14 +23,18 @@ import (
 // MemberReconciler reconciles a Member object.
 type MemberReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 type observedMember struct {
 	projectID int
 	memberID  int
+	// drifted is set when the Harbor role differs from the spec but the
+	// management policy forbids updating it.
+	drifted bool
 }
 
 // RBAC permissions.
@@ -57,13 +62,13 @@ func (r *MemberReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	// Resolve Harbor connection + typed client
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &member, &member.Status.HarborStatusBase, member.Namespace, member.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &member, &member.Status.HarborStatusBase, member.Namespace, member.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &member, member.Spec.GetDeletionPolicy(), true, err); done {
 			return ctrl.Result{}, finalErr
 		}
 		r.logger.Error(err, "Failed to get HarborConnection", "HarborConnectionRef", member.Spec.HarborConnectionRef)
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &member, &member.Status.HarborStatusBase, member.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &member, &member.Status.HarborStatusBase, member.Generation, err)
 	}
 
 	// Handle deletion with finalizer pattern
@@ -82,7 +87,7 @@ func (r *MemberReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	roleID, err := convertRoleNameToID(member.Spec.Role)
 	if err != nil {
 		r.logger.Error(err, "Invalid role", "Role", member.Spec.Role)
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &member, &member.Status.HarborStatusBase, member.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &member, &member.Status.HarborStatusBase, member.Generation, err)
 	}
 
 	// Ensure desired member state in Harbor (create/update as needed).
@@ -91,17 +96,21 @@ func (r *MemberReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		r.logger.Error(err, "Failed to ensure member in Harbor",
 			"ProjectRef", member.Spec.ProjectRef,
 			"RoleID", roleID)
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &member, &member.Status.HarborStatusBase, member.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &member, &member.Status.HarborStatusBase, member.Generation, err)
 	}
 	if observed.projectID == 0 || observed.memberID == 0 {
 		err := fmt.Errorf("member reconciliation completed without resolved Harbor IDs")
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &member, &member.Status.HarborStatusBase, member.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &member, &member.Status.HarborStatusBase, member.Generation, err)
 	}
 
 	statusChanged := member.Status.HarborProjectID != observed.projectID || member.Status.HarborMemberID != observed.memberID
 	member.Status.HarborProjectID = observed.projectID
 	member.Status.HarborMemberID = observed.memberID
-	conditionChanged := markReady(&member.Status.HarborStatusBase, member.Generation, "Reconciled", "Member reconciled")
+	reason, message := ReasonReconciled, "Member reconciled"
+	if observed.drifted {
+		reason, message = ReasonDriftDetected, observedDriftMessage("Member", member.Spec.ManagementPolicy)
+	}
+	conditionChanged := markReady(&member.Status.HarborStatusBase, member.Generation, reason, message)
 	if statusChanged || conditionChanged {
 		sanitizeOptionalHarborConnectionRef(&member)
 		if err := r.Status().Update(ctx, &member); err != nil {
@@ -149,7 +158,7 @@ func (r *MemberReconciler) ensureMemberPresent(
 	}
 
 	if existing == nil {
-		if err := requireCreationAllowed(r.Options, member.Spec.CreationPolicy); err != nil {
+		if err := requireCreationAllowed(r.Options, member.Spec.CreationPolicy, member.Spec.ManagementPolicy); err != nil {
 			return observedMember{}, err
 		}
 		newID, err := hc.CreateProjectMember(ctx, projectKey, reqBody)
@@ -178,6 +187,7 @@ func (r *MemberReconciler) ensureMemberPresent(
 			"EntityName", entityName,
 			"RoleID", roleID,
 			"MemberID", newID)
+		recordEvent(r.Recorder, member, ReasonCreated, actionCreate, "Created Harbor project member %d for %s %s", newID, entityType, entityName)
 		return observedMember{projectID: projectID, memberID: newID}, nil
 	}
 
@@ -186,7 +196,7 @@ func (r *MemberReconciler) ensureMemberPresent(
 	// not ready. Do not require a Ready condition to recover from an outage;
 	// the persisted project/member IDs are the ownership evidence. A different
 	// or previously unknown membership still requires an adoption policy.
-	if !allowsAdoption(r.Options, member.Spec.CreationPolicy) {
+	if !allowsAdoption(r.Options, member.Spec.CreationPolicy, member.Spec.ManagementPolicy) {
 		owned := member.Status.HarborProjectID == projectID && member.Status.HarborMemberID == existing.ID
 		if !owned {
 			cond := meta.FindStatusCondition(member.Status.Conditions, ConditionReady)
@@ -196,8 +206,19 @@ func (r *MemberReconciler) ensureMemberPresent(
 		}
 	}
 
+	if member.Status.HarborProjectID != projectID || member.Status.HarborMemberID != existing.ID {
+		recordEvent(r.Recorder, member, ReasonAdopted, actionAdopt, "Adopted existing Harbor project member %d for %s %s", existing.ID, entityType, entityName)
+	}
+
 	// Member exists → check if role matches; update if needed.
 	if existing.RoleID != roleID {
+		if err := recordDrift(ctx, r.Client, member, &member.Status.HarborStatusBase, &member.Spec.HarborSpecBase, "Member",
+			map[string]int{"role_id": roleID}, map[string]int{"role_id": existing.RoleID}); err != nil {
+			return observedMember{}, err
+		}
+		if !member.Spec.ManagementPolicy.AllowsWrites() {
+			return observedMember{projectID: projectID, memberID: existing.ID, drifted: true}, nil
+		}
 		if err := hc.UpdateProjectMemberRole(ctx, projectKey, existing.ID, roleID); err != nil {
 			return observedMember{}, err
 		}
@@ -208,6 +229,7 @@ func (r *MemberReconciler) ensureMemberPresent(
 			"OldRoleID", existing.RoleID,
 			"NewRoleID", roleID,
 			"MemberID", existing.ID)
+		recordUpdate(r.Recorder, member, &member.Status.HarborStatusBase, "Updated role of Harbor project member %d from %d to %d", existing.ID, existing.RoleID, roleID)
 	} else {
 		r.logger.V(2).Info("Harbor project member already up to date",
 			"ProjectRef", projectKey,
@@ -233,7 +255,7 @@ func (r *MemberReconciler) ensureMemberAbsent(
 			// membership is then already gone and deletion is complete.
 			return nil
 		}
-		return err
+		return recordDeleted(r.Recorder, member, err, "Deleted Harbor project member %d", member.Status.HarborMemberID)
 	}
 	if member.Status.HarborProjectID != 0 || member.Status.HarborMemberID != 0 {
 		return fmt.Errorf("member status contains incomplete Harbor identity")
@@ -276,6 +298,7 @@ func (r *MemberReconciler) ensureMemberAbsent(
 				"EntityType", entityType,
 				"EntityName", entityName,
 				"MemberID", pm.ID)
+			recordEvent(r.Recorder, member, ReasonDeleted, actionDelete, "Deleted Harbor project member %d", pm.ID)
 		}
 	}
 
@@ -313,7 +336,7 @@ func (r *MemberReconciler) desiredEntityFromSpec(
 		}
 		return "u", username, harborclient.CreateMemberRequest{
 			RoleID: roleID,
-			MemberUser: &harborclient.MemberUser{
+			MemberUser: harborclient.MemberUser{
 				Username: username,
 			},
 		}, nil
@@ -332,7 +355,7 @@ func (r *MemberReconciler) desiredEntityFromSpec(
 	}
 	return "g", entityName, harborclient.CreateMemberRequest{
 		RoleID:      roleID,
-		MemberGroup: group,
+		MemberGroup: *group,
 	}, nil
 }
 
@@ -363,6 +386,7 @@ func (r *MemberReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.Member).Spec.HarborConnectionRef
 		},
+		nil,
 		"member",
 	)
 	if err != nil {
@@ -393,5 +417,5 @@ func (r *MemberReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			}
 			return requests
 		}),
-	).Complete(r)
+	).Complete(traceReconciler("Member", r))
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -19,7 +20,11 @@ type OperatorOptions struct {
 	allowCrossNamespaceReferences *bool
 	defaultDriftDetectionInterval time.Duration
 	harborRequestTimeout          time.Duration
-	secretReader                  client.Reader
+	apiReader                     client.Reader
+	harborClients                 *harborClientCache
+	// harborClient, when set, replaces the connection of every resource. Plan
+	// uses it to reconcile manifests against a connection given directly.
+	harborClient *harborclient.Client
 }
 
 // OperatorConfig contains the validated startup values used to construct
@@ -49,6 +54,7 @@ func NewOperatorOptions(config OperatorConfig) (OperatorOptions, error) {
 		allowCrossNamespaceReferences: config.AllowCrossNamespaceReferences,
 		defaultDriftDetectionInterval: config.DefaultDriftDetectionInterval,
 		harborRequestTimeout:          config.HarborRequestTimeout,
+		harborClients:                 newHarborClientCache(),
 	}, nil
 }
 
@@ -70,13 +76,22 @@ func validateDefaultCreationPolicy(policy harborv1alpha1.CreationPolicy) error {
 	}
 }
 
-// WithSecretReader returns a copy configured to bypass the manager cache when
-// reading Secrets.
-func (o OperatorOptions) WithSecretReader(reader client.Reader) OperatorOptions {
-	o.secretReader = reader
+// WithAPIReader returns a copy configured to bypass the manager cache when
+// reading Secrets and the workloads a Robot restarts.
+func (o OperatorOptions) WithAPIReader(reader client.Reader) OperatorOptions {
+	o.apiReader = reader
 	return o
 }
 
+// uncachedReader returns the reader set by WithAPIReader, or c when there is
+// none.
+func (o OperatorOptions) uncachedReader(c client.Client) client.Reader {
+	if o.apiReader != nil {
+		return o.apiReader
+	}
+	return c
+}
+
 func (o OperatorOptions) effectiveCreationPolicy(policy harborv1alpha1.CreationPolicy) harborv1alpha1.CreationPolicy {
 	if policy != "" {
 		return policy
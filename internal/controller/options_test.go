@@ -90,7 +90,7 @@ func TestOperatorOptionsHarborRequestTimeout(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewOperatorOptions() error = %v", err)
 	}
-	harborClient, err := newHarborClient(options, "https://harbor.example.com", "user", "password", "")
+	harborClient, err := newHarborClient(options, "https://harbor.example.com", harborAuth{username: "user", password: "password"}, harborTLS{})
 	if err != nil {
 		t.Fatalf("newHarborClient() error = %v", err)
 	}
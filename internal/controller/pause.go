@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// pausedAnnotation stops the operator from calling Harbor for the annotated
+// object. On a HarborConnection or ClusterHarborConnection it pauses every
+// resource that uses the connection.
+const pausedAnnotation = "harbor.harbor-operator.io/paused"
+
+// reconciliationPausedError is returned instead of a Harbor client when the
+// object or its connection is paused.
+type reconciliationPausedError struct {
+	source string
+}
+
+func (e *reconciliationPausedError) Error() string {
+	return fmt.Sprintf("reconciliation is paused by the %s annotation on %s", pausedAnnotation, e.source)
+}
+
+func isReconciliationPaused(err error) bool {
+	var paused *reconciliationPausedError
+	return errors.As(err, &paused)
+}
+
+func hasPausedAnnotation(obj client.Object) bool {
+	return obj.GetAnnotations()[pausedAnnotation] == "true"
+}
+
+// checkPaused returns a reconciliationPausedError when obj, or the connection
+// it resolved, is paused. conn may be nil before the connection is resolved.
+func checkPaused(obj client.Object, conn *connectionConfig) error {
+	if hasPausedAnnotation(obj) {
+		return &reconciliationPausedError{source: "the resource"}
+	}
+	if conn != nil && conn.paused {
+		return &reconciliationPausedError{source: conn.displayName}
+	}
+	return nil
+}
+
+func markPaused(base *harborv1alpha1.HarborStatusBase, generation int64, message string) bool {
+	return setCondition(&base.Conditions, metav1.Condition{
+		Type:               ConditionPaused,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonPaused,
+		Message:            message,
+		ObservedGeneration: generation,
+		LastTransitionTime: metav1.Now(),
+	})
+}
+
+// markResumed sets Paused back to False on a resource that was paused before.
+func markResumed(base *harborv1alpha1.HarborStatusBase, generation int64) bool {
+	if meta.FindStatusCondition(base.Conditions, ConditionPaused) == nil {
+		return false
+	}
+	return setCondition(&base.Conditions, metav1.Condition{
+		Type:               ConditionPaused,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonResumed,
+		Message:            "Reconciliation is not paused",
+		ObservedGeneration: generation,
+		LastTransitionTime: metav1.Now(),
+	})
+}
+
+// setResumedStatus sets Paused back to False as soon as a paused resource may
+// reconcile again, so that a later failure does not leave it reported as
+// paused, and emits a Resumed Event.
+func setResumedStatus(ctx context.Context, c client.Client, recorder events.EventRecorder, obj client.Object, base *harborv1alpha1.HarborStatusBase, generation int64) error {
+	if !meta.IsStatusConditionTrue(base.Conditions, ConditionPaused) {
+		return nil
+	}
+	markResumed(base, generation)
+	sanitizeOptionalHarborConnectionRef(obj)
+	if err := c.Status().Update(ctx, obj); err != nil {
+		return err
+	}
+	recordEvent(recorder, obj, ReasonResumed, actionReconcile, "Reconciliation resumed")
+	return nil
+}
+
+// setPausedStatus records that reconciliation is paused. The other conditions
+// keep describing the last reconcile, and the resource is not requeued: removing
+// the annotation triggers the next reconcile.
+func setPausedStatus(ctx context.Context, c client.Client, recorder events.EventRecorder, obj client.Object, base *harborv1alpha1.HarborStatusBase, generation int64, err error) error {
+	if changed := markPaused(base, generation, err.Error()); changed {
+		sanitizeOptionalHarborConnectionRef(obj)
+		if updateErr := c.Status().Update(ctx, obj); updateErr != nil {
+			return updateErr
+		}
+		recordEvent(recorder, obj, ReasonPaused, actionReconcile, "%s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newPauseTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := harborv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithStatusSubresource(objs...).Build()
+}
+
+func pausedTestLabel(annotations map[string]string) *harborv1alpha1.Label {
+	return &harborv1alpha1.Label{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", Generation: 1, Annotations: annotations},
+		Spec: harborv1alpha1.LabelSpec{
+			HarborSpecBase: harborv1alpha1.HarborSpecBase{
+				HarborConnectionRef: &harborv1alpha1.HarborConnectionReference{Name: "harbor"},
+			},
+		},
+	}
+}
+
+func pausedTestConnection(annotations map[string]string) *harborv1alpha1.HarborConnection {
+	// The base URL is never reachable, so any Harbor call fails the test.
+	return &harborv1alpha1.HarborConnection{
+		ObjectMeta: metav1.ObjectMeta{Name: "harbor", Namespace: "default", Annotations: annotations},
+		Spec:       harborv1alpha1.HarborConnectionSpec{BaseURL: "https://harbor.invalid"},
+	}
+}
+
+func TestPausedResourceSkipsHarbor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		label      map[string]string
+		connection map[string]string
+		source     string
+	}{
+		{name: "resource annotation", label: map[string]string{pausedAnnotation: "true"}, source: "the resource"},
+		{name: "connection annotation", connection: map[string]string{pausedAnnotation: "true"}, source: "HarborConnection default/harbor"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			label := pausedTestLabel(tt.label)
+			c := newPauseTestClient(t, label, pausedTestConnection(tt.connection))
+			recorder := events.NewFakeRecorder(3)
+			r := &LabelReconciler{Client: c, Scheme: c.Scheme(), Recorder: recorder}
+
+			result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(label)})
+			if err != nil {
+				t.Fatalf("Reconcile returned error: %v", err)
+			}
+			if result.RequeueAfter != 0 {
+				t.Fatalf("RequeueAfter = %v, want no requeue while paused", result.RequeueAfter)
+			}
+
+			var got harborv1alpha1.Label
+			if err := c.Get(context.Background(), client.ObjectKeyFromObject(label), &got); err != nil {
+				t.Fatalf("get label: %v", err)
+			}
+			cond := meta.FindStatusCondition(got.Status.Conditions, ConditionPaused)
+			if cond == nil || cond.Status != metav1.ConditionTrue || !strings.Contains(cond.Message, tt.source) {
+				t.Fatalf("Paused = %+v, want True naming %s", cond, tt.source)
+			}
+			if len(got.Finalizers) != 0 {
+				t.Fatalf("finalizers = %v, want none added while paused", got.Finalizers)
+			}
+			if event := <-recorder.Events; !strings.HasPrefix(event, "Normal "+ReasonPaused+" ") {
+				t.Fatalf("event = %q, want a %s Event from the reconciler's Recorder", event, ReasonPaused)
+			}
+
+			// The connection is not Ready, so the resumed reconcile fails, but it
+			// must not stay reported as paused.
+			got.Annotations = nil
+			if err := c.Update(context.Background(), &got); err != nil {
+				t.Fatalf("update label: %v", err)
+			}
+			var conn harborv1alpha1.HarborConnection
+			if err := c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "harbor"}, &conn); err != nil {
+				t.Fatalf("get connection: %v", err)
+			}
+			conn.Annotations = nil
+			if err := c.Update(context.Background(), &conn); err != nil {
+				t.Fatalf("update connection: %v", err)
+			}
+			_, _ = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(label)})
+			if err := c.Get(context.Background(), client.ObjectKeyFromObject(label), &got); err != nil {
+				t.Fatalf("get label: %v", err)
+			}
+			if cond := meta.FindStatusCondition(got.Status.Conditions, ConditionPaused); cond == nil || cond.Status != metav1.ConditionFalse {
+				t.Fatalf("Paused = %+v, want False as soon as reconciliation resumes", cond)
+			}
+			if meta.IsStatusConditionTrue(got.Status.Conditions, ConditionReady) {
+				t.Fatal("Ready = True, want the resumed reconcile to fail on the connection")
+			}
+			if event := <-recorder.Events; !strings.HasPrefix(event, "Normal "+ReasonResumed+" ") {
+				t.Fatalf("event = %q, want a %s Event", event, ReasonResumed)
+			}
+		})
+	}
+}
+
+func TestPausedResourceIsOrphanedOnDeletion(t *testing.T) {
+	t.Parallel()
+
+	label := pausedTestLabel(map[string]string{pausedAnnotation: "true"})
+	label.Finalizers = []string{finalizerName}
+	label.Spec.DeletionPolicy = harborv1alpha1.DeletionPolicyDelete
+	label.Status.HarborLabelID = 7
+	now := metav1.Now()
+	label.DeletionTimestamp = &now
+	c := newPauseTestClient(t, label, pausedTestConnection(nil))
+	r := &LabelReconciler{Client: c, Scheme: c.Scheme()}
+
+	if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(label)}); err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+	var got harborv1alpha1.Label
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(label), &got); !apierrors.IsNotFound(err) {
+		t.Fatalf("get label = %v, want the finalizer removed and the object gone", err)
+	}
+}
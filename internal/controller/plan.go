@@ -0,0 +1,440 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+)
+
+// PlanAction is what reconciling a resource would do in Harbor.
+type PlanAction string
+
+const (
+	PlanCreate   PlanAction = "create"
+	PlanAdopt    PlanAction = "adopt"
+	PlanUpdate   PlanAction = "update"
+	PlanDelete   PlanAction = "delete"
+	PlanNoChange PlanAction = "no-change"
+	PlanError    PlanAction = "error"
+)
+
+// PlanEntry is the planned action for one resource.
+type PlanEntry struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Action    PlanAction
+	// Details are the Events the reconcile would emit and the fields that
+	// differ from Harbor.
+	Details []string
+	Err     error
+}
+
+// Changes reports whether the entry changes Harbor.
+func (e PlanEntry) Changes() bool {
+	return e.Action != PlanNoChange && e.Action != PlanError
+}
+
+// placeholderIDBase offsets the IDs of planned creates from real Harbor IDs.
+const placeholderIDBase = 1 << 30
+
+// maxPlanPasses bounds the reconciles run for one resource. Reconciles that
+// only update status requeue until they reach Harbor.
+const maxPlanPasses = 5
+
+// planOrder lists the planned kinds so that referenced resources are planned
+// before the resources that reference them.
+var planOrder = []string{
+	"Registry",
+	"Project",
+	"User",
+	"UserGroupClaim",
+	"ScannerRegistration",
+	"Member",
+	"Robot",
+	"Label",
+	"Quota",
+	"ImmutableTagRule",
+	"WebhookPolicy",
+	"RetentionPolicy",
+	"ReplicationPolicy",
+	"Configuration",
+	"GCSchedule",
+	"PurgeAuditSchedule",
+	"ScanAllSchedule",
+}
+
+// Plan reports what the controllers would do in Harbor to reconcile objects,
+// and to finalize deleted, without writing to Harbor. Objects may include the
+// Secrets they reference. Every resource uses hc instead of its
+// spec.harborConnectionRef. The resources are planned as if they were Ready
+// at their current generation, and existing Harbor objects are matched
+// regardless of creationPolicy since the manifests carry no status.
+func Plan(ctx context.Context, hc *harborclient.Client, options OperatorOptions, objects, deleted []client.Object) ([]PlanEntry, error) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(harborv1alpha1.AddToScheme(scheme))
+
+	transport := &dryRunTransport{next: http.DefaultTransport}
+	planned := *hc
+	httpClient := &http.Client{Transport: transport}
+	if hc.HTTPClient != nil {
+		httpClient.Timeout = hc.HTTPClient.Timeout
+		if hc.HTTPClient.Transport != nil {
+			transport.next = hc.HTTPClient.Transport
+		}
+	}
+	planned.HTTPClient = httpClient
+	options.harborClient = &planned
+
+	p := &planner{
+		options:   options,
+		transport: transport,
+		recorder:  &planRecorder{},
+		policies:  map[string]harborv1alpha1.CreationPolicy{},
+		created:   map[string]bool{},
+	}
+	var all, items []client.Object
+	seen := map[string]bool{}
+	for i, list := range [][]client.Object{objects, deleted} {
+		for _, obj := range list {
+			obj = obj.DeepCopyObject().(client.Object)
+			kind, err := kindOf(scheme, obj)
+			if err != nil {
+				return nil, err
+			}
+			key := planKey(kind, obj.GetNamespace(), obj.GetName())
+			if seen[key] {
+				return nil, fmt.Errorf("%s is listed more than once", key)
+			}
+			seen[key] = true
+			obj.SetResourceVersion("")
+			if slices.Contains(planOrder, kind) {
+				p.prepare(key, obj)
+				items = append(items, obj)
+				if i == 1 {
+					p.deleted = append(p.deleted, key)
+				}
+			}
+			all = append(all, obj)
+		}
+	}
+	p.client = fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(all...).
+		WithStatusSubresource(items...).
+		Build()
+	p.reconcilers = planReconcilers(p.client, scheme, options, p.recorder)
+
+	slices.SortStableFunc(items, func(a, b client.Object) int {
+		return slices.Index(planOrder, mustKind(scheme, a)) - slices.Index(planOrder, mustKind(scheme, b))
+	})
+	entries := make([]PlanEntry, 0, len(items))
+	for _, obj := range items {
+		entries = append(entries, p.plan(ctx, mustKind(scheme, obj), obj))
+	}
+	return entries, nil
+}
+
+func planReconcilers(c client.Client, scheme *runtime.Scheme, options OperatorOptions, recorder events.EventRecorder) map[string]reconcile.Reconciler {
+	return map[string]reconcile.Reconciler{
+		"Registry":            &RegistryReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"Project":             &ProjectReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"User":                &UserReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"UserGroupClaim":      &UserGroupClaimReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"ScannerRegistration": &ScannerRegistrationReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"Member":              &MemberReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"Robot":               &RobotReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"Label":               &LabelReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"Quota":               &QuotaReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"ImmutableTagRule":    &ImmutableTagRuleReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"WebhookPolicy":       &WebhookPolicyReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"RetentionPolicy":     &RetentionPolicyReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"ReplicationPolicy":   &ReplicationPolicyReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"Configuration":       &ConfigurationReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"GCSchedule":          &GCScheduleReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"PurgeAuditSchedule":  &PurgeAuditScheduleReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+		"ScanAllSchedule":     &ScanAllScheduleReconciler{Client: c, Scheme: scheme, Options: options, Recorder: recorder},
+	}
+}
+
+type planner struct {
+	options     OperatorOptions
+	client      client.Client
+	reconcilers map[string]reconcile.Reconciler
+	transport   *dryRunTransport
+	// recorder is the Recorder of every planned reconciler. It is reset
+	// before each resource is planned.
+	recorder *planRecorder
+	// policies holds the creationPolicy of each resource from its manifest,
+	// which prepare replaces.
+	policies map[string]harborv1alpha1.CreationPolicy
+	deleted  []string
+	// created holds the resources planned for creation.
+	created map[string]bool
+}
+
+// prepare makes obj look like a resource that was Ready at its generation and
+// lets it match an existing Harbor object by name.
+func (p *planner) prepare(key string, obj client.Object) {
+	obj.SetGeneration(1)
+	value := reflect.ValueOf(obj).Elem()
+	if status := value.FieldByName("Status"); status.IsValid() {
+		status.Set(reflect.Zero(status.Type()))
+	}
+	if base := harborStatusBase(obj); base != nil {
+		base.ObservedGeneration = 1
+		meta.SetStatusCondition(&base.Conditions, metav1.Condition{
+			Type:               ConditionReady,
+			Status:             metav1.ConditionTrue,
+			Reason:             ReasonReconciled,
+			ObservedGeneration: 1,
+		})
+	}
+	policy := value.FieldByName("Spec").FieldByName("CreationPolicy")
+	if policy.IsValid() {
+		p.policies[key] = harborv1alpha1.CreationPolicy(policy.String())
+		policy.SetString(string(harborv1alpha1.CreationPolicyCreateOrAdopt))
+	}
+}
+
+func (p *planner) plan(ctx context.Context, kind string, obj client.Object) PlanEntry {
+	entry := PlanEntry{Kind: kind, Namespace: obj.GetNamespace(), Name: obj.GetName(), Action: PlanNoChange}
+	key := planKey(kind, obj.GetNamespace(), obj.GetName())
+	ctx = log.IntoContext(ctx, logr.Discard())
+
+	recorder, err := p.reconcile(ctx, kind, obj)
+	if slices.Contains(p.deleted, key) && err == nil {
+		if recorder.has(ReasonCreated) || p.transport.wrote(http.MethodPost) {
+			entry.Details = append(entry.Details, "not found in Harbor")
+			return entry
+		}
+		if err = p.client.Delete(ctx, obj); err == nil {
+			recorder, err = p.reconcile(ctx, kind, obj)
+		}
+	}
+
+	policy, hasPolicy := p.policies[key]
+	switch {
+	case recorder.has(ReasonCreated), p.transport.wrote(http.MethodPost) && !recorder.has(ReasonAdopted):
+		entry.Action = PlanCreate
+		p.created[key] = true
+	case recorder.has(ReasonDeleted), p.transport.wrote(http.MethodDelete):
+		entry.Action = PlanDelete
+	case recorder.has(ReasonAdopted) && (!hasPolicy || p.options.effectiveCreationPolicy(policy).AllowsAdoption()):
+		entry.Action = PlanAdopt
+	case recorder.has(ReasonUpdated), recorder.has(ReasonDriftCorrected), recorder.has(ReasonSecretRotated),
+		len(p.transport.requests()) > 0:
+		entry.Action = PlanUpdate
+	case err != nil:
+		if dep := p.createdDependency(obj); dep != "" {
+			entry.Action = PlanCreate
+			entry.Details = append(entry.Details, "after "+dep+" is created")
+			p.created[key] = true
+			return entry
+		}
+		entry.Action = PlanError
+		entry.Err = err
+		return entry
+	}
+
+	for i, note := range recorder.notes {
+		// Matching an object the resource already manages is not an adoption,
+		// and the IDs of planned creates are placeholders.
+		if entry.Action != PlanCreate && (recorder.reasons[i] != ReasonAdopted || entry.Action == PlanAdopt) {
+			entry.Details = append(entry.Details, note)
+		}
+	}
+	if len(recorder.notes) == 0 {
+		entry.Details = append(entry.Details, p.transport.requests()...)
+	}
+	if err := p.client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err == nil {
+		if base := harborStatusBase(obj); base != nil && base.Drift != nil {
+			for _, field := range base.Drift.Fields {
+				entry.Details = append(entry.Details, fmt.Sprintf("%s: %s -> %s", field.Path, planValue(field.Observed), planValue(field.Desired)))
+			}
+		}
+	}
+	return entry
+}
+
+// reconcile runs the reconciler of obj until it reaches Harbor, fails, or
+// finishes, and returns the Events it emitted.
+func (p *planner) reconcile(ctx context.Context, kind string, obj client.Object) (*planRecorder, error) {
+	recorder := p.recorder
+	*recorder = planRecorder{}
+	p.transport.reset()
+	req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)}
+	for range maxPlanPasses {
+		result, err := p.reconcilers[kind].Reconcile(ctx, req)
+		if err != nil {
+			return recorder, err
+		}
+		if len(p.transport.requests()) > 0 || !result.Requeue { //nolint:staticcheck // controllers requeue with Requeue
+			break
+		}
+	}
+	// Reconciles that wait for a dependency report it in the Ready condition
+	// instead of returning an error.
+	current := obj.DeepCopyObject().(client.Object)
+	if err := p.client.Get(ctx, req.NamespacedName, current); err == nil && len(p.transport.requests()) == 0 {
+		if base := harborStatusBase(current); base != nil {
+			if ready := meta.FindStatusCondition(base.Conditions, ConditionReady); ready != nil && ready.Status != metav1.ConditionTrue {
+				return recorder, errors.New(ready.Message)
+			}
+		}
+	}
+	return recorder, nil
+}
+
+// createdDependency returns the resource obj references that is planned for
+// creation, if any. A resource that depends on one cannot be planned against
+// Harbor, but will be created once its dependency exists.
+func (p *planner) createdDependency(obj client.Object) string {
+	var found string
+	walkSpecReferences(obj, func(_ *field.Path, kind, namespace, name string) {
+		if namespace == "" {
+			namespace = obj.GetNamespace()
+		}
+		if key := planKey(kind, namespace, name); found == "" && p.created[key] {
+			found = key
+		}
+	})
+	return found
+}
+
+func planValue(v string) string {
+	if v == "" {
+		return "(unset)"
+	}
+	return v
+}
+
+func harborStatusBase(obj client.Object) *harborv1alpha1.HarborStatusBase {
+	status := reflect.ValueOf(obj).Elem().FieldByName("Status")
+	if !status.IsValid() {
+		return nil
+	}
+	base := status.FieldByName("HarborStatusBase")
+	if !base.IsValid() {
+		return nil
+	}
+	return base.Addr().Interface().(*harborv1alpha1.HarborStatusBase)
+}
+
+func kindOf(scheme *runtime.Scheme, obj client.Object) (string, error) {
+	gvks, _, err := scheme.ObjectKinds(obj)
+	if err != nil {
+		return "", err
+	}
+	return gvks[0].Kind, nil
+}
+
+func mustKind(scheme *runtime.Scheme, obj client.Object) string {
+	kind, err := kindOf(scheme, obj)
+	utilruntime.Must(err)
+	return kind
+}
+
+func planKey(kind, namespace, name string) string {
+	if namespace == "" {
+		return kind + " " + name
+	}
+	return kind + " " + namespace + "/" + name
+}
+
+// planRecorder collects the Events of a planned reconcile.
+type planRecorder struct {
+	reasons []string
+	notes   []string
+}
+
+func (r *planRecorder) Eventf(_ runtime.Object, _ runtime.Object, _, reason, _, note string, args ...any) {
+	r.reasons = append(r.reasons, reason)
+	r.notes = append(r.notes, fmt.Sprintf(note, args...))
+}
+
+func (r *planRecorder) has(reason string) bool {
+	return slices.Contains(r.reasons, reason)
+}
+
+// dryRunTransport passes reads to Harbor and answers every write with a
+// successful empty response, recording the request instead of sending it.
+// Creates get a Location header with a placeholder ID above the IDs Harbor
+// hands out, so reads of a planned object find nothing.
+type dryRunTransport struct {
+	next   http.RoundTripper
+	mu     sync.Mutex
+	writes []string
+	nextID int
+}
+
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return t.next.RoundTrip(req)
+	}
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.writes = append(t.writes, req.Method+" "+req.URL.Path)
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    req,
+	}
+	if req.Method == http.MethodPost {
+		t.nextID++
+		id := placeholderIDBase + t.nextID
+		resp.StatusCode = http.StatusCreated
+		resp.Header.Set("Location", strings.TrimSuffix(req.URL.Path, "/")+"/"+strconv.Itoa(id))
+	}
+	return resp, nil
+}
+
+func (t *dryRunTransport) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.writes = nil
+}
+
+func (t *dryRunTransport) requests() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.Clone(t.writes)
+}
+
+func (t *dryRunTransport) wrote(method string) bool {
+	for _, w := range t.requests() {
+		if strings.HasPrefix(w, method+" ") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,200 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+	"github.com/rkthtrifork/harbor-operator/internal/harborfake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestPlanReportsChangesWithoutWritingToHarbor(t *testing.T) {
+	t.Parallel()
+
+	const password = "Harbor12345"
+	server := harborfake.New(harborfake.Options{Username: harborfake.AdminUsername, Password: password})
+	t.Cleanup(server.Close)
+	hc := harborclient.New(server.URL, harborfake.AdminUsername, password)
+	ctx := context.Background()
+
+	for _, name := range []string{"existing", "gone"} {
+		if _, err := hc.CreateProject(ctx, harborclient.CreateProjectRequest{ProjectName: name, Public: ptr.To(false)}); err != nil {
+			t.Fatalf("CreateProject returned error: %v", err)
+		}
+	}
+	if _, err := hc.CreateLabel(ctx, harborclient.Label{Name: "team", Scope: "g", Color: "#000000"}); err != nil {
+		t.Fatalf("CreateLabel returned error: %v", err)
+	}
+	writesBefore := countWrites(server)
+
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: "default"}
+	}
+	objects := []client.Object{
+		&harborv1alpha1.Project{ObjectMeta: objectMeta("existing"), Spec: harborv1alpha1.ProjectSpec{Public: true}},
+		&harborv1alpha1.Project{ObjectMeta: objectMeta("new")},
+		&harborv1alpha1.Label{ObjectMeta: objectMeta("team"), Spec: harborv1alpha1.LabelSpec{
+			CreationPolicy: harborv1alpha1.CreationPolicyAdopt,
+			Scope:          "g",
+			Color:          "#000000",
+		}},
+		&harborv1alpha1.User{ObjectMeta: objectMeta("alice"), Spec: harborv1alpha1.UserSpec{
+			Email: "alice@example.com",
+			PasswordSecretRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "alice"},
+				Key:                  "password",
+			},
+		}},
+		&corev1.Secret{ObjectMeta: objectMeta("alice"), Data: map[string][]byte{"password": []byte(password)}},
+		&harborv1alpha1.Member{ObjectMeta: objectMeta("new-alice"), Spec: harborv1alpha1.MemberSpec{
+			ProjectRef: harborv1alpha1.ProjectReference{Name: "new"},
+			Role:       "developer",
+			MemberUser: &harborv1alpha1.MemberUser{UserRef: harborv1alpha1.UserReference{Name: "alice"}},
+		}},
+	}
+	deleted := []client.Object{
+		&harborv1alpha1.Project{ObjectMeta: objectMeta("gone")},
+	}
+
+	options, err := NewOperatorOptions(OperatorConfig{
+		DefaultCreationPolicy: harborv1alpha1.CreationPolicyCreate,
+		HarborRequestTimeout:  defaultHarborRequestTimeout,
+	})
+	if err != nil {
+		t.Fatalf("NewOperatorOptions returned error: %v", err)
+	}
+	entries, err := Plan(ctx, hc, options, objects, deleted)
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+
+	got := map[string]PlanAction{}
+	for _, entry := range entries {
+		if entry.Err != nil {
+			t.Logf("%s %s: %v", entry.Kind, entry.Name, entry.Err)
+		}
+		got[entry.Kind+"/"+entry.Name] = entry.Action
+	}
+	want := map[string]PlanAction{
+		"Project/existing": PlanUpdate,
+		"Project/new":      PlanCreate,
+		"Project/gone":     PlanDelete,
+		"User/alice":       PlanCreate,
+		"Label/team":       PlanAdopt,
+		"Member/new-alice": PlanCreate,
+	}
+	for key, action := range want {
+		if got[key] != action {
+			t.Errorf("expected %s to plan %q, got %q (all: %v)", key, action, got[key], got)
+		}
+	}
+	if entries[0].Kind != "Project" {
+		t.Fatalf("expected Projects to be planned first, got %s", entries[0].Kind)
+	}
+
+	if writes := countWrites(server); writes != writesBefore {
+		t.Fatalf("expected Plan not to write to Harbor, got %d writes", writes-writesBefore)
+	}
+	if _, err := hc.FindProjectByName(ctx, "gone"); err != nil {
+		t.Fatalf("expected the deleted project to remain in Harbor: %v", err)
+	}
+}
+
+func TestPlanAdoptsByHarborName(t *testing.T) {
+	t.Parallel()
+
+	const password = "Harbor12345"
+	server := harborfake.New(harborfake.Options{Username: harborfake.AdminUsername, Password: password})
+	t.Cleanup(server.Close)
+	hc := harborclient.New(server.URL, harborfake.AdminUsername, password)
+	ctx := context.Background()
+
+	if _, err := hc.CreateProject(ctx, harborclient.CreateProjectRequest{ProjectName: "Team_Apps", Public: ptr.To(false)}); err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	objects := []client.Object{
+		&harborv1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-apps", Namespace: "default"},
+			Spec: harborv1alpha1.ProjectSpec{
+				HarborName:     "Team_Apps",
+				CreationPolicy: harborv1alpha1.CreationPolicyAdopt,
+			},
+		},
+	}
+
+	options, err := NewOperatorOptions(OperatorConfig{
+		DefaultCreationPolicy: harborv1alpha1.CreationPolicyCreate,
+		HarborRequestTimeout:  defaultHarborRequestTimeout,
+	})
+	if err != nil {
+		t.Fatalf("NewOperatorOptions returned error: %v", err)
+	}
+	entries, err := Plan(ctx, hc, options, objects, nil)
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Action != PlanAdopt {
+		t.Fatalf("entries = %+v, want the project to adopt Harbor project Team_Apps", entries)
+	}
+}
+
+func TestPlanAdoptedProjectWithoutAllowlistIsUnchanged(t *testing.T) {
+	t.Parallel()
+
+	const password = "Harbor12345"
+	server := harborfake.New(harborfake.Options{Username: harborfake.AdminUsername, Password: password})
+	t.Cleanup(server.Close)
+	hc := harborclient.New(server.URL, harborfake.AdminUsername, password)
+	ctx := context.Background()
+
+	// Harbor fills in the id and project_id of the allowlist of every project.
+	if _, err := hc.CreateProject(ctx, harborclient.CreateProjectRequest{ProjectName: "apps", Public: ptr.To(false)}); err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	objects := []client.Object{
+		&harborv1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: "default"},
+			Spec: harborv1alpha1.ProjectSpec{
+				CreationPolicy: harborv1alpha1.CreationPolicyAdopt,
+				Owner:          harborfake.AdminUsername,
+			},
+		},
+	}
+
+	options, err := NewOperatorOptions(OperatorConfig{
+		DefaultCreationPolicy: harborv1alpha1.CreationPolicyCreate,
+		HarborRequestTimeout:  defaultHarborRequestTimeout,
+	})
+	if err != nil {
+		t.Fatalf("NewOperatorOptions returned error: %v", err)
+	}
+	entries, err := Plan(ctx, hc, options, objects, nil)
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Action != PlanAdopt {
+		t.Fatalf("entries = %+v, want the project adopted", entries)
+	}
+	for _, detail := range entries[0].Details {
+		if strings.Contains(detail, "cve_allowlist") || strings.HasPrefix(detail, http.MethodPut) {
+			t.Fatalf("details = %v, want no update of a project without spec.cveAllowlist", entries[0].Details)
+		}
+	}
+}
+
+func countWrites(server *harborfake.Server) int {
+	var writes int
+	for _, req := range server.Requests() {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			writes++
+		}
+	}
+	return writes
+}
@@ -3,11 +3,14 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -18,9 +21,10 @@ import (
 
 type ProjectReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=projects,verbs=get;list;watch;create;update;patch;delete
@@ -45,12 +49,12 @@ func (r *ProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	}
 
 	// Resolve Harbor connection + typed client
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), true, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	// Handle deletion
@@ -66,36 +70,38 @@ func (r *ProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	}
 
 	// Defaults & adoption
-	if cr.Status.HarborProjectID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy) {
+	if cr.Status.HarborProjectID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy) {
 		if adopted, err := r.adoptExisting(ctx, hc, &cr); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		} else if adopted {
 			r.logger.Info("Adopted existing project",
-				"Name", cr.Name, "ID", cr.Status.HarborProjectID)
+				"Name", cr.HarborName(), "ID", cr.Status.HarborProjectID)
+			recordEvent(r.Recorder, &cr, ReasonAdopted, actionAdopt, "Adopted existing Harbor project %d", cr.Status.HarborProjectID)
 		}
 	}
 
 	// Desired payload
 	createReq, err := r.buildCreateReq(ctx, &cr)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	// Create / Update path
 	if cr.Status.HarborProjectID == 0 {
-		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy); err != nil {
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		// create
 		newID, err := hc.CreateProject(ctx, createReq)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.HarborProjectID = newID
-		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Created", "Project created"); err != nil {
+		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonCreated, "Project created"); err != nil {
 			return ctrl.Result{}, err
 		}
 		r.logger.Info("Created project", "ID", newID)
+		recordEvent(r.Recorder, &cr, ReasonCreated, actionCreate, "Created Harbor project %d", newID)
 		return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
 	}
 
@@ -107,18 +113,26 @@ func (r *ProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 				cr.Status.HarborProjectID = 0
 			}, "Project not found in Harbor")
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	// compare desired vs. current
-	if projectNeedsUpdate(createReq, *current) {
+	if projectNeedsUpdate(createReq, cr.Spec.Owner, *current) {
+		if err := recordDrift(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "Project",
+			desiredProjectState(createReq, cr.Spec.Owner), projectState(*current)); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !cr.Spec.ManagementPolicy.AllowsWrites() {
+			return reportObservedDrift(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Project")
+		}
 		// update
 		if err := hc.UpdateProject(ctx, current.ProjectID, createReq); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		r.logger.Info("Updated project", "ID", current.ProjectID)
+		recordUpdate(r.Recorder, &cr, &cr.Status.HarborStatusBase, "Updated Harbor project %d", current.ProjectID)
 	}
-	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Reconciled", "Project reconciled"); err != nil {
+	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "Project reconciled"); err != nil {
 		return ctrl.Result{}, err
 	}
 	return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
@@ -135,13 +149,13 @@ func (r *ProjectReconciler) deleteProject(ctx context.Context, hc *harborclient.
 		r.logger.V(1).Info("Project already gone", "ID", cr.Status.HarborProjectID)
 		return nil
 	}
-	return err
+	return recordDeleted(r.Recorder, cr, err, "Deleted Harbor project %d", cr.Status.HarborProjectID)
 }
 
 // adoption by name
 func (r *ProjectReconciler) adoptExisting(ctx context.Context, hc *harborclient.Client, cr *harborv1alpha1.Project) (bool, error) {
 
-	project, err := hc.FindProjectByName(ctx, cr.Name)
+	project, err := hc.FindProjectByName(ctx, cr.HarborName())
 	if err != nil {
 		return false, err
 	}
@@ -157,15 +171,15 @@ func (r *ProjectReconciler) buildCreateReq(ctx context.Context, cr *harborv1alph
 	if m := cr.Spec.Metadata; m != nil {
 		meta = harborclient.ProjectMetadata{
 			Public:                   m.Public,
-			EnableContentTrust:       m.EnableContentTrust,
-			EnableContentTrustCosign: m.EnableContentTrustCosign,
-			PreventVul:               m.PreventVul,
-			Severity:                 m.Severity,
-			AutoScan:                 m.AutoScan,
-			AutoSBOMGeneration:       m.AutoSBOMGeneration,
-			ReuseSysCVEAllowlist:     m.ReuseSysCVEAllowlist,
-			RetentionID:              m.RetentionID,
-			ProxySpeedKB:             m.ProxySpeedKB,
+			EnableContentTrust:       optional(m.EnableContentTrust),
+			EnableContentTrustCosign: optional(m.EnableContentTrustCosign),
+			PreventVul:               optional(m.PreventVul),
+			Severity:                 optional(m.Severity),
+			AutoScan:                 optional(m.AutoScan),
+			AutoSBOMGeneration:       optional(m.AutoSBOMGeneration),
+			ReuseSysCVEAllowlist:     optional(m.ReuseSysCVEAllowlist),
+			RetentionID:              optional(m.RetentionID),
+			ProxySpeedKB:             optional(m.ProxySpeedKB),
 		}
 	}
 	if meta.Public == "" {
@@ -180,12 +194,12 @@ func (r *ProjectReconciler) buildCreateReq(ctx context.Context, cr *harborv1alph
 	if a := cr.Spec.CVEAllowlist; a != nil {
 		allow.ID = a.ID
 		allow.ProjectID = a.ProjectID
-		allow.ExpiresAt = a.ExpiresAt
+		allow.ExpiresAt = optional(a.ExpiresAt)
 		allow.CreationTime = a.CreationTime.UTC().Format(time.RFC3339)
 		allow.UpdateTime = a.UpdateTime.UTC().Format(time.RFC3339)
 		allow.Items = make([]harborclient.CVEAllowlistItem, len(a.Items))
 		for i, item := range a.Items {
-			allow.Items[i].CveID = item.CveID
+			allow.Items[i].CVEID = item.CveID
 		}
 	}
 
@@ -204,9 +218,8 @@ func (r *ProjectReconciler) buildCreateReq(ctx context.Context, cr *harborv1alph
 	}
 
 	return harborclient.CreateProjectRequest{
-		ProjectName:  cr.Name,
-		Public:       cr.Spec.Public,
-		Owner:        cr.Spec.Owner,
+		ProjectName:  cr.HarborName(),
+		Public:       ptr.To(cr.Spec.Public),
 		Metadata:     meta,
 		CVEAllowlist: allow,
 		StorageLimit: storageLimit,
@@ -214,7 +227,7 @@ func (r *ProjectReconciler) buildCreateReq(ctx context.Context, cr *harborv1alph
 	}, nil
 }
 
-func projectNeedsUpdate(desired harborclient.CreateProjectRequest,
+func projectNeedsUpdate(desired harborclient.CreateProjectRequest, owner string,
 	current harborclient.Project) bool {
 
 	if desired.ProjectName != current.Name {
@@ -222,14 +235,14 @@ func projectNeedsUpdate(desired harborclient.CreateProjectRequest,
 	}
 
 	wantPub := "false"
-	if desired.Public {
+	if ptr.Deref(desired.Public, false) {
 		wantPub = "true"
 	}
 	if wantPub != current.Metadata.Public {
 		return true
 	}
 
-	if !strings.EqualFold(desired.Owner, current.OwnerName) {
+	if !strings.EqualFold(owner, current.OwnerName) {
 		return true
 	}
 
@@ -243,34 +256,74 @@ func projectNeedsUpdate(desired harborclient.CreateProjectRequest,
 	mw := desired.Metadata
 	mc := current.Metadata
 	switch {
-	case mw.EnableContentTrust != mc.EnableContentTrust,
-		mw.EnableContentTrustCosign != mc.EnableContentTrustCosign,
-		mw.PreventVul != mc.PreventVul,
-		mw.Severity != mc.Severity,
-		mw.AutoScan != mc.AutoScan,
-		mw.AutoSBOMGeneration != mc.AutoSBOMGeneration,
-		mw.ReuseSysCVEAllowlist != mc.ReuseSysCVEAllowlist,
-		mw.RetentionID != mc.RetentionID,
-		mw.ProxySpeedKB != mc.ProxySpeedKB:
+	case !ptr.Equal(mw.EnableContentTrust, mc.EnableContentTrust),
+		!ptr.Equal(mw.EnableContentTrustCosign, mc.EnableContentTrustCosign),
+		!ptr.Equal(mw.PreventVul, mc.PreventVul),
+		!ptr.Equal(mw.Severity, mc.Severity),
+		!ptr.Equal(mw.AutoScan, mc.AutoScan),
+		!ptr.Equal(mw.AutoSBOMGeneration, mc.AutoSBOMGeneration),
+		!ptr.Equal(mw.ReuseSysCVEAllowlist, mc.ReuseSysCVEAllowlist),
+		!ptr.Equal(mw.RetentionID, mc.RetentionID),
+		!ptr.Equal(mw.ProxySpeedKB, mc.ProxySpeedKB):
 		return true
 	}
 
+	// Harbor sets the id and project_id of the allowlist itself, so only the
+	// items and expiry are compared.
 	aw := desired.CVEAllowlist
 	ac := current.CVEAllowlist
-	if aw.ID != ac.ID ||
-		aw.ProjectID != ac.ProjectID ||
-		aw.ExpiresAt != ac.ExpiresAt ||
+	if !ptr.Equal(aw.ExpiresAt, ac.ExpiresAt) ||
 		len(aw.Items) != len(ac.Items) {
 		return true
 	}
 	for i := range aw.Items {
-		if aw.Items[i].CveID != ac.Items[i].CveID {
+		if aw.Items[i].CVEID != ac.Items[i].CVEID {
 			return true
 		}
 	}
 	return false
 }
 
+// desiredProjectState returns the project Harbor should hold for the request,
+// in the form of projectState.
+func desiredProjectState(desired harborclient.CreateProjectRequest, owner string) harborclient.Project {
+	meta := desired.Metadata
+	meta.Public = strconv.FormatBool(ptr.Deref(desired.Public, false))
+	return projectState(harborclient.Project{
+		Name:         desired.ProjectName,
+		OwnerName:    owner,
+		RegistryID:   ptr.Deref(desired.RegistryID, 0),
+		Metadata:     meta,
+		CVEAllowlist: desired.CVEAllowlist,
+	})
+}
+
+// projectState keeps the fields of a project that projectNeedsUpdate compares.
+func projectState(p harborclient.Project) harborclient.Project {
+	m := p.Metadata
+	return harborclient.Project{
+		Name:       p.Name,
+		OwnerName:  strings.ToLower(p.OwnerName),
+		RegistryID: p.RegistryID,
+		Metadata: harborclient.ProjectMetadata{
+			Public:                   m.Public,
+			EnableContentTrust:       m.EnableContentTrust,
+			EnableContentTrustCosign: m.EnableContentTrustCosign,
+			PreventVul:               m.PreventVul,
+			Severity:                 m.Severity,
+			AutoScan:                 m.AutoScan,
+			AutoSBOMGeneration:       m.AutoSBOMGeneration,
+			ReuseSysCVEAllowlist:     m.ReuseSysCVEAllowlist,
+			RetentionID:              m.RetentionID,
+			ProxySpeedKB:             m.ProxySpeedKB,
+		},
+		CVEAllowlist: harborclient.CVEAllowlist{
+			ExpiresAt: p.CVEAllowlist.ExpiresAt,
+			Items:     p.CVEAllowlist.Items,
+		},
+	}
+}
+
 func (r *ProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	builder, err := setupHarborBackedController(
 		mgr,
@@ -280,10 +333,11 @@ func (r *ProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.Project).Spec.HarborConnectionRef
 		},
+		nil,
 		"project",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("Project", r))
 }
@@ -25,6 +25,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -250,6 +251,36 @@ var _ = Describe("Project Controller", func() {
 			Expect(project.Spec.CreationPolicy).To(BeEmpty())
 			Expect(project.Status.HarborProjectID).To(Equal(42))
 		})
+
+		It("reports drift without updating Harbor with management policy ObserveOnly", func() {
+			Expect(k8sClient.Get(ctx, typeNamespacedName, project)).To(Succeed())
+			project.Spec.CreationPolicy = harborv1alpha1.CreationPolicyCreate
+			project.Spec.ManagementPolicy = harborv1alpha1.ManagementPolicyObserveOnly
+			project.Spec.Public = true
+			Expect(k8sClient.Update(ctx, project)).To(Succeed())
+
+			controllerReconciler := &ProjectReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, project)).To(Succeed())
+			Expect(project.Status.HarborProjectID).To(Equal(42))
+			ready := apimeta.FindStatusCondition(project.Status.Conditions, ConditionReady)
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Status).To(Equal(metav1.ConditionTrue))
+			Expect(ready.Reason).To(Equal(ReasonDriftDetected))
+			Expect(project.Status.Drift).NotTo(BeNil())
+			Expect(project.Status.Drift.Fields).To(ContainElement(harborv1alpha1.DriftField{
+				Path:     "$.metadata.public",
+				Desired:  `"true"`,
+				Observed: `"false"`,
+			}))
+		})
 	})
 
 	Context("When Adopt cannot find an existing project", func() {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -16,9 +17,10 @@ import (
 
 type PurgeAuditScheduleReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=purgeauditschedules,verbs=get;list;watch;create;update;patch;delete
@@ -41,12 +43,12 @@ func (r *PurgeAuditScheduleReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return ctrl.Result{}, err
 	}
 
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), false, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if done, err := finalizeIfDeleting(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), nil); done {
@@ -57,7 +59,7 @@ func (r *PurgeAuditScheduleReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return ctrl.Result{}, err
 	}
 	if err := ensurePurgeAuditScheduleSingletonOwner(ctx, r.Options, r.Client, &cr); err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	params := map[string]any{}
@@ -79,7 +81,7 @@ func (r *PurgeAuditScheduleReconciler) Reconcile(ctx context.Context, req ctrl.R
 		Parameters: params,
 	}
 	if sched.Schedule.Type != harborv1alpha1.ScheduleTypeManual && sched.Schedule.Type != harborv1alpha1.ScheduleTypeNone && sched.Schedule.Cron == "" {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("schedule.cron is required for schedule type %q", sched.Schedule.Type))
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("schedule.cron is required for schedule type %q", sched.Schedule.Type))
 	}
 	hash := hashParts(
 		fmt.Sprintf("type=%s", cr.Spec.Schedule.Type),
@@ -89,28 +91,52 @@ func (r *PurgeAuditScheduleReconciler) Reconcile(ctx context.Context, req ctrl.R
 		fmt.Sprintf("dry=%t", cr.Spec.Parameters.DryRun),
 	)
 
+	if !cr.Spec.ManagementPolicy.AllowsWrites() {
+		current, err := hc.GetPurgeSchedule(ctx)
+		if err != nil && !harborclient.IsNotFound(err) {
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		}
+		if current == nil || !scheduleObjEqual(current.Schedule, sched.Schedule) {
+			var observed any
+			if current != nil {
+				observed = scheduleObjState(current.Schedule)
+			}
+			if err := recordDrift(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "PurgeAuditSchedule",
+				scheduleObjState(sched.Schedule), observed); err != nil {
+				return ctrl.Result{}, err
+			}
+			return reportObservedDrift(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Purge audit schedule")
+		}
+		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "Purge audit schedule reconciled"); err != nil {
+			return ctrl.Result{}, err
+		}
+		return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
+	}
+
 	if cr.Status.LastAppliedScheduleHash == "" {
 		if err := hc.CreatePurgeSchedule(ctx, sched); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.LastAppliedScheduleHash = hash
-		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Created", "Purge audit schedule created"); err != nil {
+		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonCreated, "Purge audit schedule created"); err != nil {
 			return ctrl.Result{}, err
 		}
+		recordEvent(r.Recorder, &cr, ReasonCreated, actionCreate, "Created Harbor audit log purge schedule")
 		return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
 	}
 
 	statusChanged := false
 	if cr.Status.LastAppliedScheduleHash != hash {
 		if err := hc.UpdatePurgeSchedule(ctx, sched); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.LastAppliedScheduleHash = hash
 		statusChanged = true
 		r.logger.Info("Updated purge audit schedule")
+		recordUpdate(r.Recorder, &cr, &cr.Status.HarborStatusBase, "Updated Harbor audit log purge schedule")
 	}
 
-	condChanged := markReady(&cr.Status.HarborStatusBase, cr.Generation, "Reconciled", "Purge audit schedule reconciled")
+	condChanged := markReady(&cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "Purge audit schedule reconciled")
 	if statusChanged || condChanged {
 		if err := r.Status().Update(ctx, &cr); err != nil {
 			return ctrl.Result{}, err
@@ -128,10 +154,11 @@ func (r *PurgeAuditScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.PurgeAuditSchedule).Spec.HarborConnectionRef
 		},
+		nil,
 		"purgeaudit",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("PurgeAuditSchedule", r))
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -18,9 +19,10 @@ import (
 
 type QuotaReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=quotas,verbs=get;list;watch;create;update;patch;delete
@@ -44,12 +46,12 @@ func (r *QuotaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return ctrl.Result{}, err
 	}
 
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), false, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if done, err := finalizeIfDeleting(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), nil); done {
@@ -62,16 +64,16 @@ func (r *QuotaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 	_, projectID, err := resolveProject(ctx, r.Options, r.Client, cr.Namespace, cr.Spec.ProjectRef)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 	if projectID == 0 {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("unable to resolve project ID for quota"))
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("unable to resolve project ID for quota"))
 	}
 
 	if cr.Status.HarborQuotaID == 0 {
 		quotaID, err := r.findQuotaID(ctx, hc, projectID)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.HarborQuotaID = quotaID
 		if err := r.Status().Update(ctx, &cr); err != nil {
@@ -86,17 +88,25 @@ func (r *QuotaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 				cr.Status.HarborQuotaID = 0
 			}, "Quota not found in Harbor")
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if quotaNeedsUpdate(cr.Spec.Hard, current) {
-		if err := hc.UpdateQuota(ctx, cr.Status.HarborQuotaID, cr.Spec.Hard); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		if err := recordDrift(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "Quota",
+			quotaResourceList(cr.Spec.Hard), current.Hard); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !cr.Spec.ManagementPolicy.AllowsWrites() {
+			return reportObservedDrift(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Quota")
+		}
+		if err := hc.UpdateQuota(ctx, cr.Status.HarborQuotaID, quotaResourceList(cr.Spec.Hard)); err != nil {
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		r.logger.Info("Updated quota", "ID", cr.Status.HarborQuotaID)
+		recordUpdate(r.Recorder, &cr, &cr.Status.HarborStatusBase, "Updated Harbor quota %d", cr.Status.HarborQuotaID)
 	}
 
-	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Reconciled", "Quota reconciled"); err != nil {
+	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "Quota reconciled"); err != nil {
 		return ctrl.Result{}, err
 	}
 	return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
@@ -111,12 +121,13 @@ func (r *QuotaReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.Quota).Spec.HarborConnectionRef
 		},
+		nil,
 		"quota",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("Quota", r))
 }
 
 func (r *QuotaReconciler) findQuotaID(ctx context.Context, hc *harborclient.Client, projectID int) (int, error) {
@@ -137,5 +148,16 @@ func quotaNeedsUpdate(desired map[string]int64, current *harborclient.Quota) boo
 	if desired == nil {
 		return false
 	}
-	return !reflect.DeepEqual(desired, current.Hard)
+	return !reflect.DeepEqual(quotaResourceList(desired), current.Hard)
+}
+
+func quotaResourceList(hard map[string]int64) harborclient.ResourceList {
+	if hard == nil {
+		return nil
+	}
+	out := make(harborclient.ResourceList, len(hard))
+	for resource, limit := range hard {
+		out[resource] = int(limit)
+	}
+	return out
 }
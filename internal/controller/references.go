@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// referenceKinds maps reference types to the kind they refer to.
+var referenceKinds = map[reflect.Type]string{
+	reflect.TypeFor[harborv1alpha1.ProjectReference]():        "Project",
+	reflect.TypeFor[harborv1alpha1.RegistryReference]():       "Registry",
+	reflect.TypeFor[harborv1alpha1.UserReference]():           "User",
+	reflect.TypeFor[harborv1alpha1.UserGroupClaimReference](): "UserGroupClaim",
+	reflect.TypeFor[harborv1alpha1.SecretReference]():         "Secret",
+}
+
+// walkSpecReferences calls fn with the field path, kind, namespace, and name
+// of every reference in the spec of obj. The namespace is empty when the
+// reference omits it.
+func walkSpecReferences(obj client.Object, fn func(path *field.Path, kind, namespace, name string)) {
+	spec := reflect.ValueOf(obj).Elem().FieldByName("Spec")
+	if !spec.IsValid() {
+		return
+	}
+	walkReferences(spec, field.NewPath("spec"), fn)
+}
+
+func walkReferences(v reflect.Value, path *field.Path, fn func(path *field.Path, kind, namespace, name string)) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			walkReferences(v.Elem(), path, fn)
+		}
+	case reflect.Slice:
+		for i := range v.Len() {
+			walkReferences(v.Index(i), path.Index(i), fn)
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return
+		}
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int { return strings.Compare(a.String(), b.String()) })
+		for _, key := range keys {
+			walkReferences(v.MapIndex(key), path.Key(key.String()), fn)
+		}
+	case reflect.Struct:
+		if kind, ok := referenceKinds[v.Type()]; ok {
+			fn(path, kind, v.FieldByName("Namespace").String(), v.FieldByName("Name").String())
+			return
+		}
+		for i := range v.NumField() {
+			structField := v.Type().Field(i)
+			if !structField.IsExported() {
+				continue
+			}
+			name, _, _ := strings.Cut(structField.Tag.Get("json"), ",")
+			switch {
+			case name == "-":
+			case name == "":
+				walkReferences(v.Field(i), path, fn)
+			default:
+				walkReferences(v.Field(i), path.Child(name), fn)
+			}
+		}
+	}
+}
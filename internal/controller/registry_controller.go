@@ -7,6 +7,8 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -17,9 +19,10 @@ import (
 
 type RegistryReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=registries,verbs=get;list;watch;create;update;patch;delete
@@ -44,12 +47,12 @@ func (r *RegistryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	}
 
 	// Harbor client
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), true, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	// Deletion
@@ -65,35 +68,37 @@ func (r *RegistryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	}
 
 	// Defaults & adoption
-	if cr.Status.HarborRegistryID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy) {
+	if cr.Status.HarborRegistryID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy) {
 		if ok, err := r.adoptExisting(ctx, hc, &cr); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		} else if ok {
 			r.logger.Info("Adopted registry", "ID", cr.Status.HarborRegistryID)
+			recordEvent(r.Recorder, &cr, ReasonAdopted, actionAdopt, "Adopted existing Harbor registry %d", cr.Status.HarborRegistryID)
 		}
 	}
 
 	credential, credHash, caCert, err := r.buildRegistryCredential(ctx, &cr)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	// Create / Update
 	if cr.Status.HarborRegistryID == 0 {
-		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy); err != nil {
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		createReq := r.buildCreateReq(cr, credential, caCert)
 		id, err := hc.CreateRegistry(ctx, createReq)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.HarborRegistryID = id
 		cr.Status.CredentialHash = credHash
-		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Created", "Registry created"); err != nil {
+		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonCreated, "Registry created"); err != nil {
 			return ctrl.Result{}, err
 		}
 		r.logger.Info("Created registry", "ID", id)
+		recordEvent(r.Recorder, &cr, ReasonCreated, actionCreate, "Created Harbor registry %d", id)
 		return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
 	}
 
@@ -104,22 +109,38 @@ func (r *RegistryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 				cr.Status.HarborRegistryID = 0
 			}, "Registry not found in Harbor")
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	statusChanged := false
 	if registryNeedsUpdate(cr, *current, credHash, caCert) {
+		desired := harborclient.Registry{
+			Name:          cr.HarborName(),
+			URL:           cr.Spec.URL,
+			Description:   cr.Spec.Description,
+			Type:          cr.Spec.Type,
+			Insecure:      cr.Spec.Insecure,
+			CACertificate: ptr.To(caCert),
+		}
+		if err := recordDrift(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "Registry",
+			registryState(desired, caCert != ""), registryState(*current, caCert != "")); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !cr.Spec.ManagementPolicy.AllowsWrites() {
+			return reportObservedDrift(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Registry")
+		}
 		updateReq := r.buildUpdateReq(cr, credential, caCert)
 		if err := hc.UpdateRegistry(ctx, current.ID, updateReq); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		if credHash != "" && credHash != cr.Status.CredentialHash {
 			cr.Status.CredentialHash = credHash
 			statusChanged = true
 		}
 		r.logger.Info("Updated registry", "ID", current.ID)
+		recordUpdate(r.Recorder, &cr, &cr.Status.HarborStatusBase, "Updated Harbor registry %d", current.ID)
 	}
-	condChanged := markReady(&cr.Status.HarborStatusBase, cr.Generation, "Reconciled", "Registry reconciled")
+	condChanged := markReady(&cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "Registry reconciled")
 	if statusChanged || condChanged {
 		if err := r.Status().Update(ctx, &cr); err != nil {
 			return ctrl.Result{}, err
@@ -136,11 +157,11 @@ func (r *RegistryReconciler) deleteRegistry(ctx context.Context, hc *harborclien
 	if harborclient.IsNotFound(err) {
 		return nil
 	}
-	return err
+	return recordDeleted(r.Recorder, cr, err, "Deleted Harbor registry %d", cr.Status.HarborRegistryID)
 }
 
 func (r *RegistryReconciler) adoptExisting(ctx context.Context, hc *harborclient.Client, cr *harborv1alpha1.Registry) (bool, error) {
-	reg, err := hc.FindRegistryByName(ctx, cr.Name)
+	reg, err := hc.FindRegistryByName(ctx, cr.HarborName())
 	if err != nil {
 		return false, err
 	}
@@ -154,33 +175,33 @@ func (r *RegistryReconciler) adoptExisting(ctx context.Context, hc *harborclient
 func (r *RegistryReconciler) buildCreateReq(cr harborv1alpha1.Registry, credential *harborclient.RegistryCredential, caCert string) harborclient.CreateRegistryRequest {
 	return harborclient.CreateRegistryRequest{
 		URL:           cr.Spec.URL,
-		Name:          cr.Name,
+		Name:          cr.HarborName(),
 		Description:   cr.Spec.Description,
 		Type:          cr.Spec.Type,
 		Insecure:      cr.Spec.Insecure,
-		CACertificate: caCert,
-		Credential:    credential,
+		CACertificate: optional(caCert),
+		Credential:    ptr.Deref(credential, harborclient.RegistryCredential{}),
 	}
 }
 
 func (r *RegistryReconciler) buildUpdateReq(cr harborv1alpha1.Registry, credential *harborclient.RegistryCredential, caCert string) harborclient.UpdateRegistryRequest {
 	req := harborclient.UpdateRegistryRequest{
-		Name:          cr.Name,
-		Description:   cr.Spec.Description,
-		URL:           cr.Spec.URL,
-		Insecure:      cr.Spec.Insecure,
-		CACertificate: caCert,
+		Name:          ptr.To(cr.HarborName()),
+		Description:   ptr.To(cr.Spec.Description),
+		URL:           ptr.To(cr.Spec.URL),
+		Insecure:      ptr.To(cr.Spec.Insecure),
+		CACertificate: optional(caCert),
 	}
 	if credential != nil {
-		req.CredentialType = credential.Type
-		req.AccessKey = credential.AccessKey
-		req.AccessSecret = credential.AccessSecret
+		req.CredentialType = optional(credential.Type)
+		req.AccessKey = optional(credential.AccessKey)
+		req.AccessSecret = optional(credential.AccessSecret)
 	}
 	return req
 }
 
 func registryNeedsUpdate(cr harborv1alpha1.Registry, current harborclient.Registry, desiredCredHash, desiredCACert string) bool {
-	if cr.Name != current.Name {
+	if cr.HarborName() != current.Name {
 		return true
 	}
 	if cr.Spec.URL != current.URL {
@@ -195,7 +216,7 @@ func registryNeedsUpdate(cr harborv1alpha1.Registry, current harborclient.Regist
 	if cr.Spec.Insecure != current.Insecure {
 		return true
 	}
-	if desiredCACert != "" && desiredCACert != current.CACertificate {
+	if desiredCACert != "" && desiredCACert != ptr.Deref(current.CACertificate, "") {
 		return true
 	}
 	if desiredCredHash != "" && desiredCredHash != cr.Status.CredentialHash {
@@ -204,6 +225,22 @@ func registryNeedsUpdate(cr harborv1alpha1.Registry, current harborclient.Regist
 	return false
 }
 
+// registryState keeps the fields of a registry that registryNeedsUpdate
+// compares. The CA certificate is only compared when the spec sets one.
+func registryState(in harborclient.Registry, withCACert bool) harborclient.Registry {
+	out := harborclient.Registry{
+		Name:        in.Name,
+		URL:         in.URL,
+		Description: in.Description,
+		Type:        strings.ToLower(in.Type),
+		Insecure:    in.Insecure,
+	}
+	if withCACert {
+		out.CACertificate = in.CACertificate
+	}
+	return out
+}
+
 func (r *RegistryReconciler) buildRegistryCredential(ctx context.Context, cr *harborv1alpha1.Registry) (*harborclient.RegistryCredential, string, string, error) {
 	var caCert string
 	if cr.Spec.CACertificateRef != nil {
@@ -263,10 +300,21 @@ func (r *RegistryReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.Registry).Spec.HarborConnectionRef
 		},
+		registrySecretKeys,
 		"registry",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("Registry", r))
+}
+
+// registrySecretKeys returns the Secrets a registry reads.
+func registrySecretKeys(obj client.Object) []string {
+	registry := obj.(*harborv1alpha1.Registry)
+	refs := []*harborv1alpha1.SecretReference{registry.Spec.CACertificateRef}
+	if cred := registry.Spec.Credential; cred != nil {
+		refs = append(refs, &cred.AccessKeySecretRef, &cred.AccessSecretSecretRef)
+	}
+	return secretRefKeys(registry.Namespace, refs...)
 }
@@ -10,6 +10,8 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -20,9 +22,10 @@ import (
 
 type ReplicationPolicyReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=replicationpolicies,verbs=get;list;watch;create;update;patch;delete
@@ -46,19 +49,20 @@ func (r *ReplicationPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, err
 	}
 
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), true, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if done, err := finalizeIfDeleting(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), func() error {
 		if cr.Status.HarborReplicationPolicyID == 0 {
 			return nil
 		}
-		return hc.DeleteReplicationPolicy(ctx, cr.Status.HarborReplicationPolicyID)
+		err := hc.DeleteReplicationPolicy(ctx, cr.Status.HarborReplicationPolicyID)
+		return recordDeleted(r.Recorder, &cr, err, "Deleted Harbor replication policy %d", cr.Status.HarborReplicationPolicyID)
 	}); done {
 		return ctrl.Result{}, err
 	}
@@ -69,57 +73,59 @@ func (r *ReplicationPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Re
 
 	srcID, err := resolveRegistryID(ctx, r.Options, r.Client, cr.Namespace, cr.Spec.SourceRegistryRef)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 	destID, err := resolveRegistryID(ctx, r.Options, r.Client, cr.Namespace, cr.Spec.DestinationRegistryRef)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	filters, err := replicationFiltersFromSpec(cr.Spec.Filters)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 	policy := harborclient.ReplicationPolicy{
-		Name:                      cr.Name,
+		Name:                      cr.HarborName(),
 		Description:               cr.Spec.Description,
-		SrcRegistry:               &harborclient.Registry{ID: srcID},
-		DestRegistry:              &harborclient.Registry{ID: destID},
+		SrcRegistry:               harborclient.Registry{ID: srcID},
+		DestRegistry:              harborclient.Registry{ID: destID},
 		DestNamespace:             cr.Spec.DestNamespace,
 		DestNamespaceReplaceCount: cr.Spec.DestNamespaceReplaceCount,
 		Trigger:                   replicationTriggerFromSpec(cr.Spec.Trigger),
 		Filters:                   filters,
-		ReplicateDeletion:         cr.Spec.ReplicateDeletion,
-		Override:                  cr.Spec.Override,
-		Enabled:                   cr.Spec.Enabled,
+		ReplicateDeletion:         ptr.Deref(cr.Spec.ReplicateDeletion, false),
+		Override:                  ptr.Deref(cr.Spec.Override, false),
+		Enabled:                   ptr.Deref(cr.Spec.Enabled, false),
 		Speed:                     cr.Spec.Speed,
 		CopyByChunk:               cr.Spec.CopyByChunk,
 		SingleActiveReplication:   cr.Spec.SingleActiveReplication,
 	}
 
-	if cr.Status.HarborReplicationPolicyID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy) {
+	if cr.Status.HarborReplicationPolicyID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy) {
 		adopted, err := r.adoptExisting(ctx, hc, &cr)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		if adopted {
 			r.logger.Info("Adopted existing replication policy", "ID", cr.Status.HarborReplicationPolicyID)
+			recordEvent(r.Recorder, &cr, ReasonAdopted, actionAdopt, "Adopted existing Harbor replication policy %d", cr.Status.HarborReplicationPolicyID)
 			return ctrl.Result{Requeue: true}, nil
 		}
 	}
 
 	if cr.Status.HarborReplicationPolicyID == 0 {
-		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy); err != nil {
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		id, err := hc.CreateReplicationPolicy(ctx, policy)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.HarborReplicationPolicyID = id
-		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Created", "Replication policy created"); err != nil {
+		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonCreated, "Replication policy created"); err != nil {
 			return ctrl.Result{}, err
 		}
+		recordEvent(r.Recorder, &cr, ReasonCreated, actionCreate, "Created Harbor replication policy %d", id)
 		return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
 	}
 
@@ -130,29 +136,37 @@ func (r *ReplicationPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Re
 				cr.Status.HarborReplicationPolicyID = 0
 			}, "Replication policy not found in Harbor")
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if replicationPolicyNeedsUpdate(policy, current) {
+		if err := recordDrift(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "ReplicationPolicy",
+			normalizeReplicationPolicy(policy), normalizeReplicationPolicy(*current)); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !cr.Spec.ManagementPolicy.AllowsWrites() {
+			return reportObservedDrift(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Replication policy")
+		}
 		if err := hc.UpdateReplicationPolicy(ctx, cr.Status.HarborReplicationPolicyID, policy); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		r.logger.Info("Updated replication policy", "ID", cr.Status.HarborReplicationPolicyID)
+		recordUpdate(r.Recorder, &cr, &cr.Status.HarborStatusBase, "Updated Harbor replication policy %d", cr.Status.HarborReplicationPolicyID)
 	}
 
-	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Reconciled", "Replication policy reconciled"); err != nil {
+	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "Replication policy reconciled"); err != nil {
 		return ctrl.Result{}, err
 	}
 	return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
 }
 
 func (r *ReplicationPolicyReconciler) adoptExisting(ctx context.Context, hc *harborclient.Client, cr *harborv1alpha1.ReplicationPolicy) (bool, error) {
-	policies, err := hc.ListReplicationPolicies(ctx, cr.Name)
+	policies, err := hc.ListReplicationPolicies(ctx, cr.HarborName())
 	if err != nil {
 		return false, err
 	}
 	for _, p := range policies {
-		if strings.EqualFold(p.Name, cr.Name) {
+		if strings.EqualFold(p.Name, cr.HarborName()) {
 			cr.Status.HarborReplicationPolicyID = p.ID
 			return true, r.Status().Update(ctx, cr)
 		}
@@ -169,21 +183,22 @@ func (r *ReplicationPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.ReplicationPolicy).Spec.HarborConnectionRef
 		},
+		nil,
 		"replicationpolicy",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("ReplicationPolicy", r))
 }
 
-func replicationTriggerFromSpec(in *harborv1alpha1.ReplicationTriggerSpec) *harborclient.ReplicationTrigger {
+func replicationTriggerFromSpec(in *harborv1alpha1.ReplicationTriggerSpec) harborclient.ReplicationTrigger {
 	if in == nil {
-		return nil
+		return harborclient.ReplicationTrigger{}
 	}
-	trigger := &harborclient.ReplicationTrigger{Type: in.Type}
+	trigger := harborclient.ReplicationTrigger{Type: in.Type}
 	if in.Settings != nil {
-		trigger.TriggerSettings = &harborclient.ReplicationTriggerSettings{Cron: in.Settings.Cron}
+		trigger.TriggerSettings = harborclient.ReplicationTriggerSettings{Cron: in.Settings.Cron}
 	}
 	return trigger
 }
@@ -222,12 +237,11 @@ func normalizeReplicationPolicy(in harborclient.ReplicationPolicy) harborclient.
 	in.ID = 0
 	in.CreationTime = ""
 	in.UpdateTime = ""
-	if in.SrcRegistry != nil {
-		in.SrcRegistry = &harborclient.Registry{ID: in.SrcRegistry.ID}
-	}
-	if in.DestRegistry != nil {
-		in.DestRegistry = &harborclient.Registry{ID: in.DestRegistry.ID}
-	}
+	// Deletion is the deprecated alias of ReplicateDeletion that Harbor
+	// still echoes back.
+	in.Deletion = false
+	in.SrcRegistry = harborclient.Registry{ID: in.SrcRegistry.ID}
+	in.DestRegistry = harborclient.Registry{ID: in.DestRegistry.ID}
 	for i := range in.Filters {
 		in.Filters[i].Value = normalizeAny(in.Filters[i].Value)
 	}
@@ -12,6 +12,8 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -22,9 +24,10 @@ import (
 
 type RetentionPolicyReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=retentionpolicies,verbs=get;list;watch;create;update;patch;delete
@@ -47,22 +50,23 @@ func (r *RetentionPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, err
 	}
 
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), true, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if done, err := finalizeIfDeleting(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), func() error {
 		if cr.Status.HarborRetentionID == 0 {
 			return nil
 		}
-		return hc.DeleteRetention(ctx, cr.Status.HarborRetentionID)
+		err := hc.DeleteRetention(ctx, cr.Status.HarborRetentionID)
+		return recordDeleted(r.Recorder, &cr, err, "Deleted Harbor retention policy %d", cr.Status.HarborRetentionID)
 	}); done {
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		return ctrl.Result{}, nil
 	}
@@ -72,24 +76,40 @@ func (r *RetentionPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	if cr.Spec.Trigger == nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("spec.trigger is required"))
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("spec.trigger is required"))
 	}
 	if cr.Spec.Trigger.Kind == "Schedule" {
 		if cron, ok := cr.Spec.Trigger.Settings["cron"]; !ok || len(cron.Raw) == 0 {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("spec.trigger.settings.cron is required for Schedule trigger"))
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("spec.trigger.settings.cron is required for Schedule trigger"))
 		}
 	}
 
 	scope, err := resolveRetentionScope(ctx, r.Options, r.Client, &cr)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 	crWithScope := cr
 	crWithScope.Spec.Scope = scope
 
 	policy, err := toRetentionPolicy(crWithScope)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+	}
+
+	if cr.Status.HarborRetentionID == 0 && !cr.Spec.ManagementPolicy.AllowsWrites() {
+		// Retention policies have no name to adopt by; the project records
+		// the policy bound to it.
+		existingID, err := r.findExistingRetentionID(ctx, hc, crWithScope.Spec.Scope)
+		if err != nil {
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		}
+		cr.Status.HarborRetentionID = existingID
+		if err := r.Status().Update(ctx, &cr); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.logger.Info("Adopted existing retention policy", "ID", existingID)
+		recordEvent(r.Recorder, &cr, ReasonAdopted, actionAdopt, "Adopted existing Harbor retention policy %d", existingID)
+		return ctrl.Result{Requeue: true}, nil
 	}
 
 	if cr.Status.HarborRetentionID == 0 {
@@ -98,21 +118,23 @@ func (r *RetentionPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			if isRetentionAlreadyBound(err) {
 				existingID, lookupErr := r.findExistingRetentionID(ctx, hc, crWithScope.Spec.Scope)
 				if lookupErr != nil {
-					return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, lookupErr)
+					return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, lookupErr)
 				}
 				cr.Status.HarborRetentionID = existingID
 				if err := r.Status().Update(ctx, &cr); err != nil {
 					return ctrl.Result{}, err
 				}
 				r.logger.Info("Adopted existing retention policy", "ID", existingID)
+				recordEvent(r.Recorder, &cr, ReasonAdopted, actionAdopt, "Adopted existing Harbor retention policy %d", existingID)
 				return ctrl.Result{Requeue: true}, nil
 			}
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.HarborRetentionID = newID
-		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Created", "Retention policy created"); err != nil {
+		if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonCreated, "Retention policy created"); err != nil {
 			return ctrl.Result{}, err
 		}
+		recordEvent(r.Recorder, &cr, ReasonCreated, actionCreate, "Created Harbor retention policy %d", newID)
 		return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
 	}
 
@@ -123,17 +145,25 @@ func (r *RetentionPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 				cr.Status.HarborRetentionID = 0
 			}, "Retention policy not found in Harbor")
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if retentionNeedsUpdate(policy, current) {
+		if err := recordDrift(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "RetentionPolicy",
+			normalizeRetentionPolicy(policy), normalizeRetentionPolicy(*current)); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !cr.Spec.ManagementPolicy.AllowsWrites() {
+			return reportObservedDrift(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Retention policy")
+		}
 		if err := hc.UpdateRetention(ctx, cr.Status.HarborRetentionID, policy); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		r.logger.Info("Updated retention policy", "ID", cr.Status.HarborRetentionID)
+		recordUpdate(r.Recorder, &cr, &cr.Status.HarborStatusBase, "Updated Harbor retention policy %d", cr.Status.HarborRetentionID)
 	}
 
-	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, "Reconciled", "Retention policy reconciled"); err != nil {
+	if err := setReadyStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "Retention policy reconciled"); err != nil {
 		return ctrl.Result{}, err
 	}
 	return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
@@ -169,30 +199,35 @@ func toRetentionPolicy(cr harborv1alpha1.RetentionPolicy) (harborclient.Retentio
 	}, nil
 }
 
-func toRetentionTrigger(trigger *harborv1alpha1.RetentionTrigger) (*harborclient.RetentionTrigger, error) {
+func toRetentionTrigger(trigger *harborv1alpha1.RetentionTrigger) (harborclient.RetentionTrigger, error) {
 	if trigger == nil {
-		return nil, nil
+		return harborclient.RetentionTrigger{}, nil
 	}
 	settings, err := jsonMapToAny(trigger.Settings)
 	if err != nil {
-		return nil, fmt.Errorf("invalid trigger settings: %w", err)
+		return harborclient.RetentionTrigger{}, fmt.Errorf("invalid trigger settings: %w", err)
 	}
 	references, err := jsonMapToAny(trigger.References)
 	if err != nil {
-		return nil, fmt.Errorf("invalid trigger references: %w", err)
+		return harborclient.RetentionTrigger{}, fmt.Errorf("invalid trigger references: %w", err)
 	}
-	return &harborclient.RetentionTrigger{
-		Kind:       trigger.Kind,
-		Settings:   settings,
-		References: references,
-	}, nil
+	out := harborclient.RetentionTrigger{Kind: trigger.Kind}
+	// Settings and References are untyped in the Harbor API; leave them nil
+	// rather than wrapping a nil map so they are omitted from the request.
+	if settings != nil {
+		out.Settings = settings
+	}
+	if references != nil {
+		out.References = references
+	}
+	return out, nil
 }
 
-func toRetentionScope(scope *harborv1alpha1.RetentionScope) *harborclient.RetentionScope {
+func toRetentionScope(scope *harborv1alpha1.RetentionScope) harborclient.RetentionScope {
 	if scope == nil {
-		return nil
+		return harborclient.RetentionScope{}
 	}
-	return &harborclient.RetentionScope{
+	return harborclient.RetentionScope{
 		Level: scope.Level,
 		Ref:   scope.Ref,
 	}
@@ -268,7 +303,7 @@ func normalizeRetentionPolicy(in harborclient.RetentionPolicy) harborclient.Rete
 
 func normalizeRetentionRule(in harborclient.RetentionRule) harborclient.RetentionRule {
 	in.ID = 0
-	in.Params = normalizeRetentionParamsAny(in.Params)
+	in.Params = normalizeRetentionParamsMap(in.Params)
 	return in
 }
 
@@ -289,17 +324,6 @@ func normalizeRetentionParamsMap(in map[string]any) map[string]any {
 	return out
 }
 
-func normalizeRetentionParamsAny(in any) any {
-	if in == nil {
-		return nil
-	}
-	m, ok := in.(map[string]any)
-	if !ok {
-		return in
-	}
-	return normalizeRetentionParamsMap(m)
-}
-
 func (r *RetentionPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	builder, err := setupHarborBackedController(
 		mgr,
@@ -309,12 +333,13 @@ func (r *RetentionPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.RetentionPolicy).Spec.HarborConnectionRef
 		},
+		nil,
 		"retentionpolicy",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("RetentionPolicy", r))
 }
 
 func (r *RetentionPolicyReconciler) findExistingRetentionID(ctx context.Context, hc *harborclient.Client, scope *harborv1alpha1.RetentionScope) (int, error) {
@@ -325,12 +350,13 @@ func (r *RetentionPolicyReconciler) findExistingRetentionID(ctx context.Context,
 	if err != nil {
 		return 0, err
 	}
-	if project.Metadata.RetentionID == "" {
+	retentionID := ptr.Deref(project.Metadata.RetentionID, "")
+	if retentionID == "" {
 		return 0, fmt.Errorf("project %d has no retention_id metadata", scope.Ref)
 	}
-	id, err := strconv.Atoi(project.Metadata.RetentionID)
+	id, err := strconv.Atoi(retentionID)
 	if err != nil {
-		return 0, fmt.Errorf("parse retention_id %q: %w", project.Metadata.RetentionID, err)
+		return 0, fmt.Errorf("parse retention_id %q: %w", retentionID, err)
 	}
 	return id, nil
 }
@@ -2,7 +2,10 @@ package controller
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"sort"
 	"strings"
 	"time"
@@ -13,25 +16,34 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
 	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+	"github.com/rkthtrifork/harbor-operator/internal/metrics"
 )
 
 type RobotReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=robots,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=robots/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=robots/finalizers,verbs=update
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets;daemonsets,verbs=get;list;patch
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=harborconnections;clusterharborconnections,verbs=get;list;watch
 
 func (r *RobotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -41,6 +53,7 @@ func (r *RobotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	if found, err := loadResource(ctx, r.Client, req.NamespacedName, &cr, r.logger); err != nil {
 		return ctrl.Result{}, err
 	} else if !found {
+		metrics.DeleteRobotSecretExpiring(req.Namespace, req.Name)
 		return ctrl.Result{}, nil
 	}
 
@@ -48,12 +61,21 @@ func (r *RobotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return ctrl.Result{}, err
 	}
 
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	if !cr.DeletionTimestamp.IsZero() {
+		// Nothing keeps the copies in sync once the Robot is gone, whatever
+		// happens to the Harbor robot.
+		if err := removeRobotSecretCopies(ctx, r.Client, &cr, nil); err != nil {
+			return ctrl.Result{}, err
+		}
+		metrics.DeleteRobotSecretExpiring(cr.Namespace, cr.Name)
+	}
+
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), true, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if done, err := finalizeIfDeleting(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), func() error {
@@ -67,30 +89,35 @@ func (r *RobotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	}
 
 	if err := validateRobotSpec(&cr); err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	secretRef, err := resolveRobotSecretRef(&cr)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+	}
+	registry, err := registryHost(hc.BaseURL)
+	if err != nil {
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
-	if cr.Status.HarborRobotID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy) {
+	if cr.Status.HarborRobotID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy) {
 		if ok, err := r.adoptExisting(ctx, hc, &cr); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		} else if ok {
 			r.logger.Info("Adopted robot", "ID", cr.Status.HarborRobotID)
+			recordEvent(r.Recorder, &cr, ReasonAdopted, actionAdopt, "Adopted existing Harbor robot %d", cr.Status.HarborRobotID)
 		}
 	}
 
 	if cr.Status.HarborRobotID == 0 {
-		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy); err != nil {
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
-		return r.createRobot(ctx, hc, &cr, secretRef)
+		return r.createRobot(ctx, hc, &cr, secretRef, registry)
 	}
 
-	return r.reconcileExisting(ctx, hc, &cr, secretRef)
+	return r.reconcileExisting(ctx, hc, &cr, secretRef, registry)
 }
 
 func (r *RobotReconciler) createRobot(
@@ -98,14 +125,15 @@ func (r *RobotReconciler) createRobot(
 	hc *harborclient.Client,
 	cr *harborv1alpha1.Robot,
 	secretRef harborv1alpha1.SecretReference,
+	registry string,
 ) (ctrl.Result, error) {
 	createReq, err := r.buildRobotCreateRequest(ctx, cr)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 	created, err := hc.CreateRobot(ctx, createReq)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 	cr.Status.HarborRobotID = created.ID
 	cr.Status.Username = created.Name
@@ -113,19 +141,30 @@ func (r *RobotReconciler) createRobot(
 	if storedSecret == "" {
 		storedSecret, err = rotateRobotSecret(ctx, hc, cr.Status.HarborRobotID)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 	}
-	if err := upsertRobotSecret(ctx, r.Client, cr, secretRef, created.Name, storedSecret); err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+	if err := upsertRobotSecret(ctx, r.Client, cr, secretRef, registry, created.Name, storedSecret); err != nil {
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+	}
+	if _, err := r.distributeRobotSecret(ctx, cr, secretRef, registry, created.Name, storedSecret); err != nil {
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+	}
+	_, restartAfter, err := r.restartRobotWorkloads(ctx, cr, storedSecret)
+	if err != nil {
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 	now := metav1.Now()
 	cr.Status.LastRotatedAt = &now
-	if err := setReadyStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, "Created", "Robot created"); err != nil {
+	setRobotNextRotationStatus(cr)
+	if err := setReadyStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonCreated, "Robot created"); err != nil {
 		return ctrl.Result{}, err
 	}
 	r.logger.Info("Created robot", "ID", created.ID)
-	return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
+	recordEvent(r.Recorder, cr, ReasonCreated, actionCreate, "Created Harbor robot %s (%d)", created.Name, created.ID)
+	metrics.SetRobotSecretExpiring(cr.Namespace, cr.Name, false)
+	result, err := requeueForRobotRotation(r.Options, cr)
+	return requeueForRobotRestart(result, restartAfter), err
 }
 
 func (r *RobotReconciler) reconcileExisting(
@@ -133,6 +172,7 @@ func (r *RobotReconciler) reconcileExisting(
 	hc *harborclient.Client,
 	cr *harborv1alpha1.Robot,
 	secretRef harborv1alpha1.SecretReference,
+	registry string,
 ) (ctrl.Result, error) {
 	current, err := hc.GetRobotByID(ctx, cr.Status.HarborRobotID)
 	if err != nil {
@@ -141,41 +181,51 @@ func (r *RobotReconciler) reconcileExisting(
 				cr.Status.HarborRobotID = 0
 			}, "Robot not found in Harbor")
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if !robotLevelMatches(cr.Spec.Level, current.Level) {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("robot level mismatch: desired %q, current %q", cr.Spec.Level, current.Level))
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("robot level mismatch: desired %q, current %q", cr.Spec.Level, current.Level))
 	}
 	if current.Name == "" {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("harbor returned an empty robot username"))
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("harbor returned an empty robot username"))
 	}
 	statusChanged := setRobotUsernameStatus(cr, current.Name)
-	if err := syncRobotUsernameSecret(ctx, r.Client, cr, secretRef, current.Name); err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+	token, err := syncRobotSecret(ctx, r.Client, cr, secretRef, registry, current.Name)
+	if err != nil {
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	desired, err := r.buildRobotUpdateRequest(ctx, cr, current)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 	if robotNeedsUpdate(desired, current) {
+		if err := recordDrift(ctx, r.Client, cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "Robot",
+			robotState(desired), robotState(*current)); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !cr.Spec.ManagementPolicy.AllowsWrites() {
+			return reportObservedDrift(ctx, r.Options, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Robot")
+		}
 		if err := hc.UpdateRobot(ctx, current.ID, desired); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		r.logger.Info("Updated robot", "ID", current.ID)
+		recordUpdate(r.Recorder, cr, &cr.Status.HarborStatusBase, "Updated Harbor robot %d", current.ID)
 	}
 
 	statusChanged = updateRobotExpiryStatus(cr, current.ExpiresAt) || statusChanged
 
-	if shouldRotateRobot(cr) {
+	if shouldRotateRobot(cr) && cr.Spec.ManagementPolicy.AllowsWrites() {
 		rotatedSecret, err := rotateRobotSecret(ctx, hc, current.ID)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
-		if err := upsertRobotSecret(ctx, r.Client, cr, secretRef, current.Name, rotatedSecret); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		if err := upsertRobotSecret(ctx, r.Client, cr, secretRef, registry, current.Name, rotatedSecret); err != nil {
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
+		token = rotatedSecret
 		now := metav1.Now()
 		cr.Status.LastRotatedAt = &now
 		refreshed, err := hc.GetRobotByID(ctx, current.ID)
@@ -184,41 +234,124 @@ func (r *RobotReconciler) reconcileExisting(
 		}
 		statusChanged = true
 		r.logger.Info("Rotated robot secret", "ID", current.ID)
+		recordEvent(r.Recorder, cr, ReasonSecretRotated, actionRotate, "Rotated the secret of Harbor robot %d into Secret %s/%s", current.ID, secretRef.Namespace, secretRef.Name)
 	}
 
-	condChanged := markReady(&cr.Status.HarborStatusBase, cr.Generation, "Reconciled", "Robot reconciled")
+	distributionChanged, err := r.distributeRobotSecret(ctx, cr, secretRef, registry, current.Name, token)
+	if err != nil {
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+	}
+	statusChanged = distributionChanged || statusChanged
+	restarted, restartAfter, err := r.restartRobotWorkloads(ctx, cr, token)
+	if err != nil {
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+	}
+	statusChanged = restarted || statusChanged
+	statusChanged = setRobotNextRotationStatus(cr) || statusChanged
+	metrics.SetRobotSecretExpiring(cr.Namespace, cr.Name, robotSecretExpiring(cr, time.Now()))
+
+	condChanged := markReady(&cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "Robot reconciled")
 	if statusChanged || condChanged {
 		if err := r.Status().Update(ctx, cr); err != nil {
 			return ctrl.Result{}, err
 		}
 	}
 
-	return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
+	result, err := requeueForRobotRotation(r.Options, cr)
+	return requeueForRobotRestart(result, restartAfter), err
+}
+
+func upsertRobotSecret(ctx context.Context, c client.Client, cr *harborv1alpha1.Robot, ref harborv1alpha1.SecretReference, registry, username, token string) error {
+	return writeRobotSecret(ctx, c, cr, ref, cr.Spec.SecretFormat, nil, registry, username, token)
 }
 
-func upsertRobotSecret(ctx context.Context, c client.Client, cr *harborv1alpha1.Robot, ref harborv1alpha1.SecretReference, username, token string) error {
+func writeRobotSecret(
+	ctx context.Context,
+	c client.Client,
+	cr *harborv1alpha1.Robot,
+	ref harborv1alpha1.SecretReference,
+	format harborv1alpha1.RobotSecretFormat,
+	labels map[string]string,
+	registry, username, token string,
+) error {
 	if username == "" {
 		return fmt.Errorf("harbor returned an empty robot username")
 	}
-	return upsertOwnedSecretValues(ctx, c, cr, "Robot", ref.Namespace, ref.Name, map[string]string{
+	values := map[string]string{
 		ref.Key:    token,
 		"username": username,
-	})
+	}
+	secretType := corev1.SecretTypeOpaque
+	if format == harborv1alpha1.RobotSecretFormatDockerConfigJSON {
+		config, err := robotDockerConfigJSON(registry, username, token)
+		if err != nil {
+			return err
+		}
+		values[corev1.DockerConfigJsonKey] = config
+		secretType = corev1.SecretTypeDockerConfigJson
+	}
+	return upsertOwnedSecretValues(ctx, c, cr, "Robot", ref.Namespace, ref.Name, secretType, labels, values)
 }
 
-func syncRobotUsernameSecret(ctx context.Context, c client.Client, cr *harborv1alpha1.Robot, ref harborv1alpha1.SecretReference, username string) error {
+// syncRobotSecret brings an existing managed Secret in line with the Harbor
+// username, the registry host, and spec.secretFormat without rotating the
+// robot secret it holds. It returns that robot secret, if any.
+func syncRobotSecret(ctx context.Context, c client.Client, cr *harborv1alpha1.Robot, ref harborv1alpha1.SecretReference, registry, username string) (string, error) {
 	var secret corev1.Secret
 	err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, &secret)
 	if errors.IsNotFound(err) {
-		return nil
+		return "", nil
 	}
 	if err != nil {
-		return err
+		return "", err
 	}
 	if !secretOwnedBy(&secret, cr, "Robot") {
-		return fmt.Errorf("secret %s/%s already exists and is not managed by Robot %s/%s", ref.Namespace, ref.Name, cr.Namespace, cr.Name)
+		return "", fmt.Errorf("secret %s/%s already exists and is not managed by Robot %s/%s", ref.Namespace, ref.Name, cr.Namespace, cr.Name)
 	}
-	return upsertOwnedSecretValues(ctx, c, cr, "Robot", ref.Namespace, ref.Name, map[string]string{"username": username})
+	token := string(secret.Data[ref.Key])
+	if token == "" {
+		return "", upsertOwnedSecretValues(ctx, c, cr, "Robot", ref.Namespace, ref.Name, secret.Type, nil, map[string]string{"username": username})
+	}
+	return token, upsertRobotSecret(ctx, c, cr, ref, registry, username, token)
+}
+
+// robotDockerConfigJSON renders the .dockerconfigjson value that lets the
+// robot pull from registry.
+func robotDockerConfigJSON(registry, username, token string) (string, error) {
+	type dockerAuth struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		Auth     string `json:"auth"`
+	}
+	config := struct {
+		Auths map[string]dockerAuth `json:"auths"`
+	}{
+		Auths: map[string]dockerAuth{
+			registry: {
+				Username: username,
+				Password: token,
+				Auth:     base64.StdEncoding.EncodeToString([]byte(username + ":" + token)),
+			},
+		},
+	}
+	out, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("encode docker config for registry %s: %w", registry, err)
+	}
+	return string(out), nil
+}
+
+// registryHost returns the host of the Harbor base URL, which is also the host
+// that images are pulled from.
+func registryHost(baseURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("parse Harbor base URL %q: %w", baseURL, err)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("harbor base URL %q has no host", baseURL)
+	}
+	return u.Host, nil
 }
 
 func setRobotUsernameStatus(cr *harborv1alpha1.Robot, username string) bool {
@@ -232,11 +365,12 @@ func (r *RobotReconciler) deleteRobot(ctx context.Context, hc *harborclient.Clie
 	if cr.Status.HarborRobotID == 0 {
 		return nil
 	}
-	return hc.DeleteRobot(ctx, cr.Status.HarborRobotID)
+	err := hc.DeleteRobot(ctx, cr.Status.HarborRobotID)
+	return recordDeleted(r.Recorder, cr, err, "Deleted Harbor robot %d", cr.Status.HarborRobotID)
 }
 
 func (r *RobotReconciler) adoptExisting(ctx context.Context, hc *harborclient.Client, cr *harborv1alpha1.Robot) (bool, error) {
-	query := "name=" + cr.Name
+	query := "name=" + cr.HarborName()
 	robots, err := hc.ListRobots(ctx, query)
 	if err != nil {
 		return false, err
@@ -254,7 +388,7 @@ func (r *RobotReconciler) adoptExisting(ctx context.Context, hc *harborclient.Cl
 
 func (r *RobotReconciler) findAndAdoptRobot(ctx context.Context, cr *harborv1alpha1.Robot, robots []harborclient.Robot) (bool, error) {
 	for _, robot := range robots {
-		if robotNameMatches(cr.Name, robot.Name) && robotLevelMatches(cr.Spec.Level, robot.Level) {
+		if robotNameMatches(cr.HarborName(), robot.Name) && robotLevelMatches(cr.Spec.Level, robot.Level) {
 			cr.Status.HarborRobotID = robot.ID
 			return true, r.Status().Update(ctx, cr)
 		}
@@ -272,6 +406,9 @@ func validateRobotSpec(cr *harborv1alpha1.Robot) error {
 	if cr.Spec.Duration == 0 {
 		return fmt.Errorf("spec.duration must be either -1 or a positive integer")
 	}
+	if errs := validateRobotRotation(cr); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
 	return nil
 }
 
@@ -296,6 +433,9 @@ func resolveRobotSecretRef(cr *harborv1alpha1.Robot) (harborv1alpha1.SecretRefer
 	if ref.Key == "username" {
 		return harborv1alpha1.SecretReference{}, fmt.Errorf("spec.secretRef.key %q is reserved for the canonical Harbor username", ref.Key)
 	}
+	if ref.Key == corev1.DockerConfigJsonKey {
+		return harborv1alpha1.SecretReference{}, fmt.Errorf("spec.secretRef.key %q is reserved for the image pull configuration", ref.Key)
+	}
 	return ref, nil
 }
 
@@ -305,12 +445,12 @@ func (r *RobotReconciler) buildRobotCreateRequest(ctx context.Context, cr *harbo
 		return harborclient.RobotCreateRequest{}, err
 	}
 	return harborclient.RobotCreateRequest{
-		Name:        cr.Name,
+		Name:        cr.HarborName(),
 		Description: cr.Spec.Description,
 		Secret:      "",
 		Level:       cr.Spec.Level,
-		Disable:     cr.Spec.Disable,
-		Duration:    &cr.Spec.Duration,
+		Disable:     ptr.Deref(cr.Spec.Disable, false),
+		Duration:    cr.Spec.Duration,
 		Permissions: permissions,
 	}, nil
 }
@@ -353,6 +493,16 @@ func robotNeedsUpdate(desired harborclient.Robot, current *harborclient.Robot) b
 	return !robotPermissionsEqual(desired.Permissions, current.Permissions)
 }
 
+// robotState keeps the fields of a robot that robotNeedsUpdate compares.
+func robotState(in harborclient.Robot) harborclient.Robot {
+	return harborclient.Robot{
+		Description: in.Description,
+		Disable:     in.Disable,
+		Duration:    in.Duration,
+		Permissions: normalizeRobotPermissions(in.Permissions),
+	}
+}
+
 func robotPermissionsEqual(a, b []harborclient.RobotPermission) bool {
 	normA := normalizeRobotPermissions(a)
 	normB := normalizeRobotPermissions(b)
@@ -474,13 +624,6 @@ func intPtrEqual(a, b *int) bool {
 	return *a == *b
 }
 
-func shouldRotateRobot(cr *harborv1alpha1.Robot) bool {
-	if cr.Status.ExpiresAt == nil {
-		return false
-	}
-	return time.Now().After(cr.Status.ExpiresAt.Time)
-}
-
 func rotateRobotSecret(ctx context.Context, hc *harborclient.Client, robotID int) (string, error) {
 	sec, err := hc.RefreshRobotSecret(ctx, robotID, "")
 	if err != nil {
@@ -508,7 +651,7 @@ func updateRobotExpiryStatus(cr *harborv1alpha1.Robot, expiresAt int) bool {
 }
 
 func (r *RobotReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	builder, err := setupHarborBackedController(
+	b, err := setupHarborBackedController(
 		mgr,
 		r.Options,
 		&harborv1alpha1.Robot{},
@@ -516,10 +659,26 @@ func (r *RobotReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.Robot).Spec.HarborConnectionRef
 		},
+		nil,
 		"robot",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return b.
+		Watches(
+			&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, _ client.Object) []ctrl.Request {
+				return requestsForRobotDistribution(ctx, mgr.GetClient())
+			}),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(func(_ context.Context, object client.Object) []ctrl.Request {
+				return requestsForRobotSecretCopy(object)
+			}),
+			builder.OnlyMetadata,
+		).
+		Complete(traceReconciler("Robot", r))
 }
@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// distributedSecretLabelKey marks the copies written for spec.distribution, so
+// that copies in namespaces that are no longer selected can be found.
+const distributedSecretLabelKey = "harbor.harbor-operator.io/distributed"
+
+// distributeRobotSecret writes the robot credentials to every namespace
+// selected by spec.distribution, removes the copies that are no longer
+// selected, and records the result in status.distribution. A copy that cannot
+// be written is reported in status without failing the reconcile. Without a
+// token, such as for an observed robot whose Secret has none, existing copies
+// are kept and every namespace reports that there is nothing to distribute.
+func (r *RobotReconciler) distributeRobotSecret(
+	ctx context.Context,
+	cr *harborv1alpha1.Robot,
+	secretRef harborv1alpha1.SecretReference,
+	registry, username, token string,
+) (bool, error) {
+	desired := map[types.NamespacedName]bool{}
+	var entries []harborv1alpha1.RobotDistributionStatus
+	if dist := cr.Spec.Distribution; dist != nil {
+		var missing string
+		if token == "" {
+			missing = fmt.Sprintf("Secret %s/%s has no key %q to distribute", secretRef.Namespace, secretRef.Name, secretRef.Key)
+		}
+		selector, err := metav1.LabelSelectorAsSelector(&dist.NamespaceSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid spec.distribution.namespaceSelector: %w", err)
+		}
+		var namespaces corev1.NamespaceList
+		if err := r.List(ctx, &namespaces, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return false, err
+		}
+		for _, ns := range namespaces.Items {
+			if !ns.DeletionTimestamp.IsZero() {
+				continue
+			}
+			ref := harborv1alpha1.SecretReference{Namespace: ns.Name, Name: dist.SecretName, Key: "secret"}
+			desired[types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}] = true
+			entry := harborv1alpha1.RobotDistributionStatus{Namespace: ns.Name, Ready: true}
+			if missing != "" {
+				entry.Ready = false
+				entry.Message = missing
+			} else if err := r.writeRobotSecretCopy(ctx, cr, secretRef, ref, dist.SecretFormat, registry, username, token); err != nil {
+				entry.Ready = false
+				entry.Message = err.Error()
+			}
+			entries = append(entries, entry)
+		}
+	}
+	if err := removeRobotSecretCopies(ctx, r.Client, cr, desired); err != nil {
+		return false, err
+	}
+
+	slices.SortFunc(entries, func(a, b harborv1alpha1.RobotDistributionStatus) int {
+		return strings.Compare(a.Namespace, b.Namespace)
+	})
+	if slices.Equal(cr.Status.Distribution, entries) {
+		return false, nil
+	}
+	cr.Status.Distribution = entries
+	return true, nil
+}
+
+func (r *RobotReconciler) writeRobotSecretCopy(
+	ctx context.Context,
+	cr *harborv1alpha1.Robot,
+	secretRef, ref harborv1alpha1.SecretReference,
+	format harborv1alpha1.RobotSecretFormat,
+	registry, username, token string,
+) error {
+	if ref.Namespace == secretRef.Namespace && ref.Name == secretRef.Name {
+		return fmt.Errorf("secret %s/%s is already written for spec.secretRef", ref.Namespace, ref.Name)
+	}
+	if err := validateReferenceNamespace(r.Options, cr.Namespace, ref.Namespace, "Secret"); err != nil {
+		return err
+	}
+	return writeRobotSecret(ctx, r.Client, cr, ref, format, map[string]string{distributedSecretLabelKey: "true"}, registry, username, token)
+}
+
+// removeRobotSecretCopies deletes the copies written for cr that are not in
+// keep. A nil keep removes every copy.
+func removeRobotSecretCopies(ctx context.Context, c client.Client, cr *harborv1alpha1.Robot, keep map[types.NamespacedName]bool) error {
+	var secrets corev1.SecretList
+	if err := c.List(ctx, &secrets, client.MatchingLabels{managedByLabelKey: managedByLabelValue, distributedSecretLabelKey: "true"}); err != nil {
+		return err
+	}
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if !secretOwnedBy(secret, cr, "Robot") || keep[client.ObjectKeyFromObject(secret)] {
+			continue
+		}
+		if err := c.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// requestsForRobotDistribution returns the Robots that distribute their
+// credentials, for a Namespace whose labels changed.
+func requestsForRobotDistribution(ctx context.Context, c client.Client) []ctrl.Request {
+	var robots harborv1alpha1.RobotList
+	if err := c.List(ctx, &robots); err != nil {
+		ctrl.Log.WithName("robot-distribution-watch").Error(err, "Failed to list Robots")
+		return nil
+	}
+	requests := []ctrl.Request{}
+	for i := range robots.Items {
+		robot := &robots.Items[i]
+		if robot.Spec.Distribution == nil && len(robot.Status.Distribution) == 0 {
+			continue
+		}
+		requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(robot)})
+	}
+	return requests
+}
+
+// requestsForRobotSecretCopy returns the Robot that owns a copy written for
+// spec.distribution, so that a changed or deleted copy is written again.
+func requestsForRobotSecretCopy(object client.Object) []ctrl.Request {
+	if object.GetLabels()[distributedSecretLabelKey] != "true" {
+		return nil
+	}
+	annotations := object.GetAnnotations()
+	if annotations[ownerKindAnnotationKey] != "Robot" || annotations[ownerNameAnnotationKey] == "" {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: types.NamespacedName{
+		Namespace: annotations[ownerNamespaceAnnKey],
+		Name:      annotations[ownerNameAnnotationKey],
+	}}}
+}
@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRobotDistributesCredentialsBySelector(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	if err := harborv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	namespace := func(name string, labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	pull := map[string]string{"harbor-pull": "true"}
+	unmanaged := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "harbor-pull", Namespace: "b"},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       map[string][]byte{"secret": []byte("keep-me")},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(namespace("a", pull), namespace("b", pull), namespace("c", nil), unmanaged).
+		Build()
+	r := &RobotReconciler{Client: c, Scheme: scheme}
+	robot := &harborv1alpha1.Robot{
+		ObjectMeta: metav1.ObjectMeta{Name: "puller", Namespace: "platform"},
+		Spec: harborv1alpha1.RobotSpec{Distribution: &harborv1alpha1.RobotSecretDistribution{
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: pull},
+			SecretName:        "harbor-pull",
+			SecretFormat:      harborv1alpha1.RobotSecretFormatDockerConfigJSON,
+		}},
+	}
+	secretRef := harborv1alpha1.SecretReference{Namespace: "platform", Name: "puller-secret", Key: "secret"}
+
+	distribute := func(token string) {
+		t.Helper()
+		if _, err := r.distributeRobotSecret(ctx, robot, secretRef, "harbor.example.com", "robot$puller", token); err != nil {
+			t.Fatalf("distributeRobotSecret returned error: %v", err)
+		}
+	}
+	expectCopy := func(namespace, token string) {
+		t.Helper()
+		var secret corev1.Secret
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "harbor-pull"}, &secret); err != nil {
+			t.Fatalf("get copy in %s: %v", namespace, err)
+		}
+		if secret.Type != corev1.SecretTypeDockerConfigJson || string(secret.Data["secret"]) != token ||
+			!strings.Contains(string(secret.Data[corev1.DockerConfigJsonKey]), `"harbor.example.com"`) {
+			t.Fatalf("copy in %s = %s %v, want a pull secret holding %q", namespace, secret.Type, secret.Data, token)
+		}
+		if got := requestsForRobotSecretCopy(&secret); len(got) != 1 || got[0].NamespacedName != client.ObjectKeyFromObject(robot) {
+			t.Fatalf("requests for copy = %v, want the owning Robot", got)
+		}
+	}
+	expectNoCopy := func(namespace string) {
+		t.Helper()
+		var secret corev1.Secret
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "harbor-pull"}, &secret); !apierrors.IsNotFound(err) {
+			t.Fatalf("get copy in %s = %v, want it removed", namespace, err)
+		}
+	}
+
+	distribute("first")
+	expectCopy("a", "first")
+	if got := robot.Status.Distribution; len(got) != 2 || !got[0].Ready || got[0].Namespace != "a" ||
+		got[1].Ready || got[1].Namespace != "b" || !strings.Contains(got[1].Message, "not managed by Robot platform/puller") {
+		t.Fatalf("status.distribution = %+v, want a ready and b reporting the unmanaged Secret", got)
+	}
+
+	distribute("rotated")
+	expectCopy("a", "rotated")
+
+	var a corev1.Namespace
+	if err := c.Get(ctx, client.ObjectKey{Name: "a"}, &a); err != nil {
+		t.Fatalf("get namespace: %v", err)
+	}
+	a.Labels = nil
+	if err := c.Update(ctx, &a); err != nil {
+		t.Fatalf("update namespace: %v", err)
+	}
+	var ns corev1.Namespace
+	if err := c.Get(ctx, client.ObjectKey{Name: "c"}, &ns); err != nil {
+		t.Fatalf("get namespace: %v", err)
+	}
+	ns.Labels = pull
+	if err := c.Update(ctx, &ns); err != nil {
+		t.Fatalf("update namespace: %v", err)
+	}
+	distribute("rotated")
+	expectNoCopy("a")
+	expectCopy("c", "rotated")
+	if got := robot.Status.Distribution; len(got) != 2 || got[0].Namespace != "b" || got[1].Namespace != "c" {
+		t.Fatalf("status.distribution = %+v, want b and c", got)
+	}
+
+	// An observed robot whose Secret holds no token keeps the existing copies
+	// and reports the missing token instead of failing.
+	distribute("")
+	expectCopy("c", "rotated")
+	if got := robot.Status.Distribution; len(got) != 2 || got[1].Ready || !strings.Contains(got[1].Message, `has no key "secret"`) {
+		t.Fatalf("status.distribution = %+v, want c reporting the missing token", got)
+	}
+
+	if err := removeRobotSecretCopies(ctx, c, robot, nil); err != nil {
+		t.Fatalf("removeRobotSecretCopies returned error: %v", err)
+	}
+	expectNoCopy("c")
+	var kept corev1.Secret
+	if err := c.Get(ctx, client.ObjectKeyFromObject(unmanaged), &kept); err != nil || string(kept.Data["secret"]) != "keep-me" {
+		t.Fatalf("unmanaged Secret = %v (%v), want it left alone", kept.Data, err)
+	}
+}
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// robotSecretHashAnnotation is set on the pod template of the workloads in
+// spec.restartWorkloads. Changing it rolls the pods of the workload.
+const robotSecretHashAnnotation = "harbor.harbor-operator.io/robot-secret-hash"
+
+// minRobotRestartInterval is the least time between two restarts of the
+// workloads in spec.restartWorkloads, so that a robot secret that keeps
+// changing does not keep rolling them.
+const minRobotRestartInterval = 5 * time.Minute
+
+// restartRobotWorkloads rolls the workloads in spec.restartWorkloads when the
+// robot secret no longer matches status.secretHash. The first hash is only
+// recorded, so enabling the option does not restart anything. Within
+// minRobotRestartInterval of status.lastRestartedAt, the restart is deferred
+// and the time left is returned instead.
+func (r *RobotReconciler) restartRobotWorkloads(ctx context.Context, cr *harborv1alpha1.Robot, token string) (bool, time.Duration, error) {
+	if len(cr.Spec.RestartWorkloads) == 0 || token == "" {
+		return false, 0, nil
+	}
+	hash := hashSecret(token)
+	if cr.Status.SecretHash == hash {
+		return false, 0, nil
+	}
+	if cr.Status.SecretHash == "" {
+		cr.Status.SecretHash = hash
+		return true, 0, nil
+	}
+	if last := cr.Status.LastRestartedAt; last != nil {
+		if wait := minRobotRestartInterval - time.Since(last.Time); wait > 0 {
+			r.logger.Info("Deferring workload restart", "after", wait)
+			return false, wait, nil
+		}
+	}
+
+	var restarted []harborv1alpha1.RobotRestartedWorkload
+	for _, target := range cr.Spec.RestartWorkloads {
+		workloads, err := r.listRobotWorkloads(ctx, cr.Namespace, target)
+		if err != nil {
+			return false, 0, err
+		}
+		for _, workload := range workloads {
+			// Workloads already annotated with hash were restarted by an
+			// earlier attempt that failed on another workload.
+			if err := setPodTemplateAnnotation(ctx, r.Client, workload, robotSecretHashAnnotation, hash); err != nil {
+				return false, 0, fmt.Errorf("restart %s %s/%s: %w", target.Kind, workload.GetNamespace(), workload.GetName(), err)
+			}
+			restarted = append(restarted, harborv1alpha1.RobotRestartedWorkload{Kind: target.Kind, Name: workload.GetName()})
+		}
+	}
+	slices.SortFunc(restarted, func(a, b harborv1alpha1.RobotRestartedWorkload) int {
+		return cmp.Or(strings.Compare(string(a.Kind), string(b.Kind)), strings.Compare(a.Name, b.Name))
+	})
+	restarted = slices.Compact(restarted)
+
+	cr.Status.SecretHash = hash
+	cr.Status.RestartedWorkloads = restarted
+	cr.Status.LastRestartedAt = ptr.To(metav1.Now())
+	if len(restarted) > 0 {
+		names := make([]string, 0, len(restarted))
+		for _, workload := range restarted {
+			names = append(names, string(workload.Kind)+"/"+workload.Name)
+		}
+		recordEvent(r.Recorder, cr, ReasonWorkloadsRestarted, actionRestart, "Restarted %s after the robot secret changed", strings.Join(names, ", "))
+	}
+	return true, 0, nil
+}
+
+// requeueForRobotRestart requeues the robot by the end of a deferred restart
+// when that comes before result.
+func requeueForRobotRestart(result ctrl.Result, wait time.Duration) ctrl.Result {
+	if wait > 0 && (result.RequeueAfter == 0 || wait < result.RequeueAfter) {
+		result.RequeueAfter = wait
+	}
+	return result
+}
+
+// listRobotWorkloads reads past the manager cache, so that restarting
+// workloads does not start cluster-wide informers for every workload kind.
+func (r *RobotReconciler) listRobotWorkloads(ctx context.Context, namespace string, target harborv1alpha1.RobotWorkloadSelector) ([]client.Object, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&target.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s selector in spec.restartWorkloads: %w", target.Kind, err)
+	}
+	reader := r.Options.uncachedReader(r.Client)
+	opts := []client.ListOption{client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}}
+	var workloads []client.Object
+	switch target.Kind {
+	case harborv1alpha1.RobotWorkloadKindDeployment:
+		var list appsv1.DeploymentList
+		if err := reader.List(ctx, &list, opts...); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			workloads = append(workloads, &list.Items[i])
+		}
+	case harborv1alpha1.RobotWorkloadKindStatefulSet:
+		var list appsv1.StatefulSetList
+		if err := reader.List(ctx, &list, opts...); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			workloads = append(workloads, &list.Items[i])
+		}
+	case harborv1alpha1.RobotWorkloadKindDaemonSet:
+		var list appsv1.DaemonSetList
+		if err := reader.List(ctx, &list, opts...); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			workloads = append(workloads, &list.Items[i])
+		}
+	default:
+		return nil, fmt.Errorf("unsupported workload kind %q in spec.restartWorkloads", target.Kind)
+	}
+	return workloads, nil
+}
+
+// setPodTemplateAnnotation patches the pod template of a Deployment,
+// StatefulSet, or DaemonSet so that the annotation has value.
+func setPodTemplateAnnotation(ctx context.Context, c client.Client, workload client.Object, key, value string) error {
+	base, ok := workload.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("unexpected workload type %T", workload)
+	}
+	var template *corev1.PodTemplateSpec
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		template = &w.Spec.Template
+	case *appsv1.StatefulSet:
+		template = &w.Spec.Template
+	case *appsv1.DaemonSet:
+		template = &w.Spec.Template
+	default:
+		return fmt.Errorf("unexpected workload type %T", workload)
+	}
+	if template.Annotations[key] == value {
+		return nil
+	}
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[key] = value
+	return c.Patch(ctx, workload, client.MergeFrom(base))
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRobotRestartsWorkloadsWhenSecretChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	if err := harborv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	consumer := map[string]string{"harbor-robot": "ci"}
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "team", Labels: consumer}}
+	statefulSet := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "team", Labels: consumer}}
+	unrelated := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "team"}}
+	otherNamespace := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "other", Labels: consumer}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment, statefulSet, unrelated, otherNamespace).Build()
+	r := &RobotReconciler{Client: c, Scheme: scheme}
+	robot := &harborv1alpha1.Robot{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci", Namespace: "team"},
+		Spec: harborv1alpha1.RobotSpec{RestartWorkloads: []harborv1alpha1.RobotWorkloadSelector{
+			{Kind: harborv1alpha1.RobotWorkloadKindDeployment, Selector: metav1.LabelSelector{MatchLabels: consumer}},
+			{Kind: harborv1alpha1.RobotWorkloadKindStatefulSet, Selector: metav1.LabelSelector{MatchLabels: consumer}},
+		}},
+	}
+	templateHash := func(obj client.Object) string {
+		t.Helper()
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			t.Fatalf("get %s: %v", obj.GetName(), err)
+		}
+		switch w := obj.(type) {
+		case *appsv1.Deployment:
+			return w.Spec.Template.Annotations[robotSecretHashAnnotation]
+		case *appsv1.StatefulSet:
+			return w.Spec.Template.Annotations[robotSecretHashAnnotation]
+		}
+		return ""
+	}
+
+	if changed, _, err := r.restartRobotWorkloads(ctx, robot, "first"); err != nil || !changed {
+		t.Fatalf("restartRobotWorkloads = %v, %v; want the first hash recorded", changed, err)
+	}
+	if robot.Status.SecretHash != hashSecret("first") || len(robot.Status.RestartedWorkloads) != 0 || templateHash(deployment) != "" {
+		t.Fatalf("status = %+v, want the first secret recorded without restarting anything", robot.Status)
+	}
+	if changed, _, err := r.restartRobotWorkloads(ctx, robot, "first"); err != nil || changed {
+		t.Fatalf("restartRobotWorkloads with an unchanged secret = %v, %v; want nothing to do", changed, err)
+	}
+
+	if changed, _, err := r.restartRobotWorkloads(ctx, robot, "rotated"); err != nil || !changed {
+		t.Fatalf("restartRobotWorkloads after rotation = %v, %v", changed, err)
+	}
+	want := []harborv1alpha1.RobotRestartedWorkload{
+		{Kind: harborv1alpha1.RobotWorkloadKindDeployment, Name: "api"},
+		{Kind: harborv1alpha1.RobotWorkloadKindStatefulSet, Name: "db"},
+	}
+	if !slices.Equal(robot.Status.RestartedWorkloads, want) || robot.Status.LastRestartedAt == nil {
+		t.Fatalf("status.restartedWorkloads = %+v, want %+v", robot.Status.RestartedWorkloads, want)
+	}
+	rotated := hashSecret("rotated")
+	if templateHash(deployment) != rotated || templateHash(statefulSet) != rotated {
+		t.Fatal("consuming workloads were not annotated with the new secret hash")
+	}
+	if templateHash(unrelated) != "" || templateHash(otherNamespace) != "" {
+		t.Fatal("workloads outside the selector or namespace were restarted")
+	}
+
+	// Another change right after the restart waits for the minimum interval.
+	changed, wait, err := r.restartRobotWorkloads(ctx, robot, "again")
+	if err != nil || changed || wait <= 0 || wait > minRobotRestartInterval {
+		t.Fatalf("restartRobotWorkloads right after a restart = %v, %v, %v; want it deferred", changed, wait, err)
+	}
+	if templateHash(deployment) != rotated || robot.Status.SecretHash != rotated {
+		t.Fatal("workloads were restarted again within the minimum interval")
+	}
+	robot.Status.LastRestartedAt = ptr.To(metav1.NewTime(time.Now().Add(-minRobotRestartInterval)))
+	if changed, wait, err := r.restartRobotWorkloads(ctx, robot, "again"); err != nil || !changed || wait != 0 {
+		t.Fatalf("restartRobotWorkloads after the minimum interval = %v, %v, %v", changed, wait, err)
+	}
+	if templateHash(deployment) != hashSecret("again") {
+		t.Fatal("deferred restart was not applied after the minimum interval")
+	}
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+)
+
+// defaultRobotExpiryWarning is how long before expiry a robot secret counts as
+// expiring when spec.rotation.beforeExpiry is not set.
+const defaultRobotExpiryWarning = 7 * 24 * time.Hour
+
+// shouldRotateRobot reports whether the robot secret is due for rotation, or
+// has expired. Expiry alone is not scheduled; it is only noticed when the
+// robot is reconciled.
+func shouldRotateRobot(cr *harborv1alpha1.Robot) bool {
+	now := time.Now()
+	if next := nextRobotRotation(cr); !next.IsZero() && !now.Before(next) {
+		return true
+	}
+	return cr.Status.ExpiresAt != nil && now.After(cr.Status.ExpiresAt.Time)
+}
+
+// nextRobotRotation returns when the robot secret is due for rotation:
+// spec.rotation.beforeExpiry before expiry, or spec.rotation.interval after
+// the last rotation, whichever comes first. It is zero when no rotation is due.
+//
+// Refreshing the secret does not move expires_at in Harbor, so a rotation at
+// or after the beforeExpiry trigger satisfies it until status.expiresAt
+// changes.
+func nextRobotRotation(cr *harborv1alpha1.Robot) time.Time {
+	rotation := cr.Spec.Rotation
+	if rotation == nil {
+		return time.Time{}
+	}
+	var next time.Time
+	earliest := func(at time.Time) {
+		if next.IsZero() || at.Before(next) {
+			next = at
+		}
+	}
+	if expiresAt := cr.Status.ExpiresAt; expiresAt != nil && rotation.BeforeExpiry != nil {
+		at := expiresAt.Add(-rotation.BeforeExpiry.Duration)
+		if cr.Status.LastRotatedAt == nil || cr.Status.LastRotatedAt.Time.Before(at) {
+			earliest(at)
+		}
+	}
+	if rotation.Interval != nil {
+		if cr.Status.LastRotatedAt == nil {
+			earliest(cr.CreationTimestamp.Time)
+		} else {
+			earliest(cr.Status.LastRotatedAt.Add(rotation.Interval.Duration))
+		}
+	}
+	return next
+}
+
+func setRobotNextRotationStatus(cr *harborv1alpha1.Robot) bool {
+	var nextRotationAt *metav1.Time
+	if next := nextRobotRotation(cr); !next.IsZero() {
+		nextRotationAt = ptr.To(metav1.NewTime(next))
+	}
+	if cr.Status.NextRotationAt.Equal(nextRotationAt) {
+		return false
+	}
+	cr.Status.NextRotationAt = nextRotationAt
+	return true
+}
+
+// robotSecretExpiring reports whether the robot secret expires within
+// spec.rotation.beforeExpiry, or within a week when it is not set.
+func robotSecretExpiring(cr *harborv1alpha1.Robot, now time.Time) bool {
+	if cr.Status.ExpiresAt == nil {
+		return false
+	}
+	window := defaultRobotExpiryWarning
+	if rotation := cr.Spec.Rotation; rotation != nil && rotation.BeforeExpiry != nil {
+		window = rotation.BeforeExpiry.Duration
+	}
+	return cr.Status.ExpiresAt.Sub(now) <= window
+}
+
+// requeueForRobotRotation requeues the robot by its next rotation when that
+// comes before the next drift check.
+func requeueForRobotRotation(options OperatorOptions, cr *harborv1alpha1.Robot) (ctrl.Result, error) {
+	result, err := returnWithDriftDetection(options, &cr.Spec.HarborSpecBase)
+	if err != nil || cr.Status.NextRotationAt == nil || !cr.Spec.ManagementPolicy.AllowsWrites() {
+		return result, err
+	}
+	wait := max(time.Until(cr.Status.NextRotationAt.Time), time.Second)
+	if result.RequeueAfter == 0 || wait < result.RequeueAfter {
+		result.RequeueAfter = wait
+	}
+	return result, nil
+}
+
+func validateRobotRotation(cr *harborv1alpha1.Robot) field.ErrorList {
+	rotation := cr.Spec.Rotation
+	if rotation == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	path := field.NewPath("spec", "rotation")
+	if rotation.Interval != nil && rotation.Interval.Duration <= 0 {
+		errs = append(errs, field.Invalid(path.Child("interval"), rotation.Interval.Duration.String(), "must be positive"))
+	}
+	if before := rotation.BeforeExpiry; before != nil {
+		if before.Duration <= 0 {
+			errs = append(errs, field.Invalid(path.Child("beforeExpiry"), before.Duration.String(), "must be positive"))
+		} else if cr.Spec.Duration > 0 && before.Duration >= time.Duration(cr.Spec.Duration)*24*time.Hour {
+			errs = append(errs, field.Invalid(path.Child("beforeExpiry"), before.Duration.String(), fmt.Sprintf("must be shorter than spec.duration (%d days)", cr.Spec.Duration)))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,198 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+	"github.com/rkthtrifork/harbor-operator/internal/harborfake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNextRobotRotation(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastRotated := created.Add(24 * time.Hour)
+	expires := created.Add(90 * 24 * time.Hour)
+	days := func(n int) *metav1.Duration { return &metav1.Duration{Duration: time.Duration(n) * 24 * time.Hour} }
+	tests := []struct {
+		name        string
+		rotation    *harborv1alpha1.RobotRotation
+		lastRotated *time.Time
+		expires     *time.Time
+		want        time.Time
+	}{
+		{name: "never expires", want: time.Time{}},
+		{name: "expiry without rotation", expires: &expires, want: time.Time{}},
+		{name: "expiry without beforeExpiry", rotation: &harborv1alpha1.RobotRotation{}, expires: &expires, want: time.Time{}},
+		{name: "before expiry", rotation: &harborv1alpha1.RobotRotation{BeforeExpiry: days(14)}, expires: &expires, want: expires.Add(-14 * 24 * time.Hour)},
+		{name: "interval", rotation: &harborv1alpha1.RobotRotation{Interval: days(30)}, lastRotated: &lastRotated, expires: &expires, want: lastRotated.Add(30 * 24 * time.Hour)},
+		{name: "interval after expiry", rotation: &harborv1alpha1.RobotRotation{Interval: days(120)}, lastRotated: &lastRotated, expires: &expires, want: lastRotated.Add(120 * 24 * time.Hour)},
+		{name: "interval without an earlier rotation", rotation: &harborv1alpha1.RobotRotation{Interval: days(30)}, want: created},
+		{name: "rotated before expiry", rotation: &harborv1alpha1.RobotRotation{BeforeExpiry: days(89)}, lastRotated: &lastRotated, expires: &expires, want: time.Time{}},
+		{name: "rotated before expiry with interval", rotation: &harborv1alpha1.RobotRotation{Interval: days(30), BeforeExpiry: days(89)}, lastRotated: &lastRotated, expires: &expires, want: lastRotated.Add(30 * 24 * time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			robot := &harborv1alpha1.Robot{
+				ObjectMeta: metav1.ObjectMeta{Name: "ci", Namespace: "team", CreationTimestamp: metav1.NewTime(created)},
+				Spec:       harborv1alpha1.RobotSpec{Duration: 90, Rotation: tt.rotation},
+			}
+			if tt.lastRotated != nil {
+				robot.Status.LastRotatedAt = ptr.To(metav1.NewTime(*tt.lastRotated))
+			}
+			if tt.expires != nil {
+				robot.Status.ExpiresAt = ptr.To(metav1.NewTime(*tt.expires))
+			}
+			if got := nextRobotRotation(robot); !got.Equal(tt.want) {
+				t.Fatalf("nextRobotRotation = %v, want %v", got, tt.want)
+			}
+			setRobotNextRotationStatus(robot)
+			if tt.want.IsZero() != (robot.Status.NextRotationAt == nil) {
+				t.Fatalf("status.nextRotationAt = %v, want %v", robot.Status.NextRotationAt, tt.want)
+			}
+		})
+	}
+}
+
+func TestRobotRotationRequeueAndExpiry(t *testing.T) {
+	t.Parallel()
+
+	options, err := NewOperatorOptions(OperatorConfig{
+		DefaultCreationPolicy:         harborv1alpha1.CreationPolicyCreate,
+		DefaultDriftDetectionInterval: 10 * time.Minute,
+		HarborRequestTimeout:          defaultHarborRequestTimeout,
+	})
+	if err != nil {
+		t.Fatalf("NewOperatorOptions returned error: %v", err)
+	}
+	now := time.Now()
+	robot := &harborv1alpha1.Robot{
+		Spec: harborv1alpha1.RobotSpec{
+			Duration: 30,
+			Rotation: &harborv1alpha1.RobotRotation{BeforeExpiry: &metav1.Duration{Duration: 48 * time.Hour}},
+		},
+		Status: harborv1alpha1.RobotStatus{ExpiresAt: ptr.To(metav1.NewTime(now.Add(50 * time.Hour)))},
+	}
+	setRobotNextRotationStatus(robot)
+
+	result, err := requeueForRobotRotation(options, robot)
+	if err != nil || result.RequeueAfter != 10*time.Minute {
+		t.Fatalf("requeue = %v (%v), want the drift interval before a distant rotation", result.RequeueAfter, err)
+	}
+	if robotSecretExpiring(robot, now) {
+		t.Fatal("robot secret reported as expiring outside beforeExpiry")
+	}
+
+	robot.Status.ExpiresAt = ptr.To(metav1.NewTime(now.Add(48*time.Hour + 5*time.Minute)))
+	setRobotNextRotationStatus(robot)
+	result, err = requeueForRobotRotation(options, robot)
+	if err != nil || result.RequeueAfter <= 0 || result.RequeueAfter > 5*time.Minute {
+		t.Fatalf("requeue = %v (%v), want the upcoming rotation", result.RequeueAfter, err)
+	}
+	if !robotSecretExpiring(robot, now.Add(6*time.Minute)) {
+		t.Fatal("robot secret not reported as expiring within beforeExpiry")
+	}
+
+	robot.Spec.ManagementPolicy = harborv1alpha1.ManagementPolicyObserveOnly
+	if result, _ := requeueForRobotRotation(options, robot); result.RequeueAfter != 10*time.Minute {
+		t.Fatalf("requeue = %v, want no rotation schedule when writes are not allowed", result.RequeueAfter)
+	}
+
+	robot.Spec.Rotation.BeforeExpiry = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+	if errs := validateRobotRotation(robot); len(errs) != 1 || !strings.Contains(errs[0].Error(), "shorter than spec.duration") {
+		t.Fatalf("validateRobotRotation = %v, want beforeExpiry rejected", errs)
+	}
+}
+
+func TestRobotRotatesOncePerExpiryWindow(t *testing.T) {
+	t.Parallel()
+
+	const password = "Harbor12345"
+	// The robot was created 29 days ago, so its 30 day secret is already
+	// within beforeExpiry.
+	server := harborfake.New(harborfake.Options{
+		Username: harborfake.AdminUsername,
+		Password: password,
+		Now:      func() time.Time { return time.Now().Add(-29 * 24 * time.Hour) },
+	})
+	t.Cleanup(server.Close)
+	hc := harborclient.New(server.URL, harborfake.AdminUsername, password)
+	ctx := context.Background()
+	created, err := hc.CreateRobot(ctx, harborclient.RobotCreateRequest{
+		Name:     "ci",
+		Level:    "system",
+		Duration: 30,
+		Permissions: []harborclient.RobotPermission{{
+			Kind:      "system",
+			Namespace: "/",
+			Access:    []harborclient.Access{{Resource: "project", Action: "list", Effect: "allow"}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("CreateRobot returned error: %v", err)
+	}
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	if err := harborv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	robot := &harborv1alpha1.Robot{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci", Namespace: "team"},
+		Spec: harborv1alpha1.RobotSpec{
+			Level:    "system",
+			Duration: 30,
+			Rotation: &harborv1alpha1.RobotRotation{BeforeExpiry: &metav1.Duration{Duration: 48 * time.Hour}},
+			Permissions: []harborv1alpha1.RobotPermission{{
+				Kind:   "system",
+				Access: []harborv1alpha1.RobotAccess{{Resource: "project", Action: "list"}},
+			}},
+		},
+		Status: harborv1alpha1.RobotStatus{HarborRobotID: created.ID},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(robot).WithStatusSubresource(robot).Build()
+	options, err := NewOperatorOptions(OperatorConfig{DefaultCreationPolicy: harborv1alpha1.CreationPolicyCreate, HarborRequestTimeout: defaultHarborRequestTimeout})
+	if err != nil {
+		t.Fatalf("NewOperatorOptions returned error: %v", err)
+	}
+	r := &RobotReconciler{Client: c, Scheme: scheme, Options: options}
+	secretRef := harborv1alpha1.SecretReference{Namespace: "team", Name: "ci-secret", Key: "secret"}
+
+	if _, err := r.reconcileExisting(ctx, hc, robot, secretRef, "harbor.example.com"); err != nil {
+		t.Fatalf("reconcileExisting returned error: %v", err)
+	}
+	rotated, _ := server.RobotSecret(created.ID)
+	if rotated == created.Secret || robot.Status.LastRotatedAt == nil {
+		t.Fatal("robot secret was not rotated within beforeExpiry")
+	}
+	// Refreshing the secret does not extend the robot, so it still expires
+	// when it was created to.
+	if robot.Status.ExpiresAt == nil || robot.Status.ExpiresAt.Unix() != int64(created.ExpiresAt) {
+		t.Fatalf("status.expiresAt = %v, want the unchanged Harbor expiry %d", robot.Status.ExpiresAt, created.ExpiresAt)
+	}
+
+	// Harbor keeps expires_at, so the next reconcile must not rotate again.
+	result, err := r.reconcileExisting(ctx, hc, robot, secretRef, "harbor.example.com")
+	if err != nil {
+		t.Fatalf("reconcileExisting returned error: %v", err)
+	}
+	if secret, _ := server.RobotSecret(created.ID); secret != rotated {
+		t.Fatal("robot secret was rotated again within the same expiry window")
+	}
+	if robot.Status.NextRotationAt != nil || result.RequeueAfter != 0 {
+		t.Fatalf("nextRotationAt = %v, requeue = %v; want no rotation scheduled until expiresAt changes", robot.Status.NextRotationAt, result.RequeueAfter)
+	}
+}
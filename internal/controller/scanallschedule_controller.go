@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -17,9 +18,10 @@ import (
 
 type ScanAllScheduleReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=scanallschedules,verbs=get;list;watch;create;update;patch;delete
@@ -42,12 +44,12 @@ func (r *ScanAllScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, err
 	}
 
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), false, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if done, err := finalizeIfDeleting(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), nil); done {
@@ -58,12 +60,12 @@ func (r *ScanAllScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, err
 	}
 	if err := ensureScanAllScheduleSingletonOwner(ctx, r.Options, r.Client, &cr); err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	params, paramsHash, err := scheduleParameters(cr.Spec.Parameters, "scan all")
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 	sched := harborclient.Schedule{
 		Schedule: harborclient.ScheduleObj{
@@ -73,7 +75,7 @@ func (r *ScanAllScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		Parameters: params,
 	}
 	if sched.Schedule.Type != harborv1alpha1.ScheduleTypeManual && sched.Schedule.Type != harborv1alpha1.ScheduleTypeNone && sched.Schedule.Cron == "" {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("schedule.cron is required for schedule type %q", sched.Schedule.Type))
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, fmt.Errorf("schedule.cron is required for schedule type %q", sched.Schedule.Type))
 	}
 	// Harbor treats Manual as a trigger. We still send parameters/hash.
 	hash := hashParts(
@@ -84,27 +86,43 @@ func (r *ScanAllScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 	current, err := hc.GetScanAllSchedule(ctx)
 	if err != nil && !harborclient.IsNotFound(err) {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+	}
+
+	if !scanAllSchedulesEqual(current, &sched) {
+		var observed any
+		if current != nil {
+			observed = scanAllScheduleState(*current)
+		}
+		if err := recordDrift(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "ScanAllSchedule",
+			scanAllScheduleState(sched), observed); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if !cr.Spec.ManagementPolicy.AllowsWrites() && (harborclient.IsNotFound(err) || !scanAllSchedulesEqual(current, &sched)) {
+		return reportObservedDrift(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Scan all schedule")
 	}
 
 	statusChanged := false
-	reason, message := "Reconciled", "Scan all schedule reconciled"
+	reason, message := ReasonReconciled, "Scan all schedule reconciled"
 	schedulesMatch := scanAllSchedulesEqual(current, &sched)
 	switch {
 	case harborclient.IsNotFound(err):
 		if err := hc.CreateScanAllSchedule(ctx, sched); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.LastAppliedScheduleHash = hash
 		statusChanged = true
-		reason, message = "Created", "Scan all schedule created"
+		reason, message = ReasonCreated, "Scan all schedule created"
+		recordEvent(r.Recorder, &cr, ReasonCreated, actionCreate, "Created Harbor scan all schedule")
 	case !schedulesMatch:
 		if err := hc.UpdateScanAllSchedule(ctx, sched); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.LastAppliedScheduleHash = hash
 		statusChanged = true
 		r.logger.Info("Updated scan all schedule")
+		recordUpdate(r.Recorder, &cr, &cr.Status.HarborStatusBase, "Updated Harbor scan all schedule")
 	case cr.Status.LastAppliedScheduleHash != hash:
 		cr.Status.LastAppliedScheduleHash = hash
 		statusChanged = true
@@ -128,12 +146,19 @@ func (r *ScanAllScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.ScanAllSchedule).Spec.HarborConnectionRef
 		},
+		nil,
 		"scanallschedule",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("ScanAllSchedule", r))
+}
+
+// scanAllScheduleState keeps the fields of a schedule that
+// scanAllSchedulesEqual compares.
+func scanAllScheduleState(s harborclient.Schedule) harborclient.Schedule {
+	return harborclient.Schedule{Schedule: scheduleObjState(s.Schedule), Parameters: s.Parameters}
 }
 
 func scanAllSchedulesEqual(current, desired *harborclient.Schedule) bool {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -17,9 +18,10 @@ import (
 
 type ScannerRegistrationReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=scannerregistrations,verbs=get;list;watch;create;update;patch;delete
@@ -42,19 +44,20 @@ func (r *ScannerRegistrationReconciler) Reconcile(ctx context.Context, req ctrl.
 		return ctrl.Result{}, err
 	}
 
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), true, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if done, err := finalizeIfDeleting(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), func() error {
 		if cr.Status.HarborScannerID == "" {
 			return nil
 		}
-		return hc.DeleteScanner(ctx, cr.Status.HarborScannerID)
+		err := hc.DeleteScanner(ctx, cr.Status.HarborScannerID)
+		return recordDeleted(r.Recorder, &cr, err, "Deleted Harbor scanner registration %s", cr.Status.HarborScannerID)
 	}); done {
 		return ctrl.Result{}, err
 	}
@@ -69,11 +72,11 @@ func (r *ScannerRegistrationReconciler) Reconcile(ctx context.Context, req ctrl.
 func (r *ScannerRegistrationReconciler) reconcileScannerRegistration(ctx context.Context, hc *harborclient.Client, cr *harborv1alpha1.ScannerRegistration) (ctrl.Result, error) {
 	credential, credentialHash, err := r.resolveCredential(ctx, cr)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	reqBody := harborclient.ScannerRegistrationReq{
-		Name:             cr.Name,
+		Name:             cr.HarborName(),
 		Description:      cr.Spec.Description,
 		URL:              cr.Spec.URL,
 		Auth:             cr.Spec.Auth,
@@ -83,30 +86,32 @@ func (r *ScannerRegistrationReconciler) reconcileScannerRegistration(ctx context
 		Disabled:         cr.Spec.Disabled,
 	}
 
-	if cr.Status.HarborScannerID == "" && allowsAdoption(r.Options, cr.Spec.CreationPolicy) {
+	if cr.Status.HarborScannerID == "" && allowsAdoption(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy) {
 		adopted, err := r.adoptExisting(ctx, hc, cr)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		if adopted {
 			r.logger.Info("Adopted existing scanner registration", "ID", cr.Status.HarborScannerID)
+			recordEvent(r.Recorder, cr, ReasonAdopted, actionAdopt, "Adopted existing Harbor scanner registration %s", cr.Status.HarborScannerID)
 			return ctrl.Result{Requeue: true}, nil
 		}
 	}
 
 	if cr.Status.HarborScannerID == "" {
-		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy); err != nil {
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		id, err := hc.CreateScanner(ctx, reqBody)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.HarborScannerID = id
 		cr.Status.CredentialHash = credentialHash
-		if err := setReadyStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, "Created", "Scanner registration created"); err != nil {
+		if err := setReadyStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonCreated, "Scanner registration created"); err != nil {
 			return ctrl.Result{}, err
 		}
+		recordEvent(r.Recorder, cr, ReasonCreated, actionCreate, "Created Harbor scanner registration %s", id)
 		return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
 	}
 
@@ -117,15 +122,25 @@ func (r *ScannerRegistrationReconciler) reconcileScannerRegistration(ctx context
 				cr.Status.HarborScannerID = ""
 			}, "Scanner registration not found in Harbor")
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	statusChanged := false
 	if scannerNeedsUpdate(reqBody, current) || (credentialHash != "" && credentialHash != cr.Status.CredentialHash) {
+		desired := reqBody
+		desired.AccessCredential = ""
+		if err := recordDrift(ctx, r.Client, cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "ScannerRegistration",
+			desired, scannerState(current)); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !cr.Spec.ManagementPolicy.AllowsWrites() {
+			return reportObservedDrift(ctx, r.Options, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Scanner registration")
+		}
 		if err := hc.UpdateScanner(ctx, cr.Status.HarborScannerID, reqBody); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		r.logger.Info("Updated scanner registration", "ID", cr.Status.HarborScannerID)
+		recordUpdate(r.Recorder, cr, &cr.Status.HarborStatusBase, "Updated Harbor scanner registration %s", cr.Status.HarborScannerID)
 		if credentialHash != "" && credentialHash != cr.Status.CredentialHash {
 			cr.Status.CredentialHash = credentialHash
 			statusChanged = true
@@ -133,13 +148,21 @@ func (r *ScannerRegistrationReconciler) reconcileScannerRegistration(ctx context
 	}
 
 	if cr.Spec.Default && !current.IsDefault {
+		if err := recordDrift(ctx, r.Client, cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "ScannerRegistration",
+			map[string]bool{"is_default": true}, map[string]bool{"is_default": current.IsDefault}); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !cr.Spec.ManagementPolicy.AllowsWrites() {
+			return reportObservedDrift(ctx, r.Options, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Scanner registration")
+		}
 		if err := hc.SetDefaultScanner(ctx, cr.Status.HarborScannerID, true); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		r.logger.Info("Set scanner registration as default", "ID", cr.Status.HarborScannerID)
+		recordUpdate(r.Recorder, cr, &cr.Status.HarborStatusBase, "Set Harbor scanner registration %s as the default scanner", cr.Status.HarborScannerID)
 	}
 
-	condChanged := markReady(&cr.Status.HarborStatusBase, cr.Generation, "Reconciled", "Scanner registration reconciled")
+	condChanged := markReady(&cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "Scanner registration reconciled")
 	if statusChanged || condChanged {
 		if err := r.Status().Update(ctx, cr); err != nil {
 			return ctrl.Result{}, err
@@ -172,7 +195,7 @@ func (r *ScannerRegistrationReconciler) adoptExisting(ctx context.Context, hc *h
 		return false, err
 	}
 	for _, reg := range registrations {
-		if strings.EqualFold(reg.Name, cr.Name) {
+		if strings.EqualFold(reg.Name, cr.HarborName()) {
 			cr.Status.HarborScannerID = reg.UUID
 			return true, r.Status().Update(ctx, cr)
 		}
@@ -189,12 +212,27 @@ func (r *ScannerRegistrationReconciler) SetupWithManager(mgr ctrl.Manager) error
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.ScannerRegistration).Spec.HarborConnectionRef
 		},
+		scannerRegistrationSecretKeys,
 		"scannerregistration",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("ScannerRegistration", r))
+}
+
+// scannerState returns the fields of a scanner registration that
+// scannerNeedsUpdate compares, in the form of the request that sets them.
+func scannerState(in *harborclient.ScannerRegistration) harborclient.ScannerRegistrationReq {
+	return harborclient.ScannerRegistrationReq{
+		Name:            in.Name,
+		Description:     in.Description,
+		URL:             in.URL,
+		Auth:            in.Auth,
+		SkipCertVerify:  in.SkipCertVerify,
+		UseInternalAddr: in.UseInternalAddr,
+		Disabled:        in.Disabled,
+	}
 }
 
 func scannerNeedsUpdate(desired harborclient.ScannerRegistrationReq, current *harborclient.ScannerRegistration) bool {
@@ -209,3 +247,9 @@ func scannerNeedsUpdate(desired harborclient.ScannerRegistrationReq, current *ha
 		desired.UseInternalAddr != current.UseInternalAddr ||
 		desired.Disabled != current.Disabled
 }
+
+// scannerRegistrationSecretKeys returns the Secrets a scanner registration reads.
+func scannerRegistrationSecretKeys(obj client.Object) []string {
+	registration := obj.(*harborv1alpha1.ScannerRegistration)
+	return secretRefKeys(registration.Namespace, registration.Spec.AccessCredentialSecretRef)
+}
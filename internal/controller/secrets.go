@@ -40,11 +40,7 @@ func readSecretValue(ctx context.Context, options OperatorOptions, c client.Clie
 	}
 
 	var secret corev1.Secret
-	reader := client.Reader(c)
-	if uncached := options.secretReader; uncached != nil {
-		reader = uncached
-	}
-	if err := reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, &secret); err != nil {
+	if err := options.uncachedReader(c).Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, &secret); err != nil {
 		return "", err
 	}
 	value, ok := secret.Data[key]
@@ -54,12 +50,35 @@ func readSecretValue(ctx context.Context, options OperatorOptions, c client.Clie
 	return string(value), nil
 }
 
+// secretRefKeys returns the namespace/name keys of the Secrets refs point to,
+// skipping nil references and references without a namespace.
+func secretRefKeys(defaultNamespace string, refs ...*harborv1alpha1.SecretReference) []string {
+	keys := []string{}
+	for _, ref := range refs {
+		if ref == nil || ref.Name == "" {
+			continue
+		}
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = defaultNamespace
+		}
+		if namespace == "" {
+			continue
+		}
+		keys = append(keys, types.NamespacedName{Namespace: namespace, Name: ref.Name}.String())
+	}
+	return keys
+}
+
 func hashSecret(value string) string {
 	sum := sha256.Sum256([]byte(value))
 	return hex.EncodeToString(sum[:])
 }
 
-func upsertOwnedSecretValues(ctx context.Context, c client.Client, owner client.Object, ownerKind, namespace, name string, values map[string]string) error {
+// upsertOwnedSecretValues writes values into a Secret of the given type that
+// is managed by owner, and adds labels to it. The type of a Secret cannot be
+// changed, so a managed Secret of another type is replaced.
+func upsertOwnedSecretValues(ctx context.Context, c client.Client, owner client.Object, ownerKind, namespace, name string, secretType corev1.SecretType, labels, values map[string]string) error {
 	for key := range values {
 		if key == "" {
 			return fmt.Errorf("secret key must be set for %s/%s", namespace, name)
@@ -71,23 +90,22 @@ func upsertOwnedSecretValues(ctx context.Context, c client.Client, owner client.
 		if !errors.IsNotFound(err) {
 			return err
 		}
-		secret = corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Namespace: namespace,
-				Name:      name,
-			},
-			Type: corev1.SecretTypeOpaque,
-			Data: map[string][]byte{},
-		}
-		setSecretValues(&secret, values)
-		ensureSecretOwnershipMetadata(&secret, owner, ownerKind)
-		return c.Create(ctx, &secret)
+		return createOwnedSecret(ctx, c, owner, ownerKind, namespace, name, secretType, labels, values)
 	}
 	if !secretOwnedBy(&secret, owner, ownerKind) {
 		return fmt.Errorf("secret %s/%s already exists and is not managed by %s %s/%s", namespace, name, ownerKind, owner.GetNamespace(), owner.GetName())
 	}
+	if secret.Type != secretType {
+		if err := c.Delete(ctx, &secret, client.Preconditions{UID: &secret.UID}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		return createOwnedSecret(ctx, c, owner, ownerKind, namespace, name, secretType, labels, values)
+	}
 
 	changed := ensureSecretOwnershipMetadata(&secret, owner, ownerKind)
+	if setSecretLabels(&secret, labels) {
+		changed = true
+	}
 	if secret.Data == nil {
 		secret.Data = map[string][]byte{}
 		changed = true
@@ -101,6 +119,32 @@ func upsertOwnedSecretValues(ctx context.Context, c client.Client, owner client.
 	return c.Update(ctx, &secret)
 }
 
+func createOwnedSecret(ctx context.Context, c client.Client, owner client.Object, ownerKind, namespace, name string, secretType corev1.SecretType, labels, values map[string]string) error {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Type: secretType,
+		Data: map[string][]byte{},
+	}
+	setSecretValues(&secret, values)
+	ensureSecretOwnershipMetadata(&secret, owner, ownerKind)
+	setSecretLabels(&secret, labels)
+	return c.Create(ctx, &secret)
+}
+
+func setSecretLabels(secret *corev1.Secret, labels map[string]string) bool {
+	changed := false
+	for key, value := range labels {
+		if existing, ok := secret.Labels[key]; !ok || existing != value {
+			secret.Labels[key] = value
+			changed = true
+		}
+	}
+	return changed
+}
+
 func setSecretValues(secret *corev1.Secret, values map[string]string) bool {
 	changed := false
 	for key, value := range values {
@@ -0,0 +1,177 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSecretKeysListEverySecretAResourceReads(t *testing.T) {
+	t.Parallel()
+
+	meta := metav1.ObjectMeta{Name: "demo", Namespace: "team"}
+	tests := []struct {
+		name string
+		keys secretRefAccessor
+		obj  client.Object
+		want []string
+	}{
+		{
+			name: "registry",
+			keys: registrySecretKeys,
+			obj: &harborv1alpha1.Registry{ObjectMeta: meta, Spec: harborv1alpha1.RegistrySpec{
+				CACertificateRef: &harborv1alpha1.SecretReference{Name: "ca", Namespace: "pki"},
+				Credential: &harborv1alpha1.RegistryCredentialSpec{
+					AccessKeySecretRef:    harborv1alpha1.SecretReference{Name: "registry-credentials"},
+					AccessSecretSecretRef: harborv1alpha1.SecretReference{Name: "registry-credentials"},
+				},
+			}},
+			want: []string{"pki/ca", "team/registry-credentials", "team/registry-credentials"},
+		},
+		{
+			name: "registry without secrets",
+			keys: registrySecretKeys,
+			obj:  &harborv1alpha1.Registry{ObjectMeta: meta},
+			want: []string{},
+		},
+		{
+			name: "scanner registration",
+			keys: scannerRegistrationSecretKeys,
+			obj: &harborv1alpha1.ScannerRegistration{ObjectMeta: meta, Spec: harborv1alpha1.ScannerRegistrationSpec{
+				AccessCredentialSecretRef: &harborv1alpha1.SecretReference{Name: "scanner"},
+			}},
+			want: []string{"team/scanner"},
+		},
+		{
+			name: "webhook policy",
+			keys: webhookPolicySecretKeys,
+			obj: &harborv1alpha1.WebhookPolicy{ObjectMeta: meta, Spec: harborv1alpha1.WebhookPolicySpec{
+				Targets: []harborv1alpha1.WebhookTargetSpec{
+					{AuthHeaderSecretRef: &harborv1alpha1.SecretReference{Name: "hook-a"}},
+					{AuthHeader: "literal"},
+					{AuthHeaderSecretRef: &harborv1alpha1.SecretReference{Name: "hook-b"}},
+				},
+			}},
+			want: []string{"team/hook-a", "team/hook-b"},
+		},
+		{
+			name: "user",
+			keys: userSecretKeys,
+			obj: &harborv1alpha1.User{ObjectMeta: meta, Spec: harborv1alpha1.UserSpec{
+				PasswordSecretRef: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "password"}},
+			}},
+			want: []string{"team/password"},
+		},
+		{
+			name: "configuration",
+			keys: configurationSecretKeys,
+			obj: &harborv1alpha1.Configuration{ObjectMeta: meta, Spec: harborv1alpha1.ConfigurationSpec{
+				Settings: map[string]harborv1alpha1.ConfigurationValue{
+					"ldap_search_password": {ValueFrom: &harborv1alpha1.ConfigurationValueSource{
+						SecretKeyRef: harborv1alpha1.SecretReference{Name: "ldap"},
+					}},
+				},
+			}},
+			want: []string{"team/ldap"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.keys(tt.obj)
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("secret keys = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRobotSecretFollowsSecretFormat(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	robot := &harborv1alpha1.Robot{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci", Namespace: "team"},
+		Spec:       harborv1alpha1.RobotSpec{SecretFormat: harborv1alpha1.RobotSecretFormatDockerConfigJSON},
+	}
+	ref := harborv1alpha1.SecretReference{Name: "ci-secret", Namespace: "team", Key: "secret"}
+	key := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+	registry, err := registryHost("https://harbor.example.com:8443/")
+	if err != nil {
+		t.Fatalf("registryHost returned error: %v", err)
+	}
+
+	expectPullSecret := func(token string) {
+		t.Helper()
+		var secret corev1.Secret
+		if err := c.Get(ctx, key, &secret); err != nil {
+			t.Fatalf("get secret: %v", err)
+		}
+		if secret.Type != corev1.SecretTypeDockerConfigJson {
+			t.Fatalf("secret type = %q, want %q", secret.Type, corev1.SecretTypeDockerConfigJson)
+		}
+		if string(secret.Data["secret"]) != token || string(secret.Data["username"]) != "robot$ci" {
+			t.Fatalf("secret data = %v, want the robot username and %q", secret.Data, token)
+		}
+		var config struct {
+			Auths map[string]map[string]string `json:"auths"`
+		}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config); err != nil {
+			t.Fatalf("decode %s: %v", corev1.DockerConfigJsonKey, err)
+		}
+		auth := config.Auths["harbor.example.com:8443"]
+		if auth["username"] != "robot$ci" || auth["password"] != token || auth["auth"] == "" {
+			t.Fatalf("docker config auths = %v, want robot$ci with %q for harbor.example.com:8443", config.Auths, token)
+		}
+	}
+
+	if err := upsertRobotSecret(ctx, c, robot, ref, registry, "robot$ci", "first"); err != nil {
+		t.Fatalf("upsertRobotSecret returned error: %v", err)
+	}
+	expectPullSecret("first")
+
+	if err := upsertRobotSecret(ctx, c, robot, ref, registry, "robot$ci", "rotated"); err != nil {
+		t.Fatalf("upsertRobotSecret after rotation returned error: %v", err)
+	}
+	expectPullSecret("rotated")
+
+	robot.Spec.SecretFormat = harborv1alpha1.RobotSecretFormatOpaque
+	if _, err := syncRobotSecret(ctx, c, robot, ref, registry, "robot$ci"); err != nil {
+		t.Fatalf("syncRobotSecret returned error: %v", err)
+	}
+	var secret corev1.Secret
+	if err := c.Get(ctx, key, &secret); err != nil {
+		t.Fatalf("get secret: %v", err)
+	}
+	if _, ok := secret.Data[corev1.DockerConfigJsonKey]; secret.Type != corev1.SecretTypeOpaque || ok {
+		t.Fatalf("secret = %s %v, want an Opaque Secret without %s", secret.Type, secret.Data, corev1.DockerConfigJsonKey)
+	}
+	if string(secret.Data["secret"]) != "rotated" {
+		t.Fatalf("secret = %q, want the robot secret kept when the format changes", secret.Data["secret"])
+	}
+
+	other := robot.DeepCopy()
+	other.Name = "other"
+	other.Spec.SecretFormat = harborv1alpha1.RobotSecretFormatDockerConfigJSON
+	err = upsertRobotSecret(ctx, c, other, ref, registry, "robot$other", "token")
+	if err == nil || !strings.Contains(err.Error(), "not managed by Robot team/other") {
+		t.Fatalf("upsertRobotSecret for another Robot = %v, want an ownership error", err)
+	}
+}
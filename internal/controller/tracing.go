@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var tracer = otel.Tracer("github.com/rkthtrifork/harbor-operator/internal/controller")
+
+// tracedReconciler wraps a reconciler in one span per reconcile. Harbor API
+// requests made with the reconcile context become its children.
+type tracedReconciler struct {
+	kind       string
+	reconciler reconcile.Reconciler
+}
+
+func traceReconciler(kind string, r reconcile.Reconciler) reconcile.Reconciler {
+	return &tracedReconciler{kind: kind, reconciler: r}
+}
+
+func (t *tracedReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, span := tracer.Start(ctx, "Reconcile "+t.kind, trace.WithAttributes(
+		attribute.String("k8s.resource.kind", t.kind),
+		attribute.String("k8s.namespace.name", req.Namespace),
+		attribute.String("k8s.object.name", req.Name),
+	))
+	defer span.End()
+
+	result, err := t.reconciler.Reconcile(ctx, req)
+	if result.RequeueAfter > 0 {
+		span.SetAttributes(attribute.String("reconcile.requeue_after", result.RequeueAfter.String()))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
+// traceObject records the generation of the reconciled object on the
+// reconcile span, which is only known once the object has been read.
+func traceObject(ctx context.Context, obj client.Object) {
+	trace.SpanFromContext(ctx).SetAttributes(attribute.Int64("k8s.object.generation", obj.GetGeneration()))
+}
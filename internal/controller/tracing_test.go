@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestTracedReconcilerRecordsObjectAndError(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := tracer
+	tracer = provider.Tracer("test")
+	t.Cleanup(func() { tracer = previous })
+
+	failure := errors.New("harbor unavailable")
+	traced := traceReconciler("Robot", reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		traceObject(ctx, &harborv1alpha1.Robot{ObjectMeta: metav1.ObjectMeta{Generation: 3}})
+		return reconcile.Result{}, failure
+	}))
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "team-a", Name: "ci"}}
+	if _, err := traced.Reconcile(context.Background(), req); !errors.Is(err, failure) {
+		t.Fatalf("Reconcile error = %v, want %v", err, failure)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("recorded %d spans, want 1", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "Reconcile Robot" {
+		t.Fatalf("span name = %q, want %q", span.Name(), "Reconcile Robot")
+	}
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	if attrs["k8s.resource.kind"].AsString() != "Robot" ||
+		attrs["k8s.namespace.name"].AsString() != "team-a" ||
+		attrs["k8s.object.name"].AsString() != "ci" ||
+		attrs["k8s.object.generation"].AsInt64() != 3 {
+		t.Fatalf("unexpected span attributes: %v", span.Attributes())
+	}
+	if span.Status().Code != codes.Error {
+		t.Fatalf("span status = %v, want error", span.Status())
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -21,9 +22,10 @@ import (
 // Harbor. Claims are non-owning and therefore never delete a Harbor group.
 type UserGroupClaimReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=usergroupclaims,verbs=get;list;watch;create;update;patch;delete
@@ -49,10 +51,10 @@ func (r *UserGroupClaimReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	if !claim.DeletionTimestamp.IsZero() {
 		if referenced, err := r.hasActiveMembers(ctx, &claim); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &claim, &claim.Status.HarborStatusBase, claim.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &claim, &claim.Status.HarborStatusBase, claim.Generation, err)
 		} else if referenced {
 			err := fmt.Errorf("UserGroupClaim %s/%s is still referenced by an active Member", claim.Namespace, claim.Name)
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &claim, &claim.Status.HarborStatusBase, claim.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &claim, &claim.Status.HarborStatusBase, claim.Generation, err)
 		}
 		return ctrl.Result{}, removeFinalizer(ctx, r.Client, &claim)
 	}
@@ -61,9 +63,9 @@ func (r *UserGroupClaimReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &claim, &claim.Status.HarborStatusBase, claim.Namespace, claim.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &claim, &claim.Status.HarborStatusBase, claim.Namespace, claim.Spec.HarborConnectionRef)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &claim, &claim.Status.HarborStatusBase, claim.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &claim, &claim.Status.HarborStatusBase, claim.Generation, err)
 	}
 
 	desired := harborclient.UserGroup{
@@ -73,14 +75,14 @@ func (r *UserGroupClaimReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 	current, found, err := findUserGroup(ctx, hc, desired)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &claim, &claim.Status.HarborStatusBase, claim.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &claim, &claim.Status.HarborStatusBase, claim.Generation, err)
 	}
 	if !found {
 		id, createErr := hc.CreateUserGroup(ctx, desired)
 		if createErr != nil && harborclient.IsConflict(createErr) {
 			current, found, err = findUserGroup(ctx, hc, desired)
 			if err != nil {
-				return ctrl.Result{}, setErrorStatus(ctx, r.Client, &claim, &claim.Status.HarborStatusBase, claim.Generation, err)
+				return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &claim, &claim.Status.HarborStatusBase, claim.Generation, err)
 			}
 			if !found {
 				createErr = fmt.Errorf("harbor reported a conflicting UserGroup for %q, but no compatible group could be found", desired.GroupName)
@@ -89,18 +91,19 @@ func (r *UserGroupClaimReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			}
 		}
 		if createErr != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, &claim, &claim.Status.HarborStatusBase, claim.Generation, createErr)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &claim, &claim.Status.HarborStatusBase, claim.Generation, createErr)
 		}
 		if !found {
 			current = &harborclient.UserGroup{ID: id}
+			recordEvent(r.Recorder, &claim, ReasonCreated, actionCreate, "Created Harbor user group %d", id)
 		}
 	}
 
 	if current == nil || current.ID == 0 {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &claim, &claim.Status.HarborStatusBase, claim.Generation, fmt.Errorf("harbor returned no UserGroup ID for %q", desired.GroupName))
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &claim, &claim.Status.HarborStatusBase, claim.Generation, fmt.Errorf("harbor returned no UserGroup ID for %q", desired.GroupName))
 	}
 	claim.Status.HarborGroupID = current.ID
-	if err := setReadyStatus(ctx, r.Client, &claim, &claim.Status.HarborStatusBase, claim.Generation, "Reconciled", "External group claim reconciled"); err != nil {
+	if err := setReadyStatus(ctx, r.Client, &claim, &claim.Status.HarborStatusBase, claim.Generation, ReasonReconciled, "External group claim reconciled"); err != nil {
 		return ctrl.Result{}, err
 	}
 	return returnWithDriftDetection(r.Options, &claim.Spec.HarborClaimSpecBase)
@@ -155,6 +158,7 @@ func (r *UserGroupClaimReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.UserGroupClaim).Spec.HarborConnectionRef
 		},
+		nil,
 		"usergroupclaim",
 	)
 	if err != nil {
@@ -172,5 +176,5 @@ func (r *UserGroupClaimReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		}
 		return []reconcile.Request{{NamespacedName: client.ObjectKey{Namespace: namespace, Name: ref.Name}}}
 	}))
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("UserGroupClaim", r))
 }
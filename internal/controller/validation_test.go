@@ -57,7 +57,7 @@ var _ = Describe("CRD validation and defaulting", func() {
 				BaseURL: "https://harbor.example.com",
 				Credentials: &harborv1alpha1.Credentials{
 					Username: "admin",
-					PasswordSecretRef: harborv1alpha1.SecretReference{
+					PasswordSecretRef: &harborv1alpha1.SecretReference{
 						Name: "harbor-password",
 					},
 				},
@@ -189,6 +189,36 @@ var _ = Describe("CRD validation and defaulting", func() {
 		expectInvalidUpdate(proxyProject)
 	})
 
+	It("rejects changes to a project's harborName", func() {
+		project := &harborv1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      "immutable-harbor-name-project",
+			},
+			Spec: harborv1alpha1.ProjectSpec{
+				HarborSpecBase: harborv1alpha1.HarborSpecBase{
+					HarborConnectionRef: &harborv1alpha1.HarborConnectionReference{Name: "conn"},
+				},
+				HarborName: "Team_Project",
+			},
+		}
+		Expect(k8sClient.Create(ctx, project)).To(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, project) })
+
+		project.Spec.Public = true
+		Expect(k8sClient.Update(ctx, project)).To(Succeed())
+
+		project.Spec.HarborName = "other"
+		err := k8sClient.Update(ctx, project)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("harborName is immutable"))
+
+		project.Spec.HarborName = ""
+		err = k8sClient.Update(ctx, project)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("harborName is immutable"))
+	})
+
 	It("defaults webhook enabled to true", func() {
 		policy := &harborv1alpha1.WebhookPolicy{
 			ObjectMeta: metav1.ObjectMeta{
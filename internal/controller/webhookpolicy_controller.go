@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -19,9 +20,10 @@ import (
 
 type WebhookPolicyReconciler struct {
 	client.Client
-	Scheme  *runtime.Scheme
-	Options OperatorOptions
-	logger  logr.Logger
+	Scheme   *runtime.Scheme
+	Options  OperatorOptions
+	Recorder events.EventRecorder
+	logger   logr.Logger
 }
 
 // +kubebuilder:rbac:groups=harbor.harbor-operator.io,resources=webhookpolicies,verbs=get;list;watch;create;update;patch;delete
@@ -45,12 +47,12 @@ func (r *WebhookPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, err
 	}
 
-	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
+	hc, err := getHarborClientForObject(ctx, r.Options, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Namespace, cr.Spec.HarborConnectionRef)
 	if err != nil {
 		if done, finalErr := finalizeWithoutHarborConnection(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), true, err); done {
 			return ctrl.Result{}, finalErr
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, &cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	if done, err := finalizeIfDeleting(ctx, r.Client, &cr, cr.Spec.GetDeletionPolicy(), func() error {
@@ -61,7 +63,8 @@ func (r *WebhookPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		if resolveErr != nil {
 			return resolveErr
 		}
-		return hc.DeleteWebhookPolicy(ctx, projectKey, cr.Status.HarborWebhookPolicyID)
+		err := hc.DeleteWebhookPolicy(ctx, projectKey, cr.Status.HarborWebhookPolicyID)
+		return recordDeleted(r.Recorder, &cr, err, "Deleted Harbor webhook policy %d", cr.Status.HarborWebhookPolicyID)
 	}); done {
 		return ctrl.Result{}, err
 	}
@@ -76,16 +79,16 @@ func (r *WebhookPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 func (r *WebhookPolicyReconciler) reconcileWebhookPolicy(ctx context.Context, hc *harborclient.Client, cr *harborv1alpha1.WebhookPolicy) (ctrl.Result, error) {
 	projectKey, projectID, err := resolveProject(ctx, r.Options, r.Client, cr.Namespace, cr.Spec.ProjectRef)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	targets, targetsHash, err := r.buildTargets(ctx, cr)
 	if err != nil {
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	policy := harborclient.WebhookPolicy{
-		Name:        cr.Name,
+		Name:        cr.HarborName(),
 		Description: cr.Spec.Description,
 		Targets:     targets,
 		EventTypes:  cr.Spec.EventTypes,
@@ -95,30 +98,32 @@ func (r *WebhookPolicyReconciler) reconcileWebhookPolicy(ctx context.Context, hc
 		policy.ProjectID = projectID
 	}
 
-	if cr.Status.HarborWebhookPolicyID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy) {
+	if cr.Status.HarborWebhookPolicyID == 0 && allowsAdoption(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy) {
 		adopted, err := r.adoptExisting(ctx, hc, projectKey, cr)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		if adopted {
 			r.logger.Info("Adopted existing webhook policy", "ID", cr.Status.HarborWebhookPolicyID)
+			recordEvent(r.Recorder, cr, ReasonAdopted, actionAdopt, "Adopted existing Harbor webhook policy %d", cr.Status.HarborWebhookPolicyID)
 			return ctrl.Result{Requeue: true}, nil
 		}
 	}
 
 	if cr.Status.HarborWebhookPolicyID == 0 {
-		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		if err := requireCreationAllowed(r.Options, cr.Spec.CreationPolicy, cr.Spec.ManagementPolicy); err != nil {
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		id, err := hc.CreateWebhookPolicy(ctx, projectKey, policy)
 		if err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		cr.Status.HarborWebhookPolicyID = id
 		cr.Status.TargetsHash = targetsHash
-		if err := setReadyStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, "Created", "Webhook policy created"); err != nil {
+		if err := setReadyStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, ReasonCreated, "Webhook policy created"); err != nil {
 			return ctrl.Result{}, err
 		}
+		recordEvent(r.Recorder, cr, ReasonCreated, actionCreate, "Created Harbor webhook policy %d", id)
 		return returnWithDriftDetection(r.Options, &cr.Spec.HarborSpecBase)
 	}
 
@@ -129,22 +134,30 @@ func (r *WebhookPolicyReconciler) reconcileWebhookPolicy(ctx context.Context, hc
 				cr.Status.HarborWebhookPolicyID = 0
 			}, "Webhook policy not found in Harbor")
 		}
-		return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+		return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 	}
 
 	statusChanged := false
 	if webhookPolicyNeedsUpdate(policy, current) || (targetsHash != "" && targetsHash != cr.Status.TargetsHash) {
+		if err := recordDrift(ctx, r.Client, cr, &cr.Status.HarborStatusBase, &cr.Spec.HarborSpecBase, "WebhookPolicy",
+			normalizeWebhookPolicy(policy), normalizeWebhookPolicy(*current)); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !cr.Spec.ManagementPolicy.AllowsWrites() {
+			return reportObservedDrift(ctx, r.Options, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, &cr.Spec.HarborSpecBase, "Webhook policy")
+		}
 		if err := hc.UpdateWebhookPolicy(ctx, projectKey, cr.Status.HarborWebhookPolicyID, policy); err != nil {
-			return ctrl.Result{}, setErrorStatus(ctx, r.Client, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
+			return ctrl.Result{}, setErrorStatus(ctx, r.Client, r.Recorder, cr, &cr.Status.HarborStatusBase, cr.Generation, err)
 		}
 		r.logger.Info("Updated webhook policy", "ID", cr.Status.HarborWebhookPolicyID)
+		recordUpdate(r.Recorder, cr, &cr.Status.HarborStatusBase, "Updated Harbor webhook policy %d", cr.Status.HarborWebhookPolicyID)
 		if targetsHash != "" && targetsHash != cr.Status.TargetsHash {
 			cr.Status.TargetsHash = targetsHash
 			statusChanged = true
 		}
 	}
 
-	condChanged := markReady(&cr.Status.HarborStatusBase, cr.Generation, "Reconciled", "Webhook policy reconciled")
+	condChanged := markReady(&cr.Status.HarborStatusBase, cr.Generation, ReasonReconciled, "Webhook policy reconciled")
 	if statusChanged || condChanged {
 		if err := r.Status().Update(ctx, cr); err != nil {
 			return ctrl.Result{}, err
@@ -189,7 +202,7 @@ func (r *WebhookPolicyReconciler) adoptExisting(ctx context.Context, hc *harborc
 		return false, err
 	}
 	for _, p := range policies {
-		if strings.EqualFold(p.Name, cr.Name) {
+		if strings.EqualFold(p.Name, cr.HarborName()) {
 			cr.Status.HarborWebhookPolicyID = p.ID
 			return true, r.Status().Update(ctx, cr)
 		}
@@ -206,12 +219,13 @@ func (r *WebhookPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		func(obj client.Object) *harborv1alpha1.HarborConnectionReference {
 			return obj.(*harborv1alpha1.WebhookPolicy).Spec.HarborConnectionRef
 		},
+		webhookPolicySecretKeys,
 		"webhookpolicy",
 	)
 	if err != nil {
 		return err
 	}
-	return builder.Complete(r)
+	return builder.Complete(traceReconciler("WebhookPolicy", r))
 }
 
 func webhookPolicyNeedsUpdate(desired harborclient.WebhookPolicy, current *harborclient.WebhookPolicy) bool {
@@ -242,3 +256,13 @@ func normalizeWebhookPolicy(in harborclient.WebhookPolicy) harborclient.WebhookP
 func webhookTargetKey(t harborclient.WebhookTarget) string {
 	return fmt.Sprintf("%s|%s|%s|%t", t.Type, t.Address, t.PayloadFormat, t.SkipCertVerify)
 }
+
+// webhookPolicySecretKeys returns the Secrets a webhook policy reads.
+func webhookPolicySecretKeys(obj client.Object) []string {
+	policy := obj.(*harborv1alpha1.WebhookPolicy)
+	refs := make([]*harborv1alpha1.SecretReference, 0, len(policy.Spec.Targets))
+	for _, target := range policy.Spec.Targets {
+		refs = append(refs, target.AuthHeaderSecretRef)
+	}
+	return secretRefKeys(policy.Namespace, refs...)
+}
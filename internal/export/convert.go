@@ -0,0 +1,125 @@
+package export
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// derivedName joins parts into a valid object name for resources that Harbor
+// does not name, such as members and retention policies.
+func derivedName(parts ...string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(strings.Join(parts, "-")), "-")
+	if len(name) > validation.DNS1123SubdomainMaxLength {
+		name = name[:validation.DNS1123SubdomainMaxLength]
+	}
+	return strings.Trim(name, "-.")
+}
+
+// setHarborName sets spec.harborName on the kinds that have it. Other kinds
+// are not named in Harbor, so only their object name changes.
+func setHarborName(obj client.Object, name string) {
+	switch cr := obj.(type) {
+	case *harborv1alpha1.Registry:
+		cr.Spec.HarborName = name
+	case *harborv1alpha1.Project:
+		cr.Spec.HarborName = name
+	case *harborv1alpha1.User:
+		cr.Spec.HarborName = name
+	case *harborv1alpha1.Robot:
+		cr.Spec.HarborName = name
+	case *harborv1alpha1.ReplicationPolicy:
+		cr.Spec.HarborName = name
+	case *harborv1alpha1.WebhookPolicy:
+		cr.Spec.HarborName = name
+	case *harborv1alpha1.Label:
+		cr.Spec.HarborName = name
+	}
+}
+
+func secretKeySelector(ref harborv1alpha1.SecretReference) corev1.SecretKeySelector {
+	return corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+		Key:                  ref.Key,
+	}
+}
+
+func jsonValue(v any) apiextensionsv1.JSON {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return apiextensionsv1.JSON{}
+	}
+	return apiextensionsv1.JSON{Raw: raw}
+}
+
+func jsonMap(in map[string]any) map[string]apiextensionsv1.JSON {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string]apiextensionsv1.JSON, len(in))
+	for k, v := range in {
+		out[k] = jsonValue(v)
+	}
+	return out
+}
+
+// anyMap returns v when it decoded from a JSON object and nil otherwise.
+func anyMap(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
+
+func retentionSelectors(in []harborclient.RetentionSelector) []harborv1alpha1.RetentionSelector {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]harborv1alpha1.RetentionSelector, len(in))
+	for i, s := range in {
+		out[i] = harborv1alpha1.RetentionSelector{Kind: s.Kind, Decoration: s.Decoration, Pattern: s.Pattern, Extras: s.Extras}
+	}
+	return out
+}
+
+func retentionScopeSelectors(in map[string][]harborclient.RetentionSelector) map[string][]harborv1alpha1.RetentionSelector {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string][]harborv1alpha1.RetentionSelector, len(in))
+	for k, v := range in {
+		out[k] = retentionSelectors(v)
+	}
+	return out
+}
+
+func immutableSelectors(in []harborclient.ImmutableSelector) []harborv1alpha1.ImmutableSelector {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]harborv1alpha1.ImmutableSelector, len(in))
+	for i, s := range in {
+		out[i] = harborv1alpha1.ImmutableSelector{Kind: s.Kind, Decoration: s.Decoration, Pattern: s.Pattern, Extras: s.Extras}
+	}
+	return out
+}
+
+func immutableScopeSelectors(in map[string][]harborclient.ImmutableSelector) map[string][]harborv1alpha1.ImmutableSelector {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string][]harborv1alpha1.ImmutableSelector, len(in))
+	for k, v := range in {
+		out[k] = immutableSelectors(v)
+	}
+	return out
+}
@@ -0,0 +1,803 @@
+// Package export reads an existing Harbor instance and builds the operator's
+// custom resources that describe it, so hand-made Harbor objects can be
+// brought under management with creationPolicy Adopt.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+)
+
+// Options control how exported resources are written.
+type Options struct {
+	// Namespace is the namespace of every exported resource. References
+	// between exported resources stay within it.
+	Namespace string
+	// HarborConnectionRef is set on every exported resource when not nil.
+	HarborConnectionRef *harborv1alpha1.HarborConnectionReference
+}
+
+// Result holds the exported resources in apply order and the Harbor objects
+// that could not be exported or need manual follow-up.
+type Result struct {
+	Objects  []client.Object
+	Warnings []string
+}
+
+// memberRoles maps Harbor project role IDs to Member roles.
+var memberRoles = map[int]string{
+	1: "admin",
+	2: "developer",
+	3: "guest",
+	4: "maintainer",
+}
+
+type exporter struct {
+	hc     *harborclient.Client
+	opts   Options
+	result Result
+	names  map[string]bool
+
+	// Harbor IDs and names of the exported projects, registries, and users
+	// mapped to their object names, used to rewrite references into
+	// projectRef, registryRef, and userRef.
+	projects     []harborclient.Project
+	projectNames map[string]string
+	registries   map[int]string
+	userNames    map[string]string
+
+	users  map[string]harborclient.User
+	groups map[string]harborclient.UserGroup
+}
+
+// Export reads hc and returns the resources that describe it.
+func Export(ctx context.Context, hc *harborclient.Client, opts Options) (*Result, error) {
+	e := &exporter{
+		hc:           hc,
+		opts:         opts,
+		names:        map[string]bool{},
+		projectNames: map[string]string{},
+		registries:   map[int]string{},
+		userNames:    map[string]string{},
+		users:        map[string]harborclient.User{},
+		groups:       map[string]harborclient.UserGroup{},
+	}
+	steps := []struct {
+		what string
+		fn   func(context.Context) error
+	}{
+		{"registries", e.exportRegistries},
+		{"projects", e.exportProjects},
+		{"project resources", e.exportProjectResources},
+		{"robots", e.exportRobots},
+		{"replication policies", e.exportReplicationPolicies},
+		{"global labels", e.exportGlobalLabels},
+		{"configuration", e.exportConfiguration},
+		{"schedules", e.exportSchedules},
+	}
+	for _, step := range steps {
+		if err := step.fn(ctx); err != nil {
+			return nil, fmt.Errorf("export %s: %w", step.what, err)
+		}
+	}
+	return &e.result, nil
+}
+
+func (e *exporter) warnf(format string, args ...any) {
+	e.result.Warnings = append(e.result.Warnings, fmt.Sprintf(format, args...))
+}
+
+// add appends obj to the result unless another object of the same kind
+// already has its name. A Harbor name that is not a valid object name is
+// replaced by a derived name and kept in spec.harborName.
+func (e *exporter) add(obj client.Object, kind string) bool {
+	name := obj.GetName()
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		derived := derivedName(name)
+		if len(validation.IsDNS1123Subdomain(derived)) > 0 {
+			e.warnf("skipping %s %q: not a valid Kubernetes name: %s", kind, name, strings.Join(errs, "; "))
+			return false
+		}
+		setHarborName(obj, name)
+		obj.SetName(derived)
+		name = derived
+	}
+	key := kind + "/" + name
+	if e.names[key] {
+		e.warnf("skipping %s %q: another %s has the same name", kind, name, kind)
+		return false
+	}
+	e.names[key] = true
+	obj.GetObjectKind().SetGroupVersionKind(harborv1alpha1.GroupVersion.WithKind(kind))
+	obj.SetNamespace(e.opts.Namespace)
+	e.result.Objects = append(e.result.Objects, obj)
+	return true
+}
+
+func (e *exporter) specBase() harborv1alpha1.HarborSpecBase {
+	return harborv1alpha1.HarborSpecBase{HarborConnectionRef: e.connectionRef()}
+}
+
+func (e *exporter) connectionRef() *harborv1alpha1.HarborConnectionReference {
+	if e.opts.HarborConnectionRef == nil {
+		return nil
+	}
+	ref := *e.opts.HarborConnectionRef
+	return &ref
+}
+
+// secretRef returns a placeholder reference to a Secret the user has to
+// create before applying the exported resources.
+func (e *exporter) secretRef(owner, kind, key string, parts ...string) harborv1alpha1.SecretReference {
+	name := derivedName(parts...)
+	e.warnf("%s %q references Secret %q key %q, which must be created before it is applied", kind, owner, name, key)
+	return harborv1alpha1.SecretReference{Name: name, Key: key}
+}
+
+func objectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: name}
+}
+
+func (e *exporter) exportRegistries(ctx context.Context) error {
+	registries, err := e.hc.ListRegistries(ctx)
+	if err != nil {
+		return err
+	}
+	for _, reg := range registries {
+		cr := &harborv1alpha1.Registry{
+			ObjectMeta: objectMeta(reg.Name),
+			Spec: harborv1alpha1.RegistrySpec{
+				HarborSpecBase: e.specBase(),
+				CreationPolicy: harborv1alpha1.CreationPolicyAdopt,
+				Type:           reg.Type,
+				Description:    reg.Description,
+				URL:            reg.URL,
+				CACertificate:  ptr.Deref(reg.CACertificate, ""),
+				Insecure:       reg.Insecure,
+			},
+		}
+		if c := reg.Credential; c.AccessKey != "" || c.AccessSecret != "" {
+			cr.Spec.Credential = &harborv1alpha1.RegistryCredentialSpec{
+				Type:                  c.Type,
+				AccessKeySecretRef:    e.secretRef(reg.Name, "Registry", "access_key", reg.Name, "credentials"),
+				AccessSecretSecretRef: e.secretRef(reg.Name, "Registry", "access_secret", reg.Name, "credentials"),
+			}
+		}
+		if e.add(cr, "Registry") {
+			e.registries[reg.ID] = cr.Name
+		}
+	}
+	return nil
+}
+
+func (e *exporter) registryRef(id int) (*harborv1alpha1.RegistryReference, bool) {
+	if id == 0 {
+		return nil, true
+	}
+	name, ok := e.registries[id]
+	if !ok {
+		return nil, false
+	}
+	return &harborv1alpha1.RegistryReference{Name: name}, true
+}
+
+func (e *exporter) exportProjects(ctx context.Context) error {
+	projects, err := e.hc.ListProjects(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range projects {
+		registryRef, ok := e.registryRef(p.RegistryID)
+		if !ok {
+			e.warnf("skipping Project %q: its proxy cache registry %d was not exported", p.Name, p.RegistryID)
+			continue
+		}
+		cr := &harborv1alpha1.Project{
+			ObjectMeta: objectMeta(p.Name),
+			Spec: harborv1alpha1.ProjectSpec{
+				HarborSpecBase: e.specBase(),
+				CreationPolicy: harborv1alpha1.CreationPolicyAdopt,
+				Public:         p.Metadata.Public == "true",
+				Owner:          p.OwnerName,
+				Metadata:       projectMetadata(p.Metadata),
+				CVEAllowlist:   cveAllowlist(p.CVEAllowlist),
+				RegistryRef:    registryRef,
+			},
+		}
+		if e.add(cr, "Project") {
+			e.projects = append(e.projects, p)
+			e.projectNames[p.Name] = cr.Name
+		}
+	}
+	return nil
+}
+
+// projectRef returns the reference to the exported Project of p.
+func (e *exporter) projectRef(p harborclient.Project) harborv1alpha1.ProjectReference {
+	return harborv1alpha1.ProjectReference{Name: e.projectNames[p.Name]}
+}
+
+func projectMetadata(m harborclient.ProjectMetadata) *harborv1alpha1.ProjectMetadata {
+	out := harborv1alpha1.ProjectMetadata{
+		EnableContentTrust:       ptr.Deref(m.EnableContentTrust, ""),
+		EnableContentTrustCosign: ptr.Deref(m.EnableContentTrustCosign, ""),
+		PreventVul:               ptr.Deref(m.PreventVul, ""),
+		Severity:                 ptr.Deref(m.Severity, ""),
+		AutoScan:                 ptr.Deref(m.AutoScan, ""),
+		AutoSBOMGeneration:       ptr.Deref(m.AutoSBOMGeneration, ""),
+		ReuseSysCVEAllowlist:     ptr.Deref(m.ReuseSysCVEAllowlist, ""),
+		RetentionID:              ptr.Deref(m.RetentionID, ""),
+		ProxySpeedKB:             ptr.Deref(m.ProxySpeedKB, ""),
+	}
+	if out == (harborv1alpha1.ProjectMetadata{}) {
+		return nil
+	}
+	return &out
+}
+
+func cveAllowlist(a harborclient.CVEAllowlist) *harborv1alpha1.CVEAllowlist {
+	if len(a.Items) == 0 && a.ExpiresAt == nil {
+		return nil
+	}
+	out := &harborv1alpha1.CVEAllowlist{
+		ID:        a.ID,
+		ProjectID: a.ProjectID,
+		ExpiresAt: ptr.Deref(a.ExpiresAt, 0),
+	}
+	for _, item := range a.Items {
+		out.Items = append(out.Items, harborv1alpha1.CVEAllowlistItem{CveID: item.CVEID})
+	}
+	return out
+}
+
+// exportProjectResources exports the resources that belong to a single
+// project.
+func (e *exporter) exportProjectResources(ctx context.Context) error {
+	users, err := e.hc.ListUsers(ctx, "")
+	if err != nil {
+		return err
+	}
+	for _, u := range users {
+		e.users[u.Username] = u
+	}
+	groups, err := e.hc.ListUserGroups(ctx)
+	if err != nil {
+		return err
+	}
+	for _, g := range groups {
+		e.groups[g.GroupName] = g
+	}
+
+	for _, p := range e.projects {
+		for _, fn := range []func(context.Context, harborclient.Project) error{
+			e.exportMembers,
+			e.exportRetention,
+			e.exportImmutableRules,
+			e.exportWebhookPolicies,
+			e.exportProjectLabels,
+			e.exportQuota,
+		} {
+			if err := fn(ctx, p); err != nil {
+				return fmt.Errorf("project %s: %w", p.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportMembers(ctx context.Context, p harborclient.Project) error {
+	members, err := e.hc.ListProjectMembers(ctx, p.Name)
+	if err != nil {
+		return err
+	}
+	for _, m := range members {
+		if m.EntityType == "u" && strings.EqualFold(m.EntityName, p.OwnerName) {
+			continue
+		}
+		role, ok := memberRoles[m.RoleID]
+		if !ok {
+			e.warnf("skipping member %q of project %q: unknown role %d", m.EntityName, p.Name, m.RoleID)
+			continue
+		}
+		cr := &harborv1alpha1.Member{
+			ObjectMeta: objectMeta(derivedName(p.Name, m.EntityName)),
+			Spec: harborv1alpha1.MemberSpec{
+				HarborSpecBase: e.specBase(),
+				CreationPolicy: harborv1alpha1.CreationPolicyAdopt,
+				ProjectRef:     e.projectRef(p),
+				Role:           role,
+			},
+		}
+		switch m.EntityType {
+		case "u":
+			name, ok := e.exportUser(m.EntityName)
+			if !ok {
+				continue
+			}
+			cr.Spec.MemberUser = &harborv1alpha1.MemberUser{UserRef: harborv1alpha1.UserReference{Name: name}}
+		case "g":
+			name, ok := e.exportGroup(m.EntityName)
+			if !ok {
+				continue
+			}
+			cr.Spec.MemberGroup = &harborv1alpha1.MemberGroup{GroupClaimRef: harborv1alpha1.UserGroupClaimReference{Name: name}}
+		default:
+			e.warnf("skipping member %q of project %q: unknown entity type %q", m.EntityName, p.Name, m.EntityType)
+			continue
+		}
+		e.add(cr, "Member")
+	}
+	return nil
+}
+
+// exportUser exports the User a member refers to the first time it is seen
+// and returns its name.
+func (e *exporter) exportUser(username string) (string, bool) {
+	if name, ok := e.userNames[username]; ok {
+		return name, true
+	}
+	u, ok := e.users[username]
+	if !ok {
+		e.warnf("skipping member %q: the user was not found in Harbor", username)
+		return "", false
+	}
+	cr := &harborv1alpha1.User{
+		ObjectMeta: objectMeta(u.Username),
+		Spec: harborv1alpha1.UserSpec{
+			HarborSpecBase: e.specBase(),
+			CreationPolicy: harborv1alpha1.CreationPolicyAdopt,
+			Email:          u.Email,
+			Realname:       u.Realname,
+			Comment:        u.Comment,
+			PasswordSecretRef: secretKeySelector(
+				e.secretRef(u.Username, "User", "password", u.Username, "password"),
+			),
+		},
+	}
+	if !e.add(cr, "User") {
+		return "", false
+	}
+	e.userNames[username] = cr.Name
+	return cr.Name, true
+}
+
+// exportGroup exports the UserGroupClaim a member refers to the first time it
+// is seen and returns its name.
+func (e *exporter) exportGroup(groupName string) (string, bool) {
+	name := derivedName(groupName)
+	if e.names["UserGroupClaim/"+name] {
+		return name, true
+	}
+	g, ok := e.groups[groupName]
+	if !ok {
+		e.warnf("skipping member %q: the user group was not found in Harbor", groupName)
+		return "", false
+	}
+	return name, e.add(&harborv1alpha1.UserGroupClaim{
+		ObjectMeta: objectMeta(name),
+		Spec: harborv1alpha1.UserGroupClaimSpec{
+			HarborClaimSpecBase: harborv1alpha1.HarborClaimSpecBase{HarborConnectionRef: e.connectionRef()},
+			GroupName:           g.GroupName,
+			GroupType:           g.GroupType,
+			LDAPGroupDN:         g.LDAPGroupDN,
+		},
+	}, "UserGroupClaim")
+}
+
+func (e *exporter) exportRetention(ctx context.Context, p harborclient.Project) error {
+	raw := ptr.Deref(p.Metadata.RetentionID, "")
+	if raw == "" {
+		return nil
+	}
+	id, err := strconv.Atoi(raw)
+	if err != nil {
+		e.warnf("skipping retention policy of project %q: invalid retention_id %q", p.Name, raw)
+		return nil
+	}
+	policy, err := e.hc.GetRetentionByID(ctx, id)
+	if harborclient.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	rules := slices.Clone(policy.Rules)
+	sort.SliceStable(rules, func(i, j int) bool { return rules[i].Priority < rules[j].Priority })
+	cr := &harborv1alpha1.RetentionPolicy{
+		ObjectMeta: objectMeta(derivedName(p.Name, "retention")),
+		Spec: harborv1alpha1.RetentionPolicySpec{
+			HarborSpecBase: e.specBase(),
+			ProjectRef:     ptr.To(e.projectRef(p)),
+			Rules:          make([]harborv1alpha1.RetentionRule, 0, len(rules)),
+		},
+	}
+	for _, rule := range rules {
+		cr.Spec.Rules = append(cr.Spec.Rules, harborv1alpha1.RetentionRule{
+			Disabled:       rule.Disabled,
+			Action:         rule.Action,
+			Template:       rule.Template,
+			Params:         jsonMap(rule.Params),
+			TagSelectors:   retentionSelectors(rule.TagSelectors),
+			ScopeSelectors: retentionScopeSelectors(rule.ScopeSelectors),
+		})
+	}
+	if t := policy.Trigger; t.Kind != "" {
+		cr.Spec.Trigger = &harborv1alpha1.RetentionTrigger{
+			Kind:       t.Kind,
+			Settings:   jsonMap(anyMap(t.Settings)),
+			References: jsonMap(anyMap(t.References)),
+		}
+	}
+	e.add(cr, "RetentionPolicy")
+	return nil
+}
+
+func (e *exporter) exportImmutableRules(ctx context.Context, p harborclient.Project) error {
+	rules, err := e.hc.ListImmutableRules(ctx, p.Name)
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		e.add(&harborv1alpha1.ImmutableTagRule{
+			ObjectMeta: objectMeta(derivedName(p.Name, "immutable", strconv.Itoa(rule.ID))),
+			Spec: harborv1alpha1.ImmutableTagRuleSpec{
+				HarborSpecBase: e.specBase(),
+				CreationPolicy: harborv1alpha1.CreationPolicyAdopt,
+				ProjectRef:     ptr.To(e.projectRef(p)),
+				Disabled:       rule.Disabled,
+				Action:         rule.Action,
+				Template:       rule.Template,
+				Params:         jsonMap(rule.Params),
+				TagSelectors:   immutableSelectors(rule.TagSelectors),
+				ScopeSelectors: immutableScopeSelectors(rule.ScopeSelectors),
+				Priority:       rule.Priority,
+			},
+		}, "ImmutableTagRule")
+	}
+	return nil
+}
+
+func (e *exporter) exportWebhookPolicies(ctx context.Context, p harborclient.Project) error {
+	policies, err := e.hc.ListWebhookPolicies(ctx, p.Name)
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		cr := &harborv1alpha1.WebhookPolicy{
+			ObjectMeta: objectMeta(policy.Name),
+			Spec: harborv1alpha1.WebhookPolicySpec{
+				HarborSpecBase: e.specBase(),
+				CreationPolicy: harborv1alpha1.CreationPolicyAdopt,
+				ProjectRef:     ptr.To(e.projectRef(p)),
+				Description:    policy.Description,
+				Enabled:        ptr.To(policy.Enabled),
+				EventTypes:     policy.EventTypes,
+				Targets:        make([]harborv1alpha1.WebhookTargetSpec, 0, len(policy.Targets)),
+			},
+		}
+		for i, t := range policy.Targets {
+			target := harborv1alpha1.WebhookTargetSpec{
+				Type:           t.Type,
+				Address:        t.Address,
+				PayloadFormat:  string(t.PayloadFormat),
+				SkipCertVerify: t.SkipCertVerify,
+			}
+			if t.AuthHeader != "" {
+				parts := []string{policy.Name, "auth-header"}
+				if len(policy.Targets) > 1 {
+					parts = append(parts, strconv.Itoa(i))
+				}
+				ref := e.secretRef(policy.Name, "WebhookPolicy", "authHeader", parts...)
+				target.AuthHeaderSecretRef = &ref
+			}
+			cr.Spec.Targets = append(cr.Spec.Targets, target)
+		}
+		e.add(cr, "WebhookPolicy")
+	}
+	return nil
+}
+
+func (e *exporter) exportProjectLabels(ctx context.Context, p harborclient.Project) error {
+	labels, err := e.hc.ListLabels(ctx, "", "p", &p.ProjectID)
+	if err != nil {
+		return err
+	}
+	for _, l := range labels {
+		e.add(&harborv1alpha1.Label{
+			ObjectMeta: objectMeta(l.Name),
+			Spec: harborv1alpha1.LabelSpec{
+				HarborSpecBase: e.specBase(),
+				CreationPolicy: harborv1alpha1.CreationPolicyAdopt,
+				Description:    l.Description,
+				Color:          l.Color,
+				Scope:          "p",
+				ProjectRef:     ptr.To(e.projectRef(p)),
+			},
+		}, "Label")
+	}
+	return nil
+}
+
+func (e *exporter) exportGlobalLabels(ctx context.Context) error {
+	labels, err := e.hc.ListLabels(ctx, "", "g", nil)
+	if err != nil {
+		return err
+	}
+	for _, l := range labels {
+		e.add(&harborv1alpha1.Label{
+			ObjectMeta: objectMeta(l.Name),
+			Spec: harborv1alpha1.LabelSpec{
+				HarborSpecBase: e.specBase(),
+				CreationPolicy: harborv1alpha1.CreationPolicyAdopt,
+				Description:    l.Description,
+				Color:          l.Color,
+				Scope:          "g",
+			},
+		}, "Label")
+	}
+	return nil
+}
+
+func (e *exporter) exportQuota(ctx context.Context, p harborclient.Project) error {
+	quotas, err := e.hc.ListQuotas(ctx, "project", strconv.Itoa(p.ProjectID))
+	if err != nil {
+		return err
+	}
+	if len(quotas) == 0 {
+		return nil
+	}
+	hard := make(map[string]int64, len(quotas[0].Hard))
+	for resource, limit := range quotas[0].Hard {
+		hard[resource] = int64(limit)
+	}
+	e.add(&harborv1alpha1.Quota{
+		ObjectMeta: objectMeta(e.projectNames[p.Name]),
+		Spec: harborv1alpha1.QuotaSpec{
+			HarborSpecBase: e.specBase(),
+			ProjectRef:     ptr.To(e.projectRef(p)),
+			Hard:           hard,
+		},
+	}, "Quota")
+	return nil
+}
+
+func (e *exporter) exportRobots(ctx context.Context) error {
+	robots, err := e.hc.ListRobots(ctx, "")
+	if err != nil {
+		return err
+	}
+robots:
+	for _, robot := range robots {
+		name := robotName(robot.Name)
+		cr := &harborv1alpha1.Robot{
+			ObjectMeta: objectMeta(name),
+			Spec: harborv1alpha1.RobotSpec{
+				HarborSpecBase: e.specBase(),
+				CreationPolicy: harborv1alpha1.CreationPolicyAdopt,
+				Description:    robot.Description,
+				Level:          robot.Level,
+				Permissions:    make([]harborv1alpha1.RobotPermission, 0, len(robot.Permissions)),
+				Duration:       ptr.Deref(robot.Duration, 0),
+			},
+		}
+		if robot.Disable {
+			cr.Spec.Disable = ptr.To(true)
+		}
+		for _, perm := range robot.Permissions {
+			out := harborv1alpha1.RobotPermission{Kind: perm.Kind}
+			if perm.Kind == "project" && perm.Namespace != "*" {
+				project, ok := e.projectNames[perm.Namespace]
+				if !ok {
+					e.warnf("skipping Robot %q: project %q was not exported", robot.Name, perm.Namespace)
+					continue robots
+				}
+				out.ProjectRef = &harborv1alpha1.ProjectReference{Name: project}
+			}
+			for _, a := range perm.Access {
+				access := harborv1alpha1.RobotAccess{
+					Resource: harborv1alpha1.RobotResource(a.Resource),
+					Action:   harborv1alpha1.RobotAction(a.Action),
+				}
+				if a.Effect != "allow" {
+					access.Effect = a.Effect
+				}
+				out.Access = append(out.Access, access)
+			}
+			cr.Spec.Permissions = append(cr.Spec.Permissions, out)
+		}
+		e.add(cr, "Robot")
+	}
+	return nil
+}
+
+// robotName strips the robot prefix and project scope from a Harbor robot
+// name, leaving the name the Robot controller creates it under.
+func robotName(full string) string {
+	if i := strings.LastIndexAny(full, "+$"); i >= 0 {
+		return full[i+1:]
+	}
+	return full
+}
+
+func (e *exporter) exportReplicationPolicies(ctx context.Context) error {
+	policies, err := e.hc.ListReplicationPolicies(ctx, "")
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		src, srcOK := e.registryRef(policy.SrcRegistry.ID)
+		dest, destOK := e.registryRef(policy.DestRegistry.ID)
+		if !srcOK || !destOK {
+			e.warnf("skipping ReplicationPolicy %q: its registry was not exported", policy.Name)
+			continue
+		}
+		cr := &harborv1alpha1.ReplicationPolicy{
+			ObjectMeta: objectMeta(policy.Name),
+			Spec: harborv1alpha1.ReplicationPolicySpec{
+				HarborSpecBase:            e.specBase(),
+				CreationPolicy:            harborv1alpha1.CreationPolicyAdopt,
+				Description:               policy.Description,
+				SourceRegistryRef:         src,
+				DestinationRegistryRef:    dest,
+				DestNamespace:             policy.DestNamespace,
+				DestNamespaceReplaceCount: policy.DestNamespaceReplaceCount,
+				ReplicateDeletion:         ptr.To(policy.ReplicateDeletion),
+				Override:                  ptr.To(policy.Override),
+				Enabled:                   ptr.To(policy.Enabled),
+				Speed:                     policy.Speed,
+				CopyByChunk:               policy.CopyByChunk,
+				SingleActiveReplication:   policy.SingleActiveReplication,
+			},
+		}
+		if t := policy.Trigger; t.Type != "" {
+			cr.Spec.Trigger = &harborv1alpha1.ReplicationTriggerSpec{Type: t.Type}
+			if t.TriggerSettings.Cron != "" {
+				cr.Spec.Trigger.Settings = &harborv1alpha1.ReplicationTriggerSettings{Cron: t.TriggerSettings.Cron}
+			}
+		}
+		for _, f := range policy.Filters {
+			cr.Spec.Filters = append(cr.Spec.Filters, harborv1alpha1.ReplicationFilterSpec{
+				Type:       f.Type,
+				Value:      jsonValue(f.Value),
+				Decoration: f.Decoration,
+			})
+		}
+		e.add(cr, "ReplicationPolicy")
+	}
+	return nil
+}
+
+func (e *exporter) exportConfiguration(ctx context.Context) error {
+	items, err := e.hc.GetConfigurations(ctx)
+	if err != nil {
+		return err
+	}
+	settings := map[string]harborv1alpha1.ConfigurationValue{}
+	for key, item := range items {
+		if !item.Editable || len(item.Value) == 0 || string(item.Value) == "null" {
+			continue
+		}
+		if sensitiveConfigurationKey(key) {
+			ref := e.secretRef("harbor", "Configuration", key, "harbor", "configuration")
+			settings[key] = harborv1alpha1.ConfigurationValue{
+				ValueFrom: &harborv1alpha1.ConfigurationValueSource{SecretKeyRef: ref},
+			}
+			continue
+		}
+		settings[key] = harborv1alpha1.ConfigurationValue{
+			Value: &apiextensionsv1.JSON{Raw: slices.Clone(item.Value)},
+		}
+	}
+	if len(settings) == 0 {
+		return nil
+	}
+	e.add(&harborv1alpha1.Configuration{
+		ObjectMeta: objectMeta("harbor"),
+		Spec: harborv1alpha1.ConfigurationSpec{
+			HarborSpecBase: e.specBase(),
+			Settings:       settings,
+		},
+	}, "Configuration")
+	return nil
+}
+
+// sensitiveConfigurationKey reports whether a configuration setting holds a
+// credential. Harbor does not return their values, but a placeholder keeps the
+// key visible in the export.
+func sensitiveConfigurationKey(key string) bool {
+	key = strings.ToLower(key)
+	return strings.Contains(key, "password") || strings.Contains(key, "secret")
+}
+
+func (e *exporter) exportSchedules(ctx context.Context) error {
+	gc, err := e.hc.GetGCSchedule(ctx)
+	if err != nil && !harborclient.IsNotFound(err) {
+		return fmt.Errorf("GC schedule: %w", err)
+	}
+	if err == nil && scheduled(gc.Schedule) {
+		e.add(&harborv1alpha1.GCSchedule{
+			ObjectMeta: objectMeta("gc"),
+			Spec: harborv1alpha1.GCScheduleSpec{
+				HarborSpecBase: e.specBase(),
+				Schedule:       scheduleSpec(gc.Schedule),
+				Parameters:     jsonMap(jobParameters(gc.JobParameters)),
+			},
+		}, "GCSchedule")
+	}
+
+	purge, err := e.hc.GetPurgeSchedule(ctx)
+	if err != nil && !harborclient.IsNotFound(err) {
+		return fmt.Errorf("purge audit schedule: %w", err)
+	}
+	if err == nil && scheduled(purge.Schedule) {
+		params := jobParameters(purge.JobParameters)
+		hours, _ := params["audit_retention_hour"].(float64)
+		eventTypes, _ := params["include_event_types"].(string)
+		dryRun, _ := params["dry_run"].(bool)
+		e.add(&harborv1alpha1.PurgeAuditSchedule{
+			ObjectMeta: objectMeta("purge-audit"),
+			Spec: harborv1alpha1.PurgeAuditScheduleSpec{
+				HarborSpecBase: e.specBase(),
+				Schedule:       scheduleSpec(purge.Schedule),
+				Parameters: harborv1alpha1.PurgeAuditParameters{
+					AuditRetentionHour: int(hours),
+					IncludeEventTypes:  eventTypes,
+					DryRun:             dryRun,
+				},
+			},
+		}, "PurgeAuditSchedule")
+	}
+
+	scanAll, err := e.hc.GetScanAllSchedule(ctx)
+	if err != nil && !harborclient.IsNotFound(err) {
+		return fmt.Errorf("scan all schedule: %w", err)
+	}
+	if err == nil && scheduled(scanAll.Schedule) {
+		e.add(&harborv1alpha1.ScanAllSchedule{
+			ObjectMeta: objectMeta("scan-all"),
+			Spec: harborv1alpha1.ScanAllScheduleSpec{
+				HarborSpecBase: e.specBase(),
+				Schedule:       scheduleSpec(scanAll.Schedule),
+				Parameters:     jsonMap(scanAll.Parameters),
+			},
+		}, "ScanAllSchedule")
+	}
+	return nil
+}
+
+// scheduled reports whether Harbor has a schedule configured. Harbor reports
+// an unscheduled job with type None.
+func scheduled(s harborclient.ScheduleObj) bool {
+	return s.Type != "" && s.Type != "None"
+}
+
+func scheduleSpec(s harborclient.ScheduleObj) harborv1alpha1.ScheduleSpec {
+	return harborv1alpha1.ScheduleSpec{Type: s.Type, Cron: s.Cron}
+}
+
+// jobParameters decodes the JSON job parameters Harbor stores on schedules.
+func jobParameters(raw string) map[string]any {
+	if raw == "" {
+		return nil
+	}
+	var params map[string]any
+	if err := json.Unmarshal([]byte(raw), &params); err != nil {
+		return nil
+	}
+	return params
+}
@@ -0,0 +1,159 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	harborv1alpha1 "github.com/rkthtrifork/harbor-operator/api/v1alpha1"
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+	"github.com/rkthtrifork/harbor-operator/internal/harborfake"
+)
+
+const testPassword = "Harbor12345"
+
+func TestExportRewritesReferencesAndSecrets(t *testing.T) {
+	t.Parallel()
+	server := harborfake.New(harborfake.Options{Username: harborfake.AdminUsername, Password: testPassword})
+	t.Cleanup(server.Close)
+	hc := harborclient.New(server.URL, harborfake.AdminUsername, testPassword)
+	ctx := context.Background()
+
+	registryID, err := hc.CreateRegistry(ctx, harborclient.CreateRegistryRequest{
+		Name:       "dockerhub",
+		Type:       "docker-hub",
+		URL:        "https://hub.docker.com",
+		Credential: harborclient.RegistryCredential{Type: "basic", AccessKey: "user", AccessSecret: "s3cret"},
+	})
+	if err != nil {
+		t.Fatalf("CreateRegistry returned error: %v", err)
+	}
+	if _, err := hc.CreateProject(ctx, harborclient.CreateProjectRequest{ProjectName: "demo", Public: ptr.To(true)}); err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	if _, err := hc.CreateProject(ctx, harborclient.CreateProjectRequest{ProjectName: "Invalid_Name"}); err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	if _, err := hc.CreateUser(ctx, harborclient.CreateUserRequest{Username: "alice", Email: "alice@example.com", Password: testPassword}); err != nil {
+		t.Fatalf("CreateUser returned error: %v", err)
+	}
+	member := harborclient.CreateMemberRequest{RoleID: 4, MemberUser: harborclient.MemberUser{Username: "alice"}}
+	if _, err := hc.CreateProjectMember(ctx, "demo", member); err != nil {
+		t.Fatalf("CreateProjectMember returned error: %v", err)
+	}
+	if _, err := hc.CreateRobot(ctx, harborclient.RobotCreateRequest{
+		Name:     "ci",
+		Level:    "project",
+		Duration: -1,
+		Permissions: []harborclient.RobotPermission{{
+			Kind:      "project",
+			Namespace: "demo",
+			Access:    []harborclient.Access{{Resource: "repository", Action: "pull"}},
+		}},
+	}); err != nil {
+		t.Fatalf("CreateRobot returned error: %v", err)
+	}
+	if _, err := hc.CreateReplicationPolicy(ctx, harborclient.ReplicationPolicy{
+		Name:        "mirror",
+		SrcRegistry: harborclient.Registry{ID: registryID},
+		Enabled:     true,
+	}); err != nil {
+		t.Fatalf("CreateReplicationPolicy returned error: %v", err)
+	}
+
+	result, err := Export(ctx, hc, Options{
+		Namespace:           "harbor",
+		HarborConnectionRef: &harborv1alpha1.HarborConnectionReference{Name: "prod", Kind: harborv1alpha1.HarborConnectionReferenceKindCluster},
+	})
+	if err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+
+	registry := find[*harborv1alpha1.Registry](t, result.Objects, "dockerhub")
+	if registry.Spec.CreationPolicy != harborv1alpha1.CreationPolicyAdopt {
+		t.Fatalf("expected creationPolicy Adopt, got %q", registry.Spec.CreationPolicy)
+	}
+	if c := registry.Spec.Credential; c == nil || c.AccessSecretSecretRef != (harborv1alpha1.SecretReference{Name: "dockerhub-credentials", Key: "access_secret"}) {
+		t.Fatalf("expected the registry credential to be a Secret placeholder, got %+v", c)
+	}
+
+	project := find[*harborv1alpha1.Project](t, result.Objects, "demo")
+	if !project.Spec.Public || project.Namespace != "harbor" || project.Spec.HarborConnectionRef.Name != "prod" {
+		t.Fatalf("unexpected project: %+v", project)
+	}
+
+	robot := find[*harborv1alpha1.Robot](t, result.Objects, "ci")
+	if len(robot.Spec.Permissions) != 1 || robot.Spec.Permissions[0].ProjectRef == nil || robot.Spec.Permissions[0].ProjectRef.Name != "demo" {
+		t.Fatalf("expected the robot permission to reference the project, got %+v", robot.Spec.Permissions)
+	}
+
+	m := find[*harborv1alpha1.Member](t, result.Objects, "demo-alice")
+	if m.Spec.Role != "maintainer" || m.Spec.ProjectRef.Name != "demo" || m.Spec.MemberUser.UserRef.Name != "alice" {
+		t.Fatalf("unexpected member: %+v", m.Spec)
+	}
+	user := find[*harborv1alpha1.User](t, result.Objects, "alice")
+	if user.Spec.PasswordSecretRef.Name != "alice-password" {
+		t.Fatalf("expected the user password to be a Secret placeholder, got %+v", user.Spec.PasswordSecretRef)
+	}
+
+	policy := find[*harborv1alpha1.ReplicationPolicy](t, result.Objects, "mirror")
+	if policy.Spec.SourceRegistryRef == nil || policy.Spec.SourceRegistryRef.Name != "dockerhub" || policy.Spec.DestinationRegistryRef != nil {
+		t.Fatalf("expected the source registry to be a registryRef, got %+v", policy.Spec)
+	}
+
+	renamed := find[*harborv1alpha1.Project](t, result.Objects, "invalid-name")
+	if renamed.Spec.HarborName != "Invalid_Name" {
+		t.Fatalf("expected the invalid Harbor name to be kept in spec.harborName, got %q", renamed.Spec.HarborName)
+	}
+	if q := find[*harborv1alpha1.Quota](t, result.Objects, "invalid-name"); q.Spec.ProjectRef == nil || q.Spec.ProjectRef.Name != "invalid-name" {
+		t.Fatalf("expected the quota to reference the renamed project, got %+v", q.Spec.ProjectRef)
+	}
+
+	var out bytes.Buffer
+	if err := WriteYAML(&out, result.Objects); err != nil {
+		t.Fatalf("WriteYAML returned error: %v", err)
+	}
+	yaml := out.String()
+	for _, want := range []string{"kind: Registry\n", "creationPolicy: Adopt\n", "\n---\n"} {
+		if !strings.Contains(yaml, want) {
+			t.Fatalf("expected the YAML to contain %q:\n%s", want, yaml)
+		}
+	}
+	for _, unwanted := range []string{"s3cret", "status:", "creationTimestamp"} {
+		if strings.Contains(yaml, unwanted) {
+			t.Fatalf("expected the YAML not to contain %q:\n%s", unwanted, yaml)
+		}
+	}
+}
+
+func TestDerivedName(t *testing.T) {
+	t.Parallel()
+	if got := derivedName("My_Project", "cn=devs,dc=example"); got != "my-project-cn-devs-dc-example" {
+		t.Fatalf("unexpected derived name %q", got)
+	}
+}
+
+func find[T client.Object](t *testing.T, objs []client.Object, name string) T {
+	t.Helper()
+	for _, obj := range objs {
+		if typed, ok := obj.(T); ok && obj.GetName() == name {
+			return typed
+		}
+	}
+	var zero T
+	t.Fatalf("expected an exported %T named %q", zero, name)
+	return zero
+}
+
+func hasWarning(warnings []string, substr string) bool {
+	for _, w := range warnings {
+		if strings.Contains(w, substr) {
+			return true
+		}
+	}
+	return false
+}
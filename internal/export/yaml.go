@@ -0,0 +1,38 @@
+package export
+
+import (
+	"fmt"
+	"io"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// WriteYAML writes objs as a multi-document YAML stream. Status and the
+// server-populated metadata are left out so the output can be applied as is.
+func WriteYAML(w io.Writer, objs []client.Object) error {
+	for i, obj := range objs {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return fmt.Errorf("convert %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		delete(content, "status")
+		if meta, ok := content["metadata"].(map[string]any); ok {
+			delete(meta, "creationTimestamp")
+		}
+		out, err := yaml.Marshal(content)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if _, err := io.WriteString(w, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package harborclient
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
+)
+
+// API returns the generated Harbor API operations. Requests go through the
+// same authentication, retries, limits, and metrics as the methods of c, so
+// callers can use operations that have no hand-written wrapper yet.
+func (c *Client) API() *harborapi.Client {
+	return harborapi.New(apiDoer{client: c})
+}
+
+type apiDoer struct {
+	client *Client
+}
+
+func (d apiDoer) Do(ctx context.Context, method, relURL string, in, out any) (*http.Response, error) {
+	return d.client.do(ctx, method, relURL, in, out)
+}
@@ -0,0 +1,134 @@
+package harborclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/rkthtrifork/harbor-operator/internal/metrics"
+)
+
+const (
+	// DefaultBreakerThreshold is the number of consecutive transport errors or
+	// 5xx responses after which a Breaker opens.
+	DefaultBreakerThreshold = 5
+	// DefaultBreakerCooldown is how long an open Breaker fails fast before it
+	// lets a single probe request through.
+	DefaultBreakerCooldown = 30 * time.Second
+)
+
+// Breaker is a circuit breaker shared by all clients of one Harbor connection.
+// While it is open, requests fail immediately with an UnavailableError instead
+// of waiting for the request timeout. A nil Breaker never opens.
+type Breaker struct {
+	name      string
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	lastErr  error
+	openedAt time.Time
+	probing  bool
+}
+
+// NewBreaker returns a breaker that opens after threshold consecutive failures
+// and probes Harbor again once cooldown has passed. name labels the breaker
+// metrics and errors.
+func NewBreaker(name string, threshold int, cooldown time.Duration) *Breaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &Breaker{name: name, threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// UnavailableError is returned without contacting Harbor while the circuit
+// breaker of a connection is open.
+type UnavailableError struct {
+	Connection string
+	Failures   int
+	// LastError is the failure that opened the breaker.
+	LastError error
+}
+
+// Error implements the error interface.
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("harbor for %s is unavailable after %d consecutive failures: %v", e.Connection, e.Failures, e.LastError)
+}
+
+// IsUnavailable reports whether err came from an open circuit breaker.
+func IsUnavailable(err error) bool {
+	var unavailable *UnavailableError
+	return errors.As(err, &unavailable)
+}
+
+// allow reports whether a request may be sent. When the breaker is open and
+// the cooldown has passed, exactly one caller gets probe set and is let
+// through; everyone else fails fast until that probe finishes.
+func (b *Breaker) allow() (probe bool, err error) {
+	if b == nil {
+		return false, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.openedAt.IsZero() {
+		return false, nil
+	}
+	if b.probing || b.now().Sub(b.openedAt) < b.cooldown {
+		return false, &UnavailableError{Connection: b.name, Failures: b.failures, LastError: b.lastErr}
+	}
+	b.probing = true
+	return true, nil
+}
+
+// record updates the breaker with the outcome of a request let through by
+// allow.
+func (b *Breaker) record(ctx context.Context, probe bool, err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		b.probing = false
+	}
+	if err != nil && (ctx.Err() != nil || IsThrottled(err)) {
+		// The request was cancelled by the caller or never sent, which says
+		// nothing about Harbor.
+		return
+	}
+	if err == nil || !isUnavailableFailure(err) {
+		b.failures = 0
+		b.lastErr = nil
+		if !b.openedAt.IsZero() {
+			b.openedAt = time.Time{}
+			metrics.SetHarborCircuitBreakerOpen(b.name, false)
+		}
+		return
+	}
+	b.failures++
+	b.lastErr = err
+	if probe || b.failures >= b.threshold {
+		if b.openedAt.IsZero() {
+			metrics.SetHarborCircuitBreakerOpen(b.name, true)
+		}
+		b.openedAt = b.now()
+	}
+}
+
+// isUnavailableFailure reports whether err shows that Harbor could not be
+// reached or could not serve the request: a transport error or a 5xx response.
+func isUnavailableFailure(err error) bool {
+	var he *HTTPError
+	if errors.As(err, &he) {
+		return he.StatusCode >= 500
+	}
+	var ue *url.Error
+	return errors.As(err, &ue)
+}
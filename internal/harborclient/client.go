@@ -7,19 +7,29 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/rkthtrifork/harbor-operator/internal/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/oauth2"
 )
 
+var tracer = otel.Tracer("github.com/rkthtrifork/harbor-operator/internal/harborclient")
+
 // HTTPError wraps a non-2xx response.
 type HTTPError struct {
 	StatusCode int
 	Message    string
+	// RetryAfter is the delay requested by a Retry-After response header, or
+	// zero when Harbor did not send one.
+	RetryAfter time.Duration
 }
 
 // Error implements the error interface.
@@ -44,6 +54,15 @@ type Client struct {
 	HTTPClient *http.Client
 	Username   string
 	Password   string
+	// Tokens, when set, supplies bearer tokens that replace basic auth.
+	Tokens oauth2.TokenSource
+	// Limiter, when set, bounds requests across all clients sharing it.
+	Limiter *Limiter
+	// Breaker, when set, fails requests fast while Harbor is unreachable.
+	Breaker *Breaker
+	// Retry controls retries of transient Harbor failures. The zero value
+	// disables retries.
+	Retry RetryPolicy
 }
 
 var defaultHTTPClient = &http.Client{
@@ -62,12 +81,77 @@ func NewWithHTTPClient(baseURL, user, pass string, httpClient *http.Client) *Cli
 		HTTPClient: httpClient,
 		Username:   user,
 		Password:   pass,
+		Retry:      DefaultRetryPolicy,
 	}
 }
 
-func (c *Client) do(ctx context.Context, method, relURL string, in, out any) (resp *http.Response, err error) {
+// NewWithTokenSource returns a client that authenticates every request with a
+// bearer token from tokens.
+func NewWithTokenSource(baseURL string, tokens oauth2.TokenSource, httpClient *http.Client) *Client {
+	hc := NewWithHTTPClient(baseURL, "", "", httpClient)
+	hc.Tokens = tokens
+	return hc
+}
+
+func (c *Client) do(ctx context.Context, method, relURL string, in, out any) (*http.Response, error) {
+	// request body
+	var payload []byte
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		payload = b
+	}
+
+	policy := c.retryPolicy()
+	for attempt := 1; ; attempt++ {
+		probe, err := c.Breaker.allow()
+		if err != nil {
+			return nil, err
+		}
+		release, err := c.Limiter.acquire(ctx)
+		if err != nil {
+			c.Breaker.record(ctx, probe, err)
+			return nil, err
+		}
+		resp, err := c.doAttempt(ctx, method, relURL, payload, out, attempt)
+		release()
+		c.Breaker.record(ctx, probe, err)
+		if err == nil || attempt >= policy.MaxAttempts || !shouldRetry(ctx, method, err) {
+			return resp, err
+		}
+		if !waitForRetry(ctx, policy.delay(attempt, retryAfter(err))) {
+			return resp, err
+		}
+	}
+}
+
+func (c *Client) doAttempt(ctx context.Context, method, relURL string, payload []byte, out any, attempt int) (resp *http.Response, err error) {
 	start := time.Now()
 	endpointLabel := normalizeEndpoint(relURL)
+
+	// Every attempt is its own client span, so retries show up in the trace
+	// next to the backoff between them.
+	ctx, span := tracer.Start(ctx, method+" "+endpointLabel,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", method),
+			attribute.String("url.template", endpointLabel),
+			attribute.Int("http.request.resend_count", attempt-1),
+		),
+	)
+	status := 0
+	defer func() {
+		if status != 0 {
+			span.SetAttributes(attribute.Int("http.response.status_code", status))
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
 	defer func() {
 		if resp == nil || resp.Body == nil {
 			return
@@ -77,14 +161,9 @@ func (c *Client) do(ctx context.Context, method, relURL string, in, out any) (re
 		}
 	}()
 
-	// request body
 	var body io.Reader
-	if in != nil {
-		b, err := json.Marshal(in)
-		if err != nil {
-			return nil, err
-		}
-		body = bytes.NewBuffer(b)
+	if payload != nil {
+		body = bytes.NewReader(payload)
 	}
 
 	// build request
@@ -93,42 +172,53 @@ func (c *Client) do(ctx context.Context, method, relURL string, in, out any) (re
 	if err != nil {
 		return nil, err
 	}
-	req.SetBasicAuth(c.Username, c.Password)
+	if c.Tokens != nil {
+		token, err := c.Tokens.Token()
+		if err != nil {
+			return nil, fmt.Errorf("obtain bearer token: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	} else {
+		req.SetBasicAuth(c.Username, c.Password)
+	}
 	req.Header.Set("Content-Type", "application/json")
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
 
 	// perform
 	resp, err = c.HTTPClient.Do(req)
 	if err != nil {
-		metrics.ObserveHarborRequest(method, endpointLabel, 0, time.Since(start).Seconds())
+		metrics.ObserveHarborRequest(method, endpointLabel, 0, attempt, time.Since(start).Seconds())
 		return nil, err
 	}
+	status = resp.StatusCode
 
 	// non-2xx → wrap in *HTTPError
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		msg, _ := io.ReadAll(resp.Body)
-		metrics.ObserveHarborRequest(method, endpointLabel, resp.StatusCode, time.Since(start).Seconds())
+		metrics.ObserveHarborRequest(method, endpointLabel, resp.StatusCode, attempt, time.Since(start).Seconds())
 		return nil, &HTTPError{
 			StatusCode: resp.StatusCode,
 			Message:    strings.TrimSpace(string(msg)),
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
 		}
 	}
 
 	// decode
 	if out != nil {
 		if resp.ContentLength == 0 {
-			metrics.ObserveHarborRequest(method, endpointLabel, resp.StatusCode, time.Since(start).Seconds())
+			metrics.ObserveHarborRequest(method, endpointLabel, resp.StatusCode, attempt, time.Since(start).Seconds())
 			return resp, nil
 		}
 		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
 			if err == io.EOF {
-				metrics.ObserveHarborRequest(method, endpointLabel, resp.StatusCode, time.Since(start).Seconds())
+				metrics.ObserveHarborRequest(method, endpointLabel, resp.StatusCode, attempt, time.Since(start).Seconds())
 				return resp, nil
 			}
-			metrics.ObserveHarborRequest(method, endpointLabel, resp.StatusCode, time.Since(start).Seconds())
+			metrics.ObserveHarborRequest(method, endpointLabel, resp.StatusCode, attempt, time.Since(start).Seconds())
 			return nil, err
 		}
 	}
-	metrics.ObserveHarborRequest(method, endpointLabel, resp.StatusCode, time.Since(start).Seconds())
+	metrics.ObserveHarborRequest(method, endpointLabel, resp.StatusCode, attempt, time.Since(start).Seconds())
 	return resp, nil
 }
 
@@ -137,17 +227,11 @@ func (c *Client) get(ctx context.Context, relURL string, out any) error {
 	return err
 }
 
-func getPaged[T any](ctx context.Context, c *Client, path string, values url.Values) ([]T, error) {
-	baseValues := cloneValues(values)
+// listAll fetches every page of a generated list operation.
+func listAll[T any](list func(page, pageSize int) ([]T, *http.Response, error)) ([]T, error) {
 	var out []T
-
 	for page := 1; ; page++ {
-		pageValues := cloneValues(baseValues)
-		pageValues.Set("page", strconv.Itoa(page))
-		pageValues.Set("page_size", strconv.Itoa(defaultListPageSize))
-
-		var current []T
-		resp, err := c.do(ctx, http.MethodGet, pathWithQuery(path, pageValues), nil, &current)
+		current, resp, err := list(page, defaultListPageSize)
 		if err != nil {
 			return nil, err
 		}
@@ -159,16 +243,6 @@ func getPaged[T any](ctx context.Context, c *Client, path string, values url.Val
 	}
 }
 
-func cloneValues(values url.Values) url.Values {
-	cloned := url.Values{}
-	for key, vals := range values {
-		for _, val := range vals {
-			cloned.Add(key, val)
-		}
-	}
-	return cloned
-}
-
 func hasNextPage(resp *http.Response, currentCount, totalFetched int) bool {
 	if resp != nil {
 		if total, err := strconv.Atoi(resp.Header.Get("X-Total-Count")); err == nil {
@@ -178,46 +252,26 @@ func hasNextPage(resp *http.Response, currentCount, totalFetched int) bool {
 	return currentCount == defaultListPageSize
 }
 
-func pathWithQuery(path string, values url.Values) string {
-	if len(values) == 0 {
-		return path
-	}
-	return path + "?" + values.Encode()
-}
-
-func (c *Client) post(ctx context.Context, relURL string, in, out any) error {
-	_, err := c.do(ctx, http.MethodPost, relURL, in, out)
-	return err
-}
-
-func (c *Client) put(ctx context.Context, relURL string, in any) error {
-	_, err := c.do(ctx, http.MethodPut, relURL, in, nil)
-	return err
-}
-
-func (c *Client) patch(ctx context.Context, relURL string, in, out any) error {
-	_, err := c.do(ctx, http.MethodPatch, relURL, in, out)
-	return err
-}
-
-func (c *Client) createWithNumericLocationID(ctx context.Context, relURL string, in any) (int, error) {
-	resp, err := c.do(ctx, http.MethodPost, relURL, in, nil)
+// createdID returns the numeric ID from the Location header of a create
+// response.
+func createdID(resp *http.Response, err error) (int, error) {
 	if err != nil {
 		return 0, err
 	}
 	return extractLocationID(resp)
 }
 
-func (c *Client) createWithStringLocationID(ctx context.Context, relURL string, in any) (string, error) {
-	resp, err := c.do(ctx, http.MethodPost, relURL, in, nil)
+// createdName returns the last Location path segment of a create response.
+func createdName(resp *http.Response, err error) (string, error) {
 	if err != nil {
 		return "", err
 	}
 	return extractLocationIDString(resp)
 }
 
-func (c *Client) deleteIgnoringNotFound(ctx context.Context, relURL string, ignoreFns ...func(error) bool) error {
-	_, err := c.do(ctx, http.MethodDelete, relURL, nil, nil)
+// deleted treats a missing object, and any error matched by ignoreFns, as
+// successfully deleted.
+func deleted(_ *http.Response, err error, ignoreFns ...func(error) bool) error {
 	if IsNotFound(err) {
 		return nil
 	}
@@ -229,6 +283,11 @@ func (c *Client) deleteIgnoringNotFound(ctx context.Context, relURL string, igno
 	return err
 }
 
+// result drops the response of a generated operation.
+func result[T any](out T, _ *http.Response, err error) (T, error) {
+	return out, err
+}
+
 var (
 	numberPathSegment  = regexp.MustCompile(`^\d+$`)
 	projectPathSegment = regexp.MustCompile(`^(/api/v2\.0/projects)/[^/]+(/(?:immutabletagrules|members|webhook)(?:/.*)?$)`)
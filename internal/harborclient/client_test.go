@@ -3,11 +3,23 @@ package harborclient
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"golang.org/x/oauth2"
 )
 
 func TestDoAllowsEmptyJSONBody(t *testing.T) {
@@ -53,6 +65,30 @@ func TestCheckAuthenticationSupportsRobotAccounts(t *testing.T) {
 	}
 }
 
+func TestCheckAuthenticationSendsBearerToken(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, ok := r.BasicAuth(); ok {
+			http.Error(w, "unexpected basic auth", http.StatusBadRequest)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer id-token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	tokens := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "id-token"})
+	client := NewWithTokenSource(server.URL, tokens, server.Client())
+	if err := client.CheckAuthentication(context.Background()); err != nil {
+		t.Fatalf("CheckAuthentication returned error: %v", err)
+	}
+}
+
 func TestListProjectsFetchesAllPages(t *testing.T) {
 	t.Parallel()
 
@@ -102,6 +138,55 @@ func TestListProjectsFetchesAllPages(t *testing.T) {
 	}
 }
 
+func TestGeneratedOperationsEscapePathsAndEncodeBodies(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "pass" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if r.Method != http.MethodPut || r.URL.EscapedPath() != "/api/v2.0/projects/team%2Fa/members/7" {
+			http.NotFound(w, r)
+			return
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if got := fmt.Sprint(body); got != "map[role_id:3]" {
+			http.Error(w, "unexpected body "+got, http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "user", "pass")
+	if err := client.UpdateProjectMemberRole(context.Background(), "team/a", 7, 3); err != nil {
+		t.Fatalf("UpdateProjectMemberRole returned error: %v", err)
+	}
+}
+
+func TestDeleteRetentionTreatsMissingPolicyAsDeleted(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/v2.0/retentions/17" {
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, `{"errors":[{"code":"BAD_REQUEST","message":"no such Retention policy with id 17"}]}`, http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "user", "pass")
+	if err := client.DeleteRetention(context.Background(), 17); err != nil {
+		t.Fatalf("DeleteRetention returned error: %v", err)
+	}
+}
+
 func TestNormalizeEndpointBoundsMetricLabels(t *testing.T) {
 	t.Parallel()
 
@@ -121,3 +206,307 @@ func TestNormalizeEndpointBoundsMetricLabels(t *testing.T) {
 		}
 	}
 }
+
+func TestDoRetriesTransientGetFailures(t *testing.T) {
+	t.Parallel()
+
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "harbor core restarting", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"team-a"}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "user", "pass")
+	client.Retry = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+	var out Project
+	if err := client.get(context.Background(), "/api/v2.0/projects/1", &out); err != nil {
+		t.Fatalf("get returned error after transient failures: %v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("server saw %d attempts, want 3", attempts)
+	}
+	if out.Name != "team-a" {
+		t.Fatalf("decoded name %q, want %q", out.Name, "team-a")
+	}
+}
+
+func TestDoRetriesPostOnlyWhenHarborDidNotProcessIt(t *testing.T) {
+	t.Parallel()
+
+	tests := map[int]int{
+		http.StatusTooManyRequests:    2,
+		http.StatusServiceUnavailable: 2,
+		http.StatusBadGateway:         1,
+		http.StatusGatewayTimeout:     1,
+		http.StatusConflict:           1,
+	}
+
+	for status, wantAttempts := range tests {
+		t.Run(strconv.Itoa(status), func(t *testing.T) {
+			t.Parallel()
+
+			var attempts int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				http.Error(w, http.StatusText(status), status)
+			}))
+			defer server.Close()
+
+			client := New(server.URL, "user", "pass")
+			client.Retry = RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+			_, err := client.CreateProject(context.Background(), CreateProjectRequest{ProjectName: "team-a"})
+			if !isStatus(err, status) {
+				t.Fatalf("CreateProject error = %v, want HTTP %d", err, status)
+			}
+			if attempts != wantAttempts {
+				t.Fatalf("server saw %d attempts, want %d", attempts, wantAttempts)
+			}
+		})
+	}
+}
+
+func TestDoDoesNotWaitForRetryAfterBeyondMaxDelay(t *testing.T) {
+	t.Parallel()
+
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "120")
+		http.Error(w, "slow down", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "user", "pass")
+	client.Retry = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second}
+	err := client.get(context.Background(), "/api/v2.0/projects/1", nil)
+	var he *HTTPError
+	if !errors.As(err, &he) || he.RetryAfter != 120*time.Second {
+		t.Fatalf("get error = %#v, want HTTPError with RetryAfter 120s", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("server saw %d attempts, want 1", attempts)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.January, 2, 15, 4, 5, 0, time.UTC)
+	tests := map[string]time.Duration{
+		"":                              0,
+		"7":                             7 * time.Second,
+		"-1":                            0,
+		"soon":                          0,
+		"Fri, 02 Jan 2026 15:04:35 GMT": 30 * time.Second,
+		"Fri, 02 Jan 2026 15:00:00 GMT": 0,
+	}
+
+	for value, want := range tests {
+		if got := parseRetryAfter(value, now); got != want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
+
+func TestLimiterBoundsRequestsAcrossClients(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	limiter := NewLimiter("test", 1, 0, 0)
+	limiter.maxWait = 20 * time.Millisecond
+	first := New(server.URL, "user", "pass")
+	first.Limiter = limiter
+	second := New(server.URL, "user", "pass")
+	second.Limiter = limiter
+
+	done := make(chan error, 1)
+	go func() { done <- first.get(context.Background(), "/api/v2.0/projects/1", nil) }()
+	<-started
+
+	err := second.get(context.Background(), "/api/v2.0/projects/2", nil)
+	var throttled *ThrottledError
+	if !errors.As(err, &throttled) || throttled.Limiter != "test" {
+		t.Fatalf("second get error = %v, want ThrottledError", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("first get returned error: %v", err)
+	}
+	if err := second.get(context.Background(), "/api/v2.0/projects/2", nil); err != nil {
+		t.Fatalf("get after release returned error: %v", err)
+	}
+}
+
+func TestLimiterThrottlesBeyondRequestRate(t *testing.T) {
+	t.Parallel()
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "user", "pass")
+	client.Limiter = NewLimiter("test", 0, 1, 1)
+	client.Limiter.maxWait = 20 * time.Millisecond
+
+	if err := client.get(context.Background(), "/api/v2.0/projects/1", nil); err != nil {
+		t.Fatalf("first get returned error: %v", err)
+	}
+	if err := client.get(context.Background(), "/api/v2.0/projects/1", nil); !IsThrottled(err) {
+		t.Fatalf("second get error = %v, want throttled", err)
+	}
+	if requests != 1 {
+		t.Fatalf("server saw %d requests, want 1", requests)
+	}
+}
+
+func TestBreakerFailsFastAndProbesAfterCooldown(t *testing.T) {
+	t.Parallel()
+
+	var requests int
+	healthy := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if !healthy {
+			http.Error(w, "harbor core down", http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	now := time.Date(2026, time.January, 2, 15, 4, 5, 0, time.UTC)
+	breaker := NewBreaker("test", 2, time.Minute)
+	breaker.now = func() time.Time { return now }
+	client := New(server.URL, "user", "pass")
+	client.Retry = RetryPolicy{}
+	client.Breaker = breaker
+
+	for range 2 {
+		if err := client.get(context.Background(), "/api/v2.0/projects/1", nil); !isStatus(err, http.StatusBadGateway) {
+			t.Fatalf("get error = %v, want HTTP 502", err)
+		}
+	}
+	err := client.get(context.Background(), "/api/v2.0/projects/1", nil)
+	var unavailable *UnavailableError
+	if !errors.As(err, &unavailable) || unavailable.Failures != 2 {
+		t.Fatalf("get error = %v, want UnavailableError after 2 failures", err)
+	}
+	if requests != 2 {
+		t.Fatalf("server saw %d requests, want 2", requests)
+	}
+
+	// A failed probe reopens the breaker for another cooldown.
+	now = now.Add(time.Minute)
+	if err := client.get(context.Background(), "/api/v2.0/projects/1", nil); !isStatus(err, http.StatusBadGateway) {
+		t.Fatalf("probe error = %v, want HTTP 502", err)
+	}
+	if err := client.get(context.Background(), "/api/v2.0/projects/1", nil); !IsUnavailable(err) {
+		t.Fatalf("get after failed probe error = %v, want unavailable", err)
+	}
+
+	now = now.Add(time.Minute)
+	healthy = true
+	if err := client.get(context.Background(), "/api/v2.0/projects/1", nil); err != nil {
+		t.Fatalf("probe returned error: %v", err)
+	}
+	if err := client.get(context.Background(), "/api/v2.0/projects/1", nil); err != nil {
+		t.Fatalf("get after successful probe returned error: %v", err)
+	}
+	if requests != 5 {
+		t.Fatalf("server saw %d requests, want 5", requests)
+	}
+}
+
+func TestBreakerIgnoresClientErrors(t *testing.T) {
+	t.Parallel()
+
+	breaker := NewBreaker("test", 1, time.Minute)
+	breaker.record(context.Background(), false, &HTTPError{StatusCode: http.StatusNotFound})
+	breaker.record(context.Background(), false, &ThrottledError{Limiter: "test"})
+	if _, err := breaker.allow(); err != nil {
+		t.Fatalf("allow error = %v, want the breaker to stay closed", err)
+	}
+	breaker.record(context.Background(), false, &HTTPError{StatusCode: http.StatusInternalServerError})
+	if _, err := breaker.allow(); !IsUnavailable(err) {
+		t.Fatalf("allow error = %v, want unavailable", err)
+	}
+}
+
+// recordSpans installs a span recorder as the global tracer provider. The
+// global provider can only be set once per process, so tests share the
+// recorder and tell their spans apart by trace ID.
+var recordSpans = sync.OnceValue(func() *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return recorder
+})
+
+func TestRequestsAreTracedAndPropagateTraceContext(t *testing.T) {
+	t.Parallel()
+	recorder := recordSpans()
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		http.Error(w, "missing", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "reconcile")
+	client := New(server.URL, "user", "pass")
+	_ = client.get(ctx, "/api/v2.0/projects/42/members/7", nil)
+	parent.End()
+
+	traceID := parent.SpanContext().TraceID()
+	if !strings.Contains(traceparent, traceID.String()) {
+		t.Fatalf("traceparent = %q, want it to carry trace %s", traceparent, traceID)
+	}
+
+	var span sdktrace.ReadOnlySpan
+	for _, ended := range recorder.Ended() {
+		if ended.SpanContext().TraceID() == traceID && ended.Parent().SpanID() == parent.SpanContext().SpanID() {
+			span = ended
+		}
+	}
+	if span == nil {
+		t.Fatal("no child span was recorded for the Harbor request")
+	}
+	if span.Name() != "GET /api/v2.0/projects/:project/members/:id" {
+		t.Fatalf("span name = %q", span.Name())
+	}
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	if attrs["http.request.method"].AsString() != http.MethodGet ||
+		attrs["url.template"].AsString() != "/api/v2.0/projects/:project/members/:id" ||
+		attrs["http.response.status_code"].AsInt64() != http.StatusNotFound {
+		t.Fatalf("unexpected span attributes: %v", span.Attributes())
+	}
+	if span.Status().Code != codes.Error {
+		t.Fatalf("span status = %v, want error", span.Status())
+	}
+}
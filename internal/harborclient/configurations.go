@@ -3,6 +3,7 @@ package harborclient
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 )
 
 // ConfigurationItem represents a single configuration value with metadata.
@@ -21,5 +22,6 @@ func (c *Client) GetConfigurations(ctx context.Context) (map[string]Configuratio
 // UpdateConfigurations updates Harbor system configurations.
 // The input map may contain a subset of configuration keys.
 func (c *Client) UpdateConfigurations(ctx context.Context, in map[string]any) error {
-	return c.put(ctx, "/api/v2.0/configurations", in)
+	_, err := c.do(ctx, http.MethodPut, "/api/v2.0/configurations", in, nil)
+	return err
 }
@@ -0,0 +1,42 @@
+// Package harborapi contains the Harbor API models and operations generated
+// from hack/harbor-openapi.yaml by hack/harborapi-gen. Run make
+// generate-harbor-client after updating the specification.
+//
+// The package only describes the API. Requests are sent through a Doer, which
+// harborclient.Client implements with its authentication, retries, limits,
+// and metrics.
+package harborapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// BasePath is the path prefix of every Harbor API v2.0 operation.
+const BasePath = "/api/v2.0"
+
+// Doer sends one Harbor API request. relURL starts with BasePath and includes
+// the encoded query. in, when not nil, is encoded as the JSON request body and
+// a successful JSON response is decoded into out.
+type Doer interface {
+	Do(ctx context.Context, method, relURL string, in, out any) (*http.Response, error)
+}
+
+// Client exposes the generated Harbor API operations.
+type Client struct {
+	doer Doer
+}
+
+// New returns a client that sends requests through doer.
+func New(doer Doer) *Client {
+	return &Client{doer: doer}
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, in, out any) (*http.Response, error) {
+	relURL := BasePath + path
+	if len(query) > 0 {
+		relURL += "?" + query.Encode()
+	}
+	return c.doer.Do(ctx, method, relURL, in, out)
+}
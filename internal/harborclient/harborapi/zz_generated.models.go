@@ -0,0 +1,2339 @@
+// Code generated by hack/harborapi-gen from hack/harbor-openapi.yaml. DO NOT EDIT.
+
+package harborapi
+
+// Access is a Harbor API model.
+type Access struct {
+	// The action of the access. Possible actions are *, pull, push, create, read, update, delete, list, operate, scanner-pull and stop.
+	Action string `json:"action,omitempty"`
+	// The effect of the access
+	Effect string `json:"effect,omitempty"`
+	// The resource of the access. Possible resources are listed here for system and project level https://github.com/goharbor/harbor/blob/main/src/common/rbac/const.go
+	Resource string `json:"resource,omitempty"`
+}
+
+// Accessory is a Harbor API model.
+//
+// The accessory of the artifact
+type Accessory struct {
+	// The artifact id of the accessory
+	ArtifactID int `json:"artifact_id"`
+	// The creation time of the accessory
+	CreationTime string `json:"creation_time,omitempty"`
+	// The artifact digest of the accessory
+	Digest string `json:"digest"`
+	// The icon of the accessory
+	Icon string `json:"icon"`
+	// The ID of the accessory
+	ID int `json:"id,omitempty"`
+	// The artifact size of the accessory
+	Size int `json:"size"`
+	// The subject artifact digest of the accessory
+	SubjectArtifactDigest string `json:"subject_artifact_digest"`
+	// Going to be deprecated, use repo and digest for insteand. The subject artifact id of the accessory.
+	SubjectArtifactID int `json:"subject_artifact_id,omitempty"`
+	// The subject artifact repository name of the accessory
+	SubjectArtifactRepo string `json:"subject_artifact_repo"`
+	// The artifact size of the accessory
+	Type string `json:"type"`
+}
+
+// ActionRequest is a Harbor API model.
+//
+// The request to stop, pause or resume
+type ActionRequest struct {
+	// The action of the request, should be stop, pause or resume
+	Action string `json:"action,omitempty"`
+}
+
+// AdditionLink is a Harbor API model.
+type AdditionLink struct {
+	// Determine whether the link is an absolute URL or not
+	Absolute bool `json:"absolute"`
+	// The link of the addition
+	Href string `json:"href,omitempty"`
+}
+
+// AdditionLinks is a Harbor API model.
+type AdditionLinks = map[string]AdditionLink
+
+// Annotations is a Harbor API model.
+type Annotations = map[string]string
+
+// Artifact is a Harbor API model.
+type Artifact struct {
+	Accessories   []Accessory   `json:"accessories,omitempty"`
+	AdditionLinks AdditionLinks `json:"addition_links,omitempty"`
+	Annotations   Annotations   `json:"annotations,omitempty"`
+	// The artifact_type in the manifest of the artifact
+	ArtifactType string `json:"artifact_type,omitempty"`
+	// The digest of the artifact
+	Digest     string     `json:"digest,omitempty"`
+	ExtraAttrs ExtraAttrs `json:"extra_attrs,omitempty"`
+	// The digest of the icon
+	Icon string `json:"icon,omitempty"`
+	// The ID of the artifact
+	ID     int     `json:"id,omitempty"`
+	Labels []Label `json:"labels,omitempty"`
+	// The manifest media type of the artifact
+	ManifestMediaType string `json:"manifest_media_type,omitempty"`
+	// The media type of the artifact
+	MediaType string `json:"media_type,omitempty"`
+	// The ID of the project that the artifact belongs to
+	ProjectID int `json:"project_id,omitempty"`
+	// The latest pull time of the artifact
+	PullTime string `json:"pull_time,omitempty"`
+	// The push time of the artifact
+	PushTime   string      `json:"push_time,omitempty"`
+	References []Reference `json:"references,omitempty"`
+	// The ID of the repository that the artifact belongs to
+	RepositoryID int `json:"repository_id,omitempty"`
+	// The name of the repository that the artifact belongs to
+	RepositoryName string `json:"repository_name,omitempty"`
+	// The overview of the generating SBOM progress
+	SBOMOverview SBOMOverview `json:"sbom_overview,omitzero"`
+	// The overview of the scan result.
+	ScanOverview ScanOverview `json:"scan_overview,omitempty"`
+	// The size of the artifact
+	Size int   `json:"size,omitempty"`
+	Tags []Tag `json:"tags,omitempty"`
+	// The type of the artifact, e.g. image, chart, etc
+	Type string `json:"type,omitempty"`
+}
+
+// AuditLog is a Harbor API model.
+type AuditLog struct {
+	// The ID of the audit log entry.
+	ID int `json:"id,omitempty"`
+	// The time when this operation is triggered.
+	OpTime string `json:"op_time,omitempty"`
+	// The operation against the repository in this log entry.
+	Operation string `json:"operation,omitempty"`
+	// Name of the repository in this log entry.
+	Resource string `json:"resource,omitempty"`
+	// Tag of the repository in this log entry.
+	ResourceType string `json:"resource_type,omitempty"`
+	// Username of the user in this log entry.
+	Username string `json:"username,omitempty"`
+}
+
+// AuditLogEventType is a Harbor API model.
+type AuditLogEventType struct {
+	// the event type, such as create_user.
+	EventType string `json:"event_type,omitempty"`
+}
+
+// AuditLogExt is a Harbor API model.
+type AuditLogExt struct {
+	// The ID of the audit log entry.
+	ID int `json:"id,omitempty"`
+	// The time when this operation is triggered.
+	OpTime string `json:"op_time,omitempty"`
+	// The operation against the resource in this log entry.
+	Operation string `json:"operation,omitempty"`
+	// The operation's detail description
+	OperationDescription string `json:"operation_description,omitempty"`
+	// the operation's result, true for success, false for fail
+	OperationResult bool `json:"operation_result"`
+	// Name of the resource in this log entry.
+	Resource string `json:"resource,omitempty"`
+	// Type of the resource in this log entry.
+	ResourceType string `json:"resource_type,omitempty"`
+	// The username of the operator in this log entry.
+	Username string `json:"username,omitempty"`
+}
+
+// AuthproxySetting is a Harbor API model.
+type AuthproxySetting struct {
+	// The fully qualified URI of login endpoint of authproxy, such as 'https://192.168.1.2:8443/login'
+	Endpoint string `json:"endpoint,omitempty"`
+	// The certificate to be pinned when connecting auth proxy.
+	ServerCertificate string `json:"server_certificate,omitempty"`
+	// The flag to determine whether Harbor can skip search the user/group when adding him as a member.
+	SkipSearch bool `json:"skip_search,omitempty"`
+	// The fully qualified URI of token review endpoint of authproxy, such as 'https://192.168.1.2:8443/tokenreview'
+	TokenreivewEndpoint string `json:"tokenreivew_endpoint,omitempty"`
+	// The flag to determine whether Harbor should verify the certificate when connecting to the auth proxy.
+	VerifyCert bool `json:"verify_cert,omitempty"`
+}
+
+// BoolConfigItem is a Harbor API model.
+type BoolConfigItem struct {
+	// The configure item can be updated or not
+	Editable bool `json:"editable"`
+	// The boolean value of current config item
+	Value bool `json:"value"`
+}
+
+// CVEAllowlist is a Harbor API model.
+//
+// The CVE Allowlist for system or project
+type CVEAllowlist struct {
+	// The creation time of the allowlist.
+	CreationTime string `json:"creation_time,omitempty"`
+	// the time for expiration of the allowlist, in the form of seconds since epoch.  This is an optional attribute, if it's not set the CVE allowlist does not expire.
+	ExpiresAt *int `json:"expires_at,omitempty"`
+	// ID of the allowlist
+	ID    int                `json:"id,omitempty"`
+	Items []CVEAllowlistItem `json:"items,omitempty"`
+	// ID of the project which the allowlist belongs to.  For system level allowlist this attribute is zero.
+	ProjectID int `json:"project_id,omitempty"`
+	// The update time of the allowlist.
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// CVEAllowlistItem is a Harbor API model.
+//
+// The item in CVE allowlist
+type CVEAllowlistItem struct {
+	// The ID of the CVE, such as "CVE-2019-10164"
+	CVEID string `json:"cve_id,omitempty"`
+}
+
+// ComponentHealthStatus is a Harbor API model.
+//
+// The health status of component
+type ComponentHealthStatus struct {
+	// (optional) The error message when the status is "unhealthy"
+	Error string `json:"error,omitempty"`
+	// The component name
+	Name string `json:"name,omitempty"`
+	// The health status of component. Is either "healthy" or "unhealthy".
+	Status string `json:"status,omitempty"`
+}
+
+// Configurations is a Harbor API model.
+type Configurations struct {
+	// The audit log forward endpoint
+	AuditLogForwardEndpoint *string `json:"audit_log_forward_endpoint,omitempty"`
+	// The auth mode of current system, such as "db_auth", "ldap_auth", "oidc_auth"
+	AuthMode *string `json:"auth_mode,omitempty"`
+	// The banner message for the UI.It is the stringified result of the banner message object
+	BannerMessage *string `json:"banner_message,omitempty"`
+	// the list to disable log audit event types.
+	DisabledAuditLogEventTypes *string `json:"disabled_audit_log_event_types,omitempty"`
+	// The group which has the harbor admin privileges
+	HTTPAuthproxyAdminGroups *string `json:"http_authproxy_admin_groups,omitempty"`
+	// The username which has the harbor admin privileges
+	HTTPAuthproxyAdminUsernames *string `json:"http_authproxy_admin_usernames,omitempty"`
+	// The endpoint of the HTTP auth
+	HTTPAuthproxyEndpoint *string `json:"http_authproxy_endpoint,omitempty"`
+	// The certificate of the HTTP auth provider
+	HTTPAuthproxyServerCertificate *string `json:"http_authproxy_server_certificate,omitempty"`
+	// Search user before onboard
+	HTTPAuthproxySkipSearch *bool `json:"http_authproxy_skip_search,omitempty"`
+	// The token review endpoint
+	HTTPAuthproxyTokenreviewEndpoint *string `json:"http_authproxy_tokenreview_endpoint,omitempty"`
+	// Verify the HTTP auth provider's certificate
+	HTTPAuthproxyVerifyCert *bool `json:"http_authproxy_verify_cert,omitempty"`
+	// The Base DN for LDAP binding.
+	LDAPBaseDN *string `json:"ldap_base_dn,omitempty"`
+	// The filter for LDAP search
+	LDAPFilter *string `json:"ldap_filter,omitempty"`
+	// Specify the ldap group which have the same privilege with Harbor admin
+	LDAPGroupAdminDN *string `json:"ldap_group_admin_dn,omitempty"`
+	// Attach LDAP user group information in parallel, the parallel worker count is 5
+	LDAPGroupAttachParallel *bool `json:"ldap_group_attach_parallel,omitempty"`
+	// The attribute which is used as identity of the LDAP group, default is cn.'
+	LDAPGroupAttributeName *string `json:"ldap_group_attribute_name,omitempty"`
+	// The base DN to search LDAP group.
+	LDAPGroupBaseDN *string `json:"ldap_group_base_dn,omitempty"`
+	// The user attribute to identify the group membership
+	LDAPGroupMembershipAttribute *string `json:"ldap_group_membership_attribute,omitempty"`
+	// The filter to search the ldap group
+	LDAPGroupSearchFilter *string `json:"ldap_group_search_filter,omitempty"`
+	// The scope to search ldap group. ''0-LDAP_SCOPE_BASE, 1-LDAP_SCOPE_ONELEVEL, 2-LDAP_SCOPE_SUBTREE''
+	LDAPGroupSearchScope *int `json:"ldap_group_search_scope,omitempty"`
+	// The scope to search ldap users,'0-LDAP_SCOPE_BASE, 1-LDAP_SCOPE_ONELEVEL, 2-LDAP_SCOPE_SUBTREE'
+	LDAPScope *int `json:"ldap_scope,omitempty"`
+	// The DN of the user to do the search.
+	LDAPSearchDN *string `json:"ldap_search_dn,omitempty"`
+	// The password of the ldap search dn
+	LDAPSearchPassword *string `json:"ldap_search_password,omitempty"`
+	// Timeout in seconds for connection to LDAP server
+	LDAPTimeout *int `json:"ldap_timeout,omitempty"`
+	// The attribute which is used as identity for the LDAP binding, such as "CN" or "SAMAccountname"
+	LDAPUID *string `json:"ldap_uid,omitempty"`
+	// The URL of LDAP server
+	LDAPURL *string `json:"ldap_url,omitempty"`
+	// Whether verify your OIDC server certificate, disable it if your OIDC server is hosted via self-hosted certificate.
+	LDAPVerifyCert *bool `json:"ldap_verify_cert,omitempty"`
+	// Enable notification
+	NotificationEnable *bool `json:"notification_enable,omitempty"`
+	// The OIDC group which has the harbor admin privileges
+	OIDCAdminGroup *string `json:"oidc_admin_group,omitempty"`
+	// Auto onboard the OIDC user
+	OIDCAutoOnboard *bool `json:"oidc_auto_onboard,omitempty"`
+	// The client ID of the OIDC provider
+	OIDCClientID *string `json:"oidc_client_id,omitempty"`
+	// The OIDC provider secret
+	OIDCClientSecret *string `json:"oidc_client_secret,omitempty"`
+	// The endpoint of the OIDC provider
+	OIDCEndpoint *string `json:"oidc_endpoint,omitempty"`
+	// Extra parameters to add when redirect request to OIDC provider
+	OIDCExtraRedirectParms *string `json:"oidc_extra_redirect_parms,omitempty"`
+	// The OIDC group filter which filters out the group name doesn't match the regular expression
+	OIDCGroupFilter *string `json:"oidc_group_filter,omitempty"`
+	// The attribute claims the group name
+	OIDCGroupsClaim *string `json:"oidc_groups_claim,omitempty"`
+	// Logout OIDC user session
+	OIDCLogout *bool `json:"oidc_logout,omitempty"`
+	// The OIDC provider name
+	OIDCName *string `json:"oidc_name,omitempty"`
+	// The scope of the OIDC provider
+	OIDCScope *string `json:"oidc_scope,omitempty"`
+	// The attribute claims the username
+	OIDCUserClaim *string `json:"oidc_user_claim,omitempty"`
+	// Verify the OIDC provider's certificate'
+	OIDCVerifyCert *bool `json:"oidc_verify_cert,omitempty"`
+	// The flag to indicate whether the current auth mode should consider as a primary one.
+	PrimaryAuthMode *bool `json:"primary_auth_mode,omitempty"`
+	// Indicate who can create projects, it could be ''adminonly'' or ''everyone''.
+	ProjectCreationRestriction *string `json:"project_creation_restriction,omitempty"`
+	// Enable quota per project
+	QuotaPerProjectEnable *bool `json:"quota_per_project_enable,omitempty"`
+	// The flag to indicate whether Harbor is in readonly mode.
+	ReadOnly *bool `json:"read_only,omitempty"`
+	// The rebot account name prefix
+	RobotNamePrefix *string `json:"robot_name_prefix,omitempty"`
+	// The robot account token duration in days
+	RobotTokenDuration *int `json:"robot_token_duration,omitempty"`
+	// Whether or not to skip update pull time for scanner
+	ScannerSkipUpdatePulltime *bool `json:"scanner_skip_update_pulltime,omitempty"`
+	// Whether the Harbor instance supports self-registration.  If it''s set to false, admin need to add user to the instance.
+	SelfRegistration *bool `json:"self_registration,omitempty"`
+	// The session timeout for harbor, in minutes.
+	SessionTimeout *int `json:"session_timeout,omitempty"`
+	// Skip audit log database
+	SkipAuditLogDatabase *bool `json:"skip_audit_log_database,omitempty"`
+	// The storage quota per project
+	StoragePerProject *int `json:"storage_per_project,omitempty"`
+	// The expiration time of the token for internal Registry, in minutes.
+	TokenExpiration *int `json:"token_expiration,omitempty"`
+	// The client id of UAA
+	UaaClientID *string `json:"uaa_client_id,omitempty"`
+	// The client secret of the UAA
+	UaaClientSecret *string `json:"uaa_client_secret,omitempty"`
+	// The endpoint of the UAA
+	UaaEndpoint *string `json:"uaa_endpoint,omitempty"`
+	// Verify the certificate in UAA server
+	UaaVerifyCert *bool `json:"uaa_verify_cert,omitempty"`
+}
+
+// ConfigurationsResponse is a Harbor API model.
+type ConfigurationsResponse struct {
+	// The endpoint of the audit log forwarder
+	AuditLogForwardEndpoint StringConfigItem `json:"audit_log_forward_endpoint,omitzero"`
+	// The auth mode of current system, such as "db_auth", "ldap_auth", "oidc_auth"
+	AuthMode StringConfigItem `json:"auth_mode,omitzero"`
+	// The banner message for the UI.It is the stringified result of the banner message object
+	BannerMessage StringConfigItem `json:"banner_message,omitzero"`
+	// The audit log event types to skip to log in database
+	DisabledAuditLogEventTypes StringConfigItem `json:"disabled_audit_log_event_types,omitzero"`
+	// The group which has the harbor admin privileges
+	HTTPAuthproxyAdminGroups StringConfigItem `json:"http_authproxy_admin_groups,omitzero"`
+	// The usernames which has the harbor admin privileges
+	HTTPAuthproxyAdminUsernames StringConfigItem `json:"http_authproxy_admin_usernames,omitzero"`
+	// The endpoint of the HTTP auth
+	HTTPAuthproxyEndpoint StringConfigItem `json:"http_authproxy_endpoint,omitzero"`
+	// The certificate of the HTTP auth provider
+	HTTPAuthproxyServerCertificate StringConfigItem `json:"http_authproxy_server_certificate,omitzero"`
+	// Search user before onboard
+	HTTPAuthproxySkipSearch BoolConfigItem `json:"http_authproxy_skip_search,omitzero"`
+	// The token review endpoint
+	HTTPAuthproxyTokenreviewEndpoint StringConfigItem `json:"http_authproxy_tokenreview_endpoint,omitzero"`
+	// Verify the HTTP auth provider's certificate
+	HTTPAuthproxyVerifyCert BoolConfigItem `json:"http_authproxy_verify_cert,omitzero"`
+	// The Base DN for LDAP binding.
+	LDAPBaseDN StringConfigItem `json:"ldap_base_dn,omitzero"`
+	// The filter for LDAP search
+	LDAPFilter StringConfigItem `json:"ldap_filter,omitzero"`
+	// Specify the ldap group which have the same privilege with Harbor admin
+	LDAPGroupAdminDN StringConfigItem `json:"ldap_group_admin_dn,omitzero"`
+	// Attach LDAP user group information in parallel.
+	LDAPGroupAttachParallel BoolConfigItem `json:"ldap_group_attach_parallel,omitzero"`
+	// The attribute which is used as identity of the LDAP group, default is cn.'
+	LDAPGroupAttributeName StringConfigItem `json:"ldap_group_attribute_name,omitzero"`
+	// The base DN to search LDAP group.
+	LDAPGroupBaseDN StringConfigItem `json:"ldap_group_base_dn,omitzero"`
+	// The user attribute to identify the group membership
+	LDAPGroupMembershipAttribute StringConfigItem `json:"ldap_group_membership_attribute,omitzero"`
+	// The filter to search the ldap group
+	LDAPGroupSearchFilter StringConfigItem `json:"ldap_group_search_filter,omitzero"`
+	// The scope to search ldap group. ''0-LDAP_SCOPE_BASE, 1-LDAP_SCOPE_ONELEVEL, 2-LDAP_SCOPE_SUBTREE''
+	LDAPGroupSearchScope IntegerConfigItem `json:"ldap_group_search_scope,omitzero"`
+	// The scope to search ldap users,'0-LDAP_SCOPE_BASE, 1-LDAP_SCOPE_ONELEVEL, 2-LDAP_SCOPE_SUBTREE'
+	LDAPScope IntegerConfigItem `json:"ldap_scope,omitzero"`
+	// The DN of the user to do the search.
+	LDAPSearchDN StringConfigItem `json:"ldap_search_dn,omitzero"`
+	// Timeout in seconds for connection to LDAP server
+	LDAPTimeout IntegerConfigItem `json:"ldap_timeout,omitzero"`
+	// The attribute which is used as identity for the LDAP binding, such as "CN" or "SAMAccountname"
+	LDAPUID StringConfigItem `json:"ldap_uid,omitzero"`
+	// The URL of LDAP server
+	LDAPURL StringConfigItem `json:"ldap_url,omitzero"`
+	// Whether verify your OIDC server certificate, disable it if your OIDC server is hosted via self-hosted certificate.
+	LDAPVerifyCert BoolConfigItem `json:"ldap_verify_cert,omitzero"`
+	// Enable notification
+	NotificationEnable BoolConfigItem `json:"notification_enable,omitzero"`
+	// The OIDC group which has the harbor admin privileges
+	OIDCAdminGroup StringConfigItem `json:"oidc_admin_group,omitzero"`
+	// Auto onboard the OIDC user
+	OIDCAutoOnboard BoolConfigItem `json:"oidc_auto_onboard,omitzero"`
+	// The client ID of the OIDC provider
+	OIDCClientID StringConfigItem `json:"oidc_client_id,omitzero"`
+	// The endpoint of the OIDC provider
+	OIDCEndpoint StringConfigItem `json:"oidc_endpoint,omitzero"`
+	// Extra parameters to add when redirect request to OIDC provider
+	OIDCExtraRedirectParms StringConfigItem `json:"oidc_extra_redirect_parms,omitzero"`
+	// The OIDC group filter which filters out the group doesn't match the regular expression
+	OIDCGroupFilter StringConfigItem `json:"oidc_group_filter,omitzero"`
+	// The attribute claims the group name
+	OIDCGroupsClaim StringConfigItem `json:"oidc_groups_claim,omitzero"`
+	// Extra parameters to logout user session from the OIDC provider
+	OIDCLogout BoolConfigItem `json:"oidc_logout,omitzero"`
+	// The OIDC provider name
+	OIDCName StringConfigItem `json:"oidc_name,omitzero"`
+	// The scope of the OIDC provider
+	OIDCScope StringConfigItem `json:"oidc_scope,omitzero"`
+	// The attribute claims the username
+	OIDCUserClaim StringConfigItem `json:"oidc_user_claim,omitzero"`
+	// Verify the OIDC provider's certificate'
+	OIDCVerifyCert BoolConfigItem `json:"oidc_verify_cert,omitzero"`
+	// The flag to indicate whether the current auth mode should consider as a primary one.
+	PrimaryAuthMode BoolConfigItem `json:"primary_auth_mode,omitzero"`
+	// Indicate who can create projects, it could be ''adminonly'' or ''everyone''.
+	ProjectCreationRestriction StringConfigItem `json:"project_creation_restriction,omitzero"`
+	// Enable quota per project
+	QuotaPerProjectEnable BoolConfigItem `json:"quota_per_project_enable,omitzero"`
+	// The flag to indicate whether Harbor is in readonly mode.
+	ReadOnly BoolConfigItem `json:"read_only,omitzero"`
+	// The rebot account name prefix
+	RobotNamePrefix StringConfigItem `json:"robot_name_prefix,omitzero"`
+	// The robot account token duration in days
+	RobotTokenDuration IntegerConfigItem                   `json:"robot_token_duration,omitzero"`
+	ScanAllPolicy      ConfigurationsResponseScanAllPolicy `json:"scan_all_policy,omitzero"`
+	// Whether or not to skip update the pull time for scanner
+	ScannerSkipUpdatePulltime BoolConfigItem `json:"scanner_skip_update_pulltime,omitzero"`
+	// Whether the Harbor instance supports self-registration.  If it''s set to false, admin need to add user to the instance.
+	SelfRegistration BoolConfigItem `json:"self_registration,omitzero"`
+	// The session timeout in minutes
+	SessionTimeout IntegerConfigItem `json:"session_timeout,omitzero"`
+	// Whether skip the audit log in database
+	SkipAuditLogDatabase BoolConfigItem `json:"skip_audit_log_database,omitzero"`
+	// The storage quota per project
+	StoragePerProject IntegerConfigItem `json:"storage_per_project,omitzero"`
+	// The expiration time of the token for internal Registry, in minutes.
+	TokenExpiration IntegerConfigItem `json:"token_expiration,omitzero"`
+	// The client id of UAA
+	UaaClientID StringConfigItem `json:"uaa_client_id,omitzero"`
+	// The client secret of the UAA
+	UaaClientSecret StringConfigItem `json:"uaa_client_secret,omitzero"`
+	// The endpoint of the UAA
+	UaaEndpoint StringConfigItem `json:"uaa_endpoint,omitzero"`
+	// Verify the certificate in UAA server
+	UaaVerifyCert BoolConfigItem `json:"uaa_verify_cert,omitzero"`
+}
+
+// DangerousArtifact is a Harbor API model.
+//
+// the dangerous artifact information
+type DangerousArtifact struct {
+	// the count of critical vulnerabilities
+	CriticalCnt int `json:"critical_cnt"`
+	// the digest of the artifact
+	Digest string `json:"digest,omitempty"`
+	// the count of high vulnerabilities
+	HighCnt int `json:"high_cnt"`
+	// the count of medium vulnerabilities
+	MediumCnt int `json:"medium_cnt"`
+	// the project id of the artifact
+	ProjectID int `json:"project_id,omitempty"`
+	// the repository name of the artifact
+	RepositoryName string `json:"repository_name,omitempty"`
+}
+
+// DangerousCVE is a Harbor API model.
+//
+// the dangerous CVE information
+type DangerousCVE struct {
+	// the cve id
+	CVEID string `json:"cve_id,omitempty"`
+	// the cvss score v3
+	CvssScoreV3 float64 `json:"cvss_score_v3,omitempty"`
+	// the description of the CVE
+	Desc string `json:"desc,omitempty"`
+	// the package of the CVE
+	Package string `json:"package,omitempty"`
+	// the severity of the CVE
+	Severity string `json:"severity,omitempty"`
+	// the version of the package
+	Version string `json:"version,omitempty"`
+}
+
+// Error is a Harbor API model.
+//
+// a model for all the error response coming from harbor
+type Error struct {
+	// The error code
+	Code string `json:"code,omitempty"`
+	// The error message
+	Message string `json:"message,omitempty"`
+}
+
+// Errors is a Harbor API model.
+//
+// The error array that describe the errors got during the handling of request
+type Errors struct {
+	Errors []Error `json:"errors,omitempty"`
+}
+
+// EventType is a Harbor API model.
+//
+// Webhook supported event type.
+type EventType = string
+
+// ExecHistory is a Harbor API model.
+type ExecHistory struct {
+	// the creation time of purge job.
+	CreationTime string `json:"creation_time,omitempty"`
+	// if purge job was deleted.
+	Deleted bool `json:"deleted,omitempty"`
+	// the id of purge job.
+	ID int `json:"id,omitempty"`
+	// the job kind of purge job.
+	JobKind string `json:"job_kind,omitempty"`
+	// the job name of purge job.
+	JobName string `json:"job_name,omitempty"`
+	// the job parameters of purge job.
+	JobParameters string `json:"job_parameters,omitempty"`
+	// the status of purge job.
+	JobStatus string      `json:"job_status,omitempty"`
+	Schedule  ScheduleObj `json:"schedule,omitzero"`
+	// the update time of purge job.
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// Execution is a Harbor API model.
+type Execution struct {
+	// The end time of execution
+	EndTime    string     `json:"end_time,omitempty"`
+	ExtraAttrs ExtraAttrs `json:"extra_attrs,omitempty"`
+	// The ID of execution
+	ID      int     `json:"id,omitempty"`
+	Metrics Metrics `json:"metrics,omitzero"`
+	// The start time of execution
+	StartTime string `json:"start_time,omitempty"`
+	// The status of execution
+	Status string `json:"status,omitempty"`
+	// The status message of execution
+	StatusMessage string `json:"status_message,omitempty"`
+	// The trigger of execution
+	Trigger string `json:"trigger,omitempty"`
+	// The vendor id of execution
+	VendorID int `json:"vendor_id,omitempty"`
+	// The vendor type of execution
+	VendorType string `json:"vendor_type,omitempty"`
+}
+
+// ExtraAttrs is a Harbor API model.
+type ExtraAttrs = map[string]any
+
+// FilterStyle is a Harbor API model.
+//
+// The style of the resource filter
+type FilterStyle struct {
+	// The filter style
+	Style string `json:"style,omitempty"`
+	// The filter type
+	Type string `json:"type,omitempty"`
+	// The filter values
+	Values []string `json:"values,omitempty"`
+}
+
+// GCHistory is a Harbor API model.
+type GCHistory struct {
+	// the creation time of gc job.
+	CreationTime string `json:"creation_time,omitempty"`
+	// if gc job was deleted.
+	Deleted bool `json:"deleted,omitempty"`
+	// the id of gc job.
+	ID int `json:"id,omitempty"`
+	// the job kind of gc job.
+	JobKind string `json:"job_kind,omitempty"`
+	// the job name of gc job.
+	JobName string `json:"job_name,omitempty"`
+	// the job parameters of gc job.
+	JobParameters string `json:"job_parameters,omitempty"`
+	// the status of gc job.
+	JobStatus string      `json:"job_status,omitempty"`
+	Schedule  ScheduleObj `json:"schedule,omitzero"`
+	// the update time of gc job.
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// GeneralInfo is a Harbor API model.
+type GeneralInfo struct {
+	// The auth mode of current Harbor instance.
+	AuthMode *string `json:"auth_mode,omitempty"`
+	// The setting of auth proxy this is only available when Harbor relies on authproxy for authentication.
+	AuthproxySettings *AuthproxySetting `json:"authproxy_settings,omitempty"`
+	// The banner message for the UI. It is the stringified result of the banner message object.
+	BannerMessage *string `json:"banner_message,omitempty"`
+	// The current time of the server.
+	CurrentTime *string `json:"current_time,omitempty"`
+	// The external URL of Harbor, with protocol.
+	ExternalURL *string `json:"external_url,omitempty"`
+	// The build version of Harbor.
+	HarborVersion *string `json:"harbor_version,omitempty"`
+	// Indicate whether there is a ca root cert file ready for download in the file system.
+	HasCARoot *bool `json:"has_ca_root,omitempty"`
+	// The flag to indicate whether notification mechanism is enabled on Harbor instance.
+	NotificationEnable *bool `json:"notification_enable,omitempty"`
+	// The OIDC provider name, empty if current auth is not OIDC_auth or OIDC provider is not configured.
+	OIDCProviderName *string `json:"oidc_provider_name,omitempty"`
+	// The flag to indicate whether the current auth mode should consider as a primary one.
+	PrimaryAuthMode *bool `json:"primary_auth_mode,omitempty"`
+	// Indicate who can create projects, it could be 'adminonly' or 'everyone'.
+	ProjectCreationRestriction *string `json:"project_creation_restriction,omitempty"`
+	// The flag to indicate whether Harbor is in readonly mode.
+	ReadOnly *bool `json:"read_only,omitempty"`
+	// The storage provider's name of Harbor registry
+	RegistryStorageProviderName *string `json:"registry_storage_provider_name,omitempty"`
+	// The url of registry against which the docker command should be issued.
+	RegistryURL *string `json:"registry_url,omitempty"`
+	// Indicate whether the Harbor instance enable user to register himself.
+	SelfRegistration *bool `json:"self_registration,omitempty"`
+}
+
+// Icon is a Harbor API model.
+type Icon struct {
+	// The base64 encoded content of the icon
+	Content string `json:"content,omitempty"`
+	// The content type of the icon
+	ContentType string `json:"content-type,omitempty"`
+}
+
+// ImmutableRule is a Harbor API model.
+type ImmutableRule struct {
+	Action         string                         `json:"action,omitempty"`
+	Disabled       bool                           `json:"disabled,omitempty"`
+	ID             int                            `json:"id,omitempty"`
+	Params         map[string]any                 `json:"params,omitempty"`
+	Priority       int                            `json:"priority,omitempty"`
+	ScopeSelectors map[string][]ImmutableSelector `json:"scope_selectors,omitempty"`
+	TagSelectors   []ImmutableSelector            `json:"tag_selectors,omitempty"`
+	Template       string                         `json:"template,omitempty"`
+}
+
+// ImmutableSelector is a Harbor API model.
+type ImmutableSelector struct {
+	Decoration string `json:"decoration,omitempty"`
+	Extras     string `json:"extras,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	Pattern    string `json:"pattern,omitempty"`
+}
+
+// Instance is a Harbor API model.
+type Instance struct {
+	// The auth credential data if it exists.
+	//
+	// When updating an instance (PUT `/p2p/preheat/instances/{preheat_instance_name}`), if this object
+	// is omitted or empty and `auth_mode` is not `NONE`, the previously stored credentials are kept.
+	AuthInfo map[string]string `json:"auth_info,omitempty"`
+	// The authentication way supported
+	AuthMode string `json:"auth_mode,omitempty"`
+	// Whether the instance is default or not
+	Default bool `json:"default"`
+	// Description of instance
+	Description string `json:"description,omitempty"`
+	// Whether the instance is activated or not
+	Enabled bool `json:"enabled"`
+	// The service endpoint of this instance
+	Endpoint string `json:"endpoint,omitempty"`
+	// Unique ID
+	ID int `json:"id,omitempty"`
+	// Whether the instance endpoint is insecure or not
+	Insecure bool `json:"insecure"`
+	// Instance name
+	Name string `json:"name,omitempty"`
+	// The timestamp of instance setting up
+	SetupTimestamp int `json:"setup_timestamp,omitempty"`
+	// The health status
+	Status string `json:"status,omitempty"`
+	// Based on which driver, identified by ID
+	Vendor string `json:"vendor,omitempty"`
+}
+
+// IntegerConfigItem is a Harbor API model.
+type IntegerConfigItem struct {
+	// The configure item can be updated or not
+	Editable bool `json:"editable"`
+	// The integer value of current config item
+	Value int `json:"value"`
+}
+
+// InternalConfigurationValue is a Harbor API model.
+type InternalConfigurationValue struct {
+	// The configure item can be updated or not
+	Editable bool `json:"editable"`
+	// The value of current config item
+	Value any `json:"value,omitempty"`
+}
+
+// InternalConfigurationsResponse is a Harbor API model.
+type InternalConfigurationsResponse = map[string]InternalConfigurationValue
+
+// IsDefault is a Harbor API model.
+type IsDefault struct {
+	// A flag indicating whether a scanner registration is default.
+	IsDefault bool `json:"is_default,omitempty"`
+}
+
+// JobQueue is a Harbor API model.
+//
+// the job queue info
+type JobQueue struct {
+	// The count of jobs in the job queue
+	Count int `json:"count,omitempty"`
+	// The type of the job queue
+	JobType string `json:"job_type,omitempty"`
+	// The latency the job queue (seconds)
+	Latency int `json:"latency,omitempty"`
+	// The paused status of the job queue
+	Paused bool `json:"paused"`
+}
+
+// Label is a Harbor API model.
+type Label struct {
+	// The color the label
+	Color string `json:"color,omitempty"`
+	// The creation time the label
+	CreationTime string `json:"creation_time,omitempty"`
+	// The description the label
+	Description string `json:"description,omitempty"`
+	// The ID of the label
+	ID int `json:"id,omitempty"`
+	// The name the label
+	Name string `json:"name,omitempty"`
+	// The ID of project that the label belongs to
+	ProjectID int `json:"project_id,omitempty"`
+	// The scope the label
+	Scope string `json:"scope,omitempty"`
+	// The update time of the label
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// LdapConf is a Harbor API model.
+//
+// The ldap configure properties
+type LdapConf struct {
+	// The base dn of ldap service.
+	LDAPBaseDN string `json:"ldap_base_dn,omitempty"`
+	// The connect timeout of ldap service(second).
+	LDAPConnectionTimeout int `json:"ldap_connection_timeout,omitempty"`
+	// The serach filter of ldap service.
+	LDAPFilter string `json:"ldap_filter,omitempty"`
+	// The serach scope of ldap service.
+	LDAPScope int `json:"ldap_scope,omitempty"`
+	// The search dn of ldap service.
+	LDAPSearchDN string `json:"ldap_search_dn,omitempty"`
+	// The search password of ldap service.
+	LDAPSearchPassword string `json:"ldap_search_password,omitempty"`
+	// The serach uid from ldap service attributes.
+	LDAPUID string `json:"ldap_uid,omitempty"`
+	// The url of ldap service.
+	LDAPURL string `json:"ldap_url,omitempty"`
+	// Verify Ldap server certificate.
+	LDAPVerifyCert bool `json:"ldap_verify_cert,omitempty"`
+}
+
+// LdapFailedImportUser is a Harbor API model.
+type LdapFailedImportUser struct {
+	// fail reason.
+	Error string `json:"error,omitempty"`
+	// the uid can't add to system.
+	UID string `json:"uid,omitempty"`
+}
+
+// LdapImportUsers is a Harbor API model.
+type LdapImportUsers struct {
+	// selected uid list
+	LDAPUIDList []string `json:"ldap_uid_list,omitempty"`
+}
+
+// LdapPingResult is a Harbor API model.
+//
+// The ldap ping result
+type LdapPingResult struct {
+	// The ping operation output message.
+	Message string `json:"message,omitempty"`
+	// Test success
+	Success bool `json:"success,omitempty"`
+}
+
+// LdapUser is a Harbor API model.
+type LdapUser struct {
+	// The user email address from "mail" or "email" attribute.
+	Email string `json:"email,omitempty"`
+	// The user realname from "uid" or "cn" attribute.
+	Realname string `json:"realname,omitempty"`
+	// ldap username.
+	Username string `json:"username,omitempty"`
+}
+
+// Metadata is a Harbor API model.
+type Metadata struct {
+	// icon
+	Icon string `json:"icon,omitempty"`
+	// id
+	ID string `json:"id,omitempty"`
+	// maintainers
+	Maintainers []string `json:"maintainers,omitempty"`
+	// name
+	Name string `json:"name,omitempty"`
+	// source
+	Source string `json:"source,omitempty"`
+	// version
+	Version string `json:"version,omitempty"`
+}
+
+// Metrics is a Harbor API model.
+type Metrics struct {
+	// The count of error task
+	ErrorTaskCount int `json:"error_task_count,omitempty"`
+	// The count of pending task
+	PendingTaskCount int `json:"pending_task_count,omitempty"`
+	// The count of running task
+	RunningTaskCount int `json:"running_task_count,omitempty"`
+	// The count of scheduled task
+	ScheduledTaskCount int `json:"scheduled_task_count,omitempty"`
+	// The count of stopped task
+	StoppedTaskCount int `json:"stopped_task_count,omitempty"`
+	// The count of success task
+	SuccessTaskCount int `json:"success_task_count,omitempty"`
+	// The count of task
+	TaskCount int `json:"task_count,omitempty"`
+}
+
+// NativeReportSummary is a Harbor API model.
+//
+// The summary for the native report
+type NativeReportSummary struct {
+	// The complete percent of the scanning which value is between 0 and 100
+	CompletePercent int `json:"complete_percent,omitempty"`
+	// The seconds spent for generating the report
+	Duration int `json:"duration,omitempty"`
+	// The end time of the scan process that generating report
+	EndTime string `json:"end_time,omitempty"`
+	// id of the native scan report
+	ReportID string `json:"report_id,omitempty"`
+	// The status of the report generating process
+	ScanStatus string  `json:"scan_status,omitempty"`
+	Scanner    Scanner `json:"scanner,omitzero"`
+	// The overall severity
+	Severity string `json:"severity,omitempty"`
+	// The start time of the scan process that generating report
+	StartTime string               `json:"start_time,omitempty"`
+	Summary   VulnerabilitySummary `json:"summary,omitzero"`
+}
+
+// NewTag is a Harbor API model.
+//
+// The request body for creating a tag
+type NewTag struct {
+	// The name of the tag
+	Name string `json:"name"`
+}
+
+// NotifyType is a Harbor API model.
+//
+// Webhook supported notify type.
+type NotifyType = string
+
+// OIDCCliSecretReq is a Harbor API model.
+type OIDCCliSecretReq struct {
+	// The new secret
+	Secret string `json:"secret,omitempty"`
+}
+
+// OIDCUserInfo is a Harbor API model.
+type OIDCUserInfo struct {
+	// The creation time of the OIDC user info record.
+	CreationTime string `json:"creation_time,omitempty"`
+	// the ID of the OIDC info record
+	ID int `json:"id,omitempty"`
+	// the secret of the OIDC user that can be used for CLI to push/pull artifacts
+	Secret string `json:"secret,omitempty"`
+	// the concatenation of sub and issuer in the ID token
+	Subiss string `json:"subiss,omitempty"`
+	// The update time of the OIDC user info record.
+	UpdateTime string `json:"update_time,omitempty"`
+	// the ID of the user
+	UserID int `json:"user_id,omitempty"`
+}
+
+// OverallHealthStatus is a Harbor API model.
+//
+// The system health status
+type OverallHealthStatus struct {
+	Components []ComponentHealthStatus `json:"components,omitempty"`
+	// The overall health status. It is "healthy" only when all the components' status are "healthy"
+	Status string `json:"status,omitempty"`
+}
+
+// PasswordReq is a Harbor API model.
+type PasswordReq struct {
+	// New password for marking as to be updated.
+	NewPassword string `json:"new_password,omitempty"`
+	// The user's existing password.
+	OldPassword string `json:"old_password,omitempty"`
+}
+
+// PayloadFormat is a Harbor API model.
+//
+// Webhook supported payload format type collections.
+type PayloadFormat struct {
+	// The supported payload formats for this notify type.
+	Formats    []PayloadFormatType `json:"formats,omitempty"`
+	NotifyType NotifyType          `json:"notify_type,omitempty"`
+}
+
+// PayloadFormatType is a Harbor API model.
+//
+// The type of webhook paylod format.
+type PayloadFormatType = string
+
+// Permission is a Harbor API model.
+type Permission struct {
+	// The permission action
+	Action string `json:"action,omitempty"`
+	// The permission resoruce
+	Resource string `json:"resource,omitempty"`
+}
+
+// Permissions is a Harbor API model.
+type Permissions struct {
+	// The project level permissions
+	Project []Permission `json:"project,omitempty"`
+	// The system level permissions
+	System []Permission `json:"system,omitempty"`
+}
+
+// Platform is a Harbor API model.
+type Platform struct {
+	// The features of the OS that the artifact applys to
+	OsFeatures []string `json:"'os.features',omitempty"`
+	// The version of the OS that the artifact applys to
+	OsVersion string `json:"'os.version',omitempty"`
+	// The architecture that the artifact applys to
+	Architecture string `json:"architecture,omitempty"`
+	// The OS that the artifact applys to
+	Os string `json:"os,omitempty"`
+	// The variant of the CPU
+	Variant string `json:"variant,omitempty"`
+}
+
+// PreheatPolicy is a Harbor API model.
+type PreheatPolicy struct {
+	// The Create Time of preheat policy
+	CreationTime string `json:"creation_time,omitempty"`
+	// The Description of preheat policy
+	Description string `json:"description,omitempty"`
+	// Whether the preheat policy enabled
+	Enabled bool `json:"enabled"`
+	// The extra attributes of preheat policy
+	ExtraAttrs string `json:"extra_attrs,omitempty"`
+	// The Filters of preheat policy
+	Filters string `json:"filters,omitempty"`
+	// The ID of preheat policy
+	ID int `json:"id,omitempty"`
+	// The Name of preheat policy
+	Name string `json:"name,omitempty"`
+	// The ID of preheat policy project
+	ProjectID int `json:"project_id,omitempty"`
+	// The ID of preheat policy provider
+	ProviderID int `json:"provider_id,omitempty"`
+	// The Name of preheat policy provider
+	ProviderName string `json:"provider_name,omitempty"`
+	// The Trigger of preheat policy
+	Trigger string `json:"trigger,omitempty"`
+	// The Update Time of preheat policy
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// Project is a Harbor API model.
+type Project struct {
+	// The creation time of the project.
+	CreationTime string `json:"creation_time,omitempty"`
+	// The role ID with highest permission of the current user who triggered the API (for UI).  This attribute is deprecated and will be removed in future versions.
+	CurrentUserRoleID int `json:"current_user_role_id,omitempty"`
+	// The list of role ID of the current user who triggered the API (for UI)
+	CurrentUserRoleIds []int `json:"current_user_role_ids,omitempty"`
+	// The CVE allowlist of this project.
+	CVEAllowlist CVEAllowlist `json:"cve_allowlist,omitzero"`
+	// A deletion mark of the project.
+	Deleted bool `json:"deleted,omitempty"`
+	// The metadata of the project.
+	Metadata ProjectMetadata `json:"metadata,omitzero"`
+	// The name of the project.
+	Name string `json:"name,omitempty"`
+	// The owner ID of the project always means the creator of the project.
+	OwnerID int `json:"owner_id,omitempty"`
+	// The owner name of the project.
+	OwnerName string `json:"owner_name,omitempty"`
+	// Project ID
+	ProjectID int `json:"project_id,omitempty"`
+	// The ID of referenced registry when the project is a proxy cache project.
+	RegistryID int `json:"registry_id,omitempty"`
+	// The number of the repositories under this project.
+	RepoCount int `json:"repo_count"`
+	// Correspond to the UI about whether the project's publicity is  updatable (for UI)
+	Togglable bool `json:"togglable,omitempty"`
+	// The update time of the project.
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// ProjectDeletable is a Harbor API model.
+type ProjectDeletable struct {
+	// Whether the project can be deleted.
+	Deletable bool `json:"deletable,omitempty"`
+	// The detail message when the project can not be deleted.
+	Message string `json:"message,omitempty"`
+}
+
+// ProjectMember is a Harbor API model.
+type ProjectMember struct {
+	MemberGroup UserGroup  `json:"member_group,omitzero"`
+	MemberUser  UserEntity `json:"member_user,omitzero"`
+	// The role id 1 for projectAdmin, 2 for developer, 3 for guest, 4 for maintainer
+	RoleID int `json:"role_id,omitempty"`
+}
+
+// ProjectMemberEntity is a Harbor API model.
+type ProjectMemberEntity struct {
+	// the id of entity, if the member is a user, it is user_id in user table. if the member is a user group, it is the user group's ID in user_group table.
+	EntityID int `json:"entity_id,omitempty"`
+	// the name of the group member.
+	EntityName string `json:"entity_name,omitempty"`
+	// the entity's type, u for user entity, g for group entity.
+	EntityType string `json:"entity_type,omitempty"`
+	// the project member id
+	ID int `json:"id,omitempty"`
+	// the project id
+	ProjectID int `json:"project_id,omitempty"`
+	// the role id
+	RoleID int `json:"role_id,omitempty"`
+	// the name of the role
+	RoleName string `json:"role_name,omitempty"`
+}
+
+// ProjectMetadata is a Harbor API model.
+type ProjectMetadata struct {
+	// Whether generating SBOM automatically when pushing a subject artifact. The valid values are "true", "false".
+	AutoSBOMGeneration *string `json:"auto_sbom_generation,omitempty"`
+	// Whether scan images automatically when pushing. The valid values are "true", "false".
+	AutoScan *string `json:"auto_scan,omitempty"`
+	// Whether content trust is enabled or not. If it is enabled, user can't pull unsigned images from this project. The valid values are "true", "false".
+	EnableContentTrust *string `json:"enable_content_trust,omitempty"`
+	// Whether cosign content trust is enabled or not. If it is enabled, user can't pull images without cosign signature from this project. The valid values are "true", "false".
+	EnableContentTrustCosign *string `json:"enable_content_trust_cosign,omitempty"`
+	// The max connection per artifact to the upstream registry in current proxy cache project, if it is -1, no limit to upstream registry connections
+	MaxUpstreamConn *string `json:"max_upstream_conn,omitempty"`
+	// Whether prevent the vulnerable images from running. The valid values are "true", "false".
+	PreventVul *string `json:"prevent_vul,omitempty"`
+	// Whether to serve images from local cache when they are removed from the upstream registry. The valid values are "true", "false".
+	ProxyCacheLocalOnNotFound *string `json:"proxy_cache_local_on_not_found,omitempty"`
+	// Whether the proxy cache project should proxy OCI 1.1 referrer API requests to the upstream registry. The valid values are "true", "false".
+	ProxyReferrerAPI *string `json:"proxy_referrer_api,omitempty"`
+	// The bandwidth limit of proxy cache, in Kbps (kilobits per second). It limits the communication between Harbor and the upstream registry, not the client and the Harbor.
+	ProxySpeedKB *string `json:"proxy_speed_kb,omitempty"`
+	// The public status of the project. The valid values are "true", "false".
+	Public string `json:"public,omitempty"`
+	// The ID of the tag retention policy for the project
+	RetentionID *string `json:"retention_id,omitempty"`
+	// Whether this project reuse the system level CVE allowlist as the allowlist of its own. The valid values are "true", "false".
+	// If it is set to "true" the actual allowlist associate with this project, if any, will be ignored.
+	ReuseSysCVEAllowlist *string `json:"reuse_sys_cve_allowlist,omitempty"`
+	// If the vulnerability is high than severity defined here, the images can't be pulled. The valid values are "none", "low", "medium", "high", "critical".
+	Severity *string `json:"severity,omitempty"`
+}
+
+// ProjectReq is a Harbor API model.
+type ProjectReq struct {
+	// The CVE allowlist of the project.
+	CVEAllowlist CVEAllowlist `json:"cve_allowlist"`
+	// The metadata of the project.
+	Metadata ProjectMetadata `json:"metadata,omitzero"`
+	// The name of the project.
+	ProjectName string `json:"project_name,omitempty"`
+	// deprecated, reserved for project creation in replication
+	Public *bool `json:"public,omitempty"`
+	// The ID of referenced registry when creating the proxy cache project
+	RegistryID *int `json:"registry_id,omitempty"`
+	// The storage quota of the project.
+	StorageLimit *int `json:"storage_limit,omitempty"`
+}
+
+// ProjectScanner is a Harbor API model.
+type ProjectScanner struct {
+	// The identifier of the scanner registration
+	UUID string `json:"uuid"`
+}
+
+// ProjectSummary is a Harbor API model.
+type ProjectSummary struct {
+	// The total number of developer members.
+	DeveloperCount int `json:"developer_count,omitempty"`
+	// The total number of guest members.
+	GuestCount int `json:"guest_count,omitempty"`
+	// The total number of limited guest members.
+	LimitedGuestCount int `json:"limited_guest_count,omitempty"`
+	// The total number of maintainer members.
+	MaintainerCount int `json:"maintainer_count,omitempty"`
+	// The total number of project admin members.
+	ProjectAdminCount int                 `json:"project_admin_count,omitempty"`
+	Quota             ProjectSummaryQuota `json:"quota,omitzero"`
+	Registry          Registry            `json:"registry,omitzero"`
+	// The number of the repositories under this project.
+	RepoCount int `json:"repo_count"`
+}
+
+// ProjectSummaryQuota is a Harbor API model.
+type ProjectSummaryQuota struct {
+	// The hard limits of the quota
+	Hard ResourceList `json:"hard,omitempty"`
+	// The used status of the quota
+	Used ResourceList `json:"used,omitempty"`
+}
+
+// ProviderUnderProject is a Harbor API model.
+type ProviderUnderProject struct {
+	Default  bool   `json:"default,omitempty"`
+	Enabled  bool   `json:"enabled,omitempty"`
+	ID       int    `json:"id,omitempty"`
+	Provider string `json:"provider,omitempty"`
+}
+
+// Quota is a Harbor API model.
+//
+// The quota object
+type Quota struct {
+	// the creation time of the quota
+	CreationTime string `json:"creation_time,omitempty"`
+	// The hard limits of the quota
+	Hard ResourceList `json:"hard"`
+	// ID of the quota
+	ID int `json:"id,omitempty"`
+	// The reference object of the quota
+	Ref QuotaRefObject `json:"ref,omitempty"`
+	// the update time of the quota
+	UpdateTime string `json:"update_time,omitempty"`
+	// The used status of the quota
+	Used ResourceList `json:"used"`
+}
+
+// QuotaRefObject is a Harbor API model.
+type QuotaRefObject = map[string]any
+
+// QuotaUpdateReq is a Harbor API model.
+type QuotaUpdateReq struct {
+	// The new hard limits for the quota
+	Hard ResourceList `json:"hard,omitempty"`
+}
+
+// Reference is a Harbor API model.
+type Reference struct {
+	Annotations Annotations `json:"annotations,omitempty"`
+	// The digest of the child artifact
+	ChildDigest string `json:"child_digest,omitempty"`
+	// The child ID of the reference
+	ChildID int `json:"child_id,omitempty"`
+	// The parent ID of the reference
+	ParentID int      `json:"parent_id,omitempty"`
+	Platform Platform `json:"platform,omitzero"`
+	// The download URLs
+	Urls []string `json:"urls,omitempty"`
+}
+
+// Registry is a Harbor API model.
+type Registry struct {
+	// The PEM-encoded CA certificate for this registry endpoint. If provided, this CA will be used to verify the registry's certificate instead of the system CA pool.
+	CACertificate *string `json:"ca_certificate,omitempty"`
+	// The create time of the policy.
+	CreationTime string             `json:"creation_time,omitempty"`
+	Credential   RegistryCredential `json:"credential,omitzero"`
+	// Description of the registry.
+	Description string `json:"description,omitempty"`
+	// The registry ID.
+	ID int `json:"id"`
+	// Whether or not the certificate will be verified when Harbor tries to access the server.
+	Insecure bool `json:"insecure,omitempty"`
+	// The registry name.
+	Name string `json:"name,omitempty"`
+	// Health status of the registry.
+	Status string `json:"status,omitempty"`
+	// Type of the registry, e.g. 'harbor'.
+	Type string `json:"type,omitempty"`
+	// The update time of the policy.
+	UpdateTime string `json:"update_time,omitempty"`
+	// The registry URL string.
+	URL string `json:"url,omitempty"`
+}
+
+// RegistryCredential is a Harbor API model.
+type RegistryCredential struct {
+	// Access key, e.g. user name when credential type is 'basic'.
+	AccessKey string `json:"access_key,omitempty"`
+	// Access secret, e.g. password when credential type is 'basic'.
+	AccessSecret string `json:"access_secret,omitempty"`
+	// Credential type, such as 'basic', 'oauth'.
+	Type string `json:"type,omitempty"`
+}
+
+// RegistryEndpoint is a Harbor API model.
+//
+// The style of the resource filter
+type RegistryEndpoint struct {
+	// The endpoint key
+	Key string `json:"key,omitempty"`
+	// The endpoint value
+	Value string `json:"value,omitempty"`
+}
+
+// RegistryInfo is a Harbor API model.
+//
+// The registry info contains the base info and capability declarations of the registry
+type RegistryInfo struct {
+	// The description
+	Description string `json:"description,omitempty"`
+	// The registry whether support copy by chunk.
+	SupportedCopyByChunk *bool `json:"supported_copy_by_chunk,omitempty"`
+	// The filters that the registry supports
+	SupportedResourceFilters []FilterStyle `json:"supported_resource_filters,omitempty"`
+	// The triggers that the registry supports
+	SupportedTriggers []string `json:"supported_triggers,omitempty"`
+	// The registry type
+	Type string `json:"type,omitempty"`
+}
+
+// RegistryPing is a Harbor API model.
+type RegistryPing struct {
+	// The registry access key.
+	AccessKey *string `json:"access_key,omitempty"`
+	// The registry access secret.
+	AccessSecret *string `json:"access_secret,omitempty"`
+	// The PEM-encoded CA certificate for this registry endpoint.
+	CACertificate *string `json:"ca_certificate,omitempty"`
+	// Credential type of the registry, e.g. 'basic'.
+	CredentialType *string `json:"credential_type,omitempty"`
+	// The registry ID.
+	ID *int `json:"id,omitempty"`
+	// Whether or not the certificate will be verified when Harbor tries to access the server.
+	Insecure *bool `json:"insecure,omitempty"`
+	// Type of the registry, e.g. 'harbor'.
+	Type *string `json:"type,omitempty"`
+	// The registry URL.
+	URL *string `json:"url,omitempty"`
+}
+
+// RegistryProviderCredentialPattern is a Harbor API model.
+//
+// The registry credential pattern
+type RegistryProviderCredentialPattern struct {
+	// The access key data
+	AccessKeyData string `json:"access_key_data,omitempty"`
+	// The access key type
+	AccessKeyType string `json:"access_key_type,omitempty"`
+	// The access secret data
+	AccessSecretData string `json:"access_secret_data,omitempty"`
+	// The access secret type
+	AccessSecretType string `json:"access_secret_type,omitempty"`
+}
+
+// RegistryProviderEndpointPattern is a Harbor API model.
+//
+// The registry endpoint pattern
+type RegistryProviderEndpointPattern struct {
+	// The endpoint type
+	EndpointType string `json:"endpoint_type,omitempty"`
+	// The endpoint list
+	Endpoints []RegistryEndpoint `json:"endpoints,omitempty"`
+}
+
+// RegistryProviderInfo is a Harbor API model.
+//
+// The registry provider info contains the base info and capability declarations of the registry provider
+type RegistryProviderInfo struct {
+	// The credential pattern
+	CredentialPattern RegistryProviderCredentialPattern `json:"credential_pattern,omitzero"`
+	// The endpoint pattern
+	EndpointPattern RegistryProviderEndpointPattern `json:"endpoint_pattern,omitzero"`
+}
+
+// RegistryUpdate is a Harbor API model.
+type RegistryUpdate struct {
+	// The registry access key.
+	AccessKey *string `json:"access_key,omitempty"`
+	// The registry access secret.
+	AccessSecret *string `json:"access_secret,omitempty"`
+	// The PEM-encoded CA certificate for this registry endpoint.
+	CACertificate *string `json:"ca_certificate,omitempty"`
+	// Credential type of the registry, e.g. 'basic'.
+	CredentialType *string `json:"credential_type,omitempty"`
+	// Description of the registry.
+	Description *string `json:"description,omitempty"`
+	// Whether or not the certificate will be verified when Harbor tries to access the server.
+	Insecure *bool `json:"insecure,omitempty"`
+	// The registry name.
+	Name *string `json:"name,omitempty"`
+	// The registry URL.
+	URL *string `json:"url,omitempty"`
+}
+
+// ReplicationExecution is a Harbor API model.
+//
+// The replication execution
+type ReplicationExecution struct {
+	// The end time
+	EndTime string `json:"end_time,omitempty"`
+	// The count of failed executions
+	Failed int `json:"failed"`
+	// The ID of the execution
+	ID int `json:"id,omitempty"`
+	// The count of in_progress executions
+	InProgress int `json:"in_progress"`
+	// The ID if the policy that the execution belongs to
+	PolicyID int `json:"policy_id,omitempty"`
+	// The start time
+	StartTime string `json:"start_time,omitempty"`
+	// The status of the execution
+	Status string `json:"status,omitempty"`
+	// The status text
+	StatusText string `json:"status_text"`
+	// The count of stopped executions
+	Stopped int `json:"stopped"`
+	// The count of succeed executions
+	Succeed int `json:"succeed"`
+	// The total count of all executions
+	Total int `json:"total"`
+	// The trigger mode
+	Trigger string `json:"trigger,omitempty"`
+}
+
+// ReplicationFilter is a Harbor API model.
+type ReplicationFilter struct {
+	// matches or excludes the result
+	Decoration string `json:"decoration,omitempty"`
+	// The replication policy filter type.
+	Type string `json:"type,omitempty"`
+	// The value of replication policy filter. For name, tag, and resource filters, this should be a string. For label filters, this should be an array of strings.
+	Value any `json:"value,omitempty"`
+}
+
+// ReplicationPolicy is a Harbor API model.
+type ReplicationPolicy struct {
+	// Whether to enable copy by chunk.
+	CopyByChunk *bool `json:"copy_by_chunk,omitempty"`
+	// The create time of the policy.
+	CreationTime string `json:"creation_time,omitempty"`
+	// Deprecated, use "replicate_deletion" instead. Whether to replicate the deletion operation.
+	Deletion bool `json:"deletion,omitempty"`
+	// The description of the policy.
+	Description string `json:"description,omitempty"`
+	// The destination namespace.
+	DestNamespace string `json:"dest_namespace,omitempty"`
+	// Specify how many path components will be replaced by the provided destination namespace.
+	// The default value is -1 in which case the legacy mode will be applied.
+	DestNamespaceReplaceCount *int `json:"dest_namespace_replace_count,omitempty"`
+	// The destination registry.
+	DestRegistry Registry `json:"dest_registry,omitzero"`
+	// Whether the policy is enabled or not.
+	Enabled bool `json:"enabled,omitempty"`
+	// The replication policy filter array.
+	Filters []ReplicationFilter `json:"filters,omitempty"`
+	// The policy ID.
+	ID int `json:"id,omitempty"`
+	// The policy name.
+	Name string `json:"name,omitempty"`
+	// Whether to override the resources on the destination registry.
+	Override bool `json:"override,omitempty"`
+	// Whether to replicate the deletion operation.
+	ReplicateDeletion bool `json:"replicate_deletion,omitempty"`
+	// Whether to skip execution until the previous active execution finishes,
+	// avoiding the execution of the same replication rules multiple times in parallel.
+	SingleActiveReplication *bool `json:"single_active_replication,omitempty"`
+	// speed limit for each task
+	Speed *int `json:"speed,omitempty"`
+	// The source registry.
+	SrcRegistry Registry           `json:"src_registry,omitzero"`
+	Trigger     ReplicationTrigger `json:"trigger,omitzero"`
+	// The update time of the policy.
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// ReplicationTask is a Harbor API model.
+//
+// The replication task
+type ReplicationTask struct {
+	// The destination resource that the task operates
+	DstResource string `json:"dst_resource,omitempty"`
+	// The end time of the task
+	EndTime string `json:"end_time,omitempty"`
+	// The ID of the execution that the task belongs to
+	ExecutionID int `json:"execution_id,omitempty"`
+	// The ID of the task
+	ID int `json:"id,omitempty"`
+	// The ID of the underlying job that the task related to
+	JobID string `json:"job_id,omitempty"`
+	// The operation of the task
+	Operation string `json:"operation,omitempty"`
+	// The type of the resource that the task operates
+	ResourceType string `json:"resource_type,omitempty"`
+	// The source resource that the task operates
+	SrcResource string `json:"src_resource,omitempty"`
+	// The start time of the task
+	StartTime string `json:"start_time,omitempty"`
+	// The status of the task
+	Status string `json:"status,omitempty"`
+}
+
+// ReplicationTrigger is a Harbor API model.
+type ReplicationTrigger struct {
+	TriggerSettings ReplicationTriggerSettings `json:"trigger_settings,omitzero"`
+	// The replication policy trigger type. The valid values are manual, event_based and scheduled.
+	Type string `json:"type,omitempty"`
+}
+
+// ReplicationTriggerSettings is a Harbor API model.
+type ReplicationTriggerSettings struct {
+	// The cron string for scheduled trigger
+	Cron string `json:"cron,omitempty"`
+}
+
+// Repository is a Harbor API model.
+type Repository struct {
+	// The count of the artifacts inside the repository
+	ArtifactCount int `json:"artifact_count"`
+	// The creation time of the repository
+	CreationTime *string `json:"creation_time,omitempty"`
+	// The description of the repository
+	Description string `json:"description,omitempty"`
+	// The ID of the repository
+	ID int `json:"id,omitempty"`
+	// The name of the repository
+	Name string `json:"name,omitempty"`
+	// The ID of the project that the repository belongs to
+	ProjectID int `json:"project_id,omitempty"`
+	// The count that the artifact inside the repository pulled
+	PullCount int `json:"pull_count"`
+	// The update time of the repository
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// ResourceList is a Harbor API model.
+type ResourceList = map[string]int
+
+// RetentionExecution is a Harbor API model.
+type RetentionExecution struct {
+	DryRun    bool   `json:"dry_run,omitempty"`
+	EndTime   string `json:"end_time,omitempty"`
+	ID        int    `json:"id,omitempty"`
+	PolicyID  int    `json:"policy_id,omitempty"`
+	StartTime string `json:"start_time,omitempty"`
+	Status    string `json:"status,omitempty"`
+	Trigger   string `json:"trigger,omitempty"`
+}
+
+// RetentionExecutionTask is a Harbor API model.
+type RetentionExecutionTask struct {
+	EndTime        string `json:"end_time,omitempty"`
+	ExecutionID    int    `json:"execution_id,omitempty"`
+	ID             int    `json:"id,omitempty"`
+	JobID          string `json:"job_id,omitempty"`
+	Repository     string `json:"repository,omitempty"`
+	Retained       int    `json:"retained"`
+	StartTime      string `json:"start_time,omitempty"`
+	Status         string `json:"status,omitempty"`
+	StatusCode     int    `json:"status_code"`
+	StatusRevision int    `json:"status_revision,omitempty"`
+	Total          int    `json:"total"`
+}
+
+// RetentionMetadata is a Harbor API model.
+//
+// the tag retention metadata
+type RetentionMetadata struct {
+	// supported scope selectors
+	ScopeSelectors []RetentionSelectorMetadata `json:"scope_selectors,omitempty"`
+	// supported tag selectors
+	TagSelectors []RetentionSelectorMetadata `json:"tag_selectors,omitempty"`
+	// templates
+	Templates []RetentionRuleMetadata `json:"templates,omitempty"`
+}
+
+// RetentionPolicy is a Harbor API model.
+//
+// retention policy
+type RetentionPolicy struct {
+	Algorithm string               `json:"algorithm,omitempty"`
+	ID        int                  `json:"id,omitempty"`
+	Rules     []RetentionRule      `json:"rules,omitempty"`
+	Scope     RetentionPolicyScope `json:"scope,omitzero"`
+	Trigger   RetentionRuleTrigger `json:"trigger,omitzero"`
+}
+
+// RetentionPolicyScope is a Harbor API model.
+type RetentionPolicyScope struct {
+	Level string `json:"level,omitempty"`
+	Ref   int    `json:"ref,omitempty"`
+}
+
+// RetentionRule is a Harbor API model.
+type RetentionRule struct {
+	Action         string                         `json:"action,omitempty"`
+	Disabled       bool                           `json:"disabled,omitempty"`
+	ID             int                            `json:"id,omitempty"`
+	Params         map[string]any                 `json:"params,omitempty"`
+	Priority       int                            `json:"priority,omitempty"`
+	ScopeSelectors map[string][]RetentionSelector `json:"scope_selectors,omitempty"`
+	TagSelectors   []RetentionSelector            `json:"tag_selectors,omitempty"`
+	Template       string                         `json:"template,omitempty"`
+}
+
+// RetentionRuleMetadata is a Harbor API model.
+//
+// the tag retention rule metadata
+type RetentionRuleMetadata struct {
+	// rule action
+	Action string `json:"action,omitempty"`
+	// rule display text
+	DisplayText string `json:"display_text,omitempty"`
+	// rule params
+	Params []RetentionRuleParamMetadata `json:"params,omitempty"`
+	// rule id
+	RuleTemplate string `json:"rule_template,omitempty"`
+}
+
+// RetentionRuleParamMetadata is a Harbor API model.
+//
+// rule param
+type RetentionRuleParamMetadata struct {
+	Required bool   `json:"required,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Unit     string `json:"unit,omitempty"`
+}
+
+// RetentionRuleTrigger is a Harbor API model.
+type RetentionRuleTrigger struct {
+	Kind       string `json:"kind,omitempty"`
+	References any    `json:"references,omitempty"`
+	Settings   any    `json:"settings,omitempty"`
+}
+
+// RetentionSelector is a Harbor API model.
+type RetentionSelector struct {
+	Decoration string `json:"decoration,omitempty"`
+	Extras     string `json:"extras,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	Pattern    string `json:"pattern,omitempty"`
+}
+
+// RetentionSelectorMetadata is a Harbor API model.
+//
+// retention selector
+type RetentionSelectorMetadata struct {
+	Decorations []string `json:"decorations,omitempty"`
+	DisplayText string   `json:"display_text,omitempty"`
+	Kind        string   `json:"kind,omitempty"`
+}
+
+// Robot is a Harbor API model.
+type Robot struct {
+	// The creation time of the robot.
+	CreationTime string `json:"creation_time,omitempty"`
+	// The reference of the robot creator, like the id of harbor user.
+	CreatorRef int `json:"creator_ref,omitempty"`
+	// The type of the robot creator, like local(harbor_user) or robot.
+	CreatorType string `json:"creator_type,omitempty"`
+	// The description of the robot
+	Description string `json:"description,omitempty"`
+	// The disable status of the robot
+	Disable bool `json:"disable"`
+	// The duration of the robot in days, duration must be either -1(Never) or a positive integer
+	Duration *int `json:"duration,omitempty"`
+	// The editable status of the robot
+	Editable bool `json:"editable"`
+	// The expiration date of the robot
+	ExpiresAt int `json:"expires_at,omitempty"`
+	// The ID of the robot
+	ID int `json:"id,omitempty"`
+	// The level of the robot, project or system
+	Level string `json:"level,omitempty"`
+	// The name of the robot
+	Name        string            `json:"name,omitempty"`
+	Permissions []RobotPermission `json:"permissions,omitempty"`
+	// The secret of the robot
+	Secret string `json:"secret,omitempty"`
+	// The update time of the robot.
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// RobotCreate is a Harbor API model.
+//
+// The request for robot account creation.
+type RobotCreate struct {
+	// The description of the robot
+	Description string `json:"description,omitempty"`
+	// The disable status of the robot
+	Disable bool `json:"disable,omitempty"`
+	// The duration of the robot in days, duration must be either -1(Never) or a positive integer
+	Duration int `json:"duration,omitempty"`
+	// The level of the robot, project or system
+	Level string `json:"level,omitempty"`
+	// The name of the robot
+	Name        string            `json:"name,omitempty"`
+	Permissions []RobotPermission `json:"permissions,omitempty"`
+	// The secret of the robot
+	Secret string `json:"secret,omitempty"`
+}
+
+// RobotCreateV1 is a Harbor API model.
+type RobotCreateV1 struct {
+	// The permission of robot account
+	Access []Access `json:"access,omitempty"`
+	// The description of robot account
+	Description string `json:"description,omitempty"`
+	// The expiration time on or after which the JWT MUST NOT be accepted for processing.
+	ExpiresAt int `json:"expires_at,omitempty"`
+	// The name of robot account
+	Name string `json:"name,omitempty"`
+}
+
+// RobotCreated is a Harbor API model.
+//
+// The response for robot account creation.
+type RobotCreated struct {
+	// The creation time of the robot.
+	CreationTime string `json:"creation_time,omitempty"`
+	// The expiration date of the robot
+	ExpiresAt int `json:"expires_at,omitempty"`
+	// The ID of the robot
+	ID int `json:"id,omitempty"`
+	// The name of the robot
+	Name string `json:"name,omitempty"`
+	// The secret of the robot
+	Secret string `json:"secret,omitempty"`
+}
+
+// RobotPermission is a Harbor API model.
+type RobotPermission struct {
+	Access []Access `json:"access,omitempty"`
+	// The kind of the permission
+	Kind string `json:"kind,omitempty"`
+	// The namespace of the permission
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// RobotSec is a Harbor API model.
+//
+// The response for refresh/update robot account secret.
+type RobotSec struct {
+	// The secret of the robot
+	Secret string `json:"secret,omitempty"`
+}
+
+// RoleRequest is a Harbor API model.
+type RoleRequest struct {
+	// The role id 1 for projectAdmin, 2 for developer, 3 for guest, 4 for maintainer
+	RoleID int `json:"role_id,omitempty"`
+}
+
+// SBOMOverview is a Harbor API model.
+//
+// The generate SBOM overview information
+type SBOMOverview struct {
+	// Time in seconds required to create the report
+	Duration int `json:"duration,omitempty"`
+	// The end time of the generating sbom report task
+	EndTime string `json:"end_time,omitempty"`
+	// id of the native scan report
+	ReportID string `json:"report_id,omitempty"`
+	// The digest of the generated SBOM accessory
+	SBOMDigest string `json:"sbom_digest,omitempty"`
+	// The status of the generating SBOM task
+	ScanStatus string  `json:"scan_status,omitempty"`
+	Scanner    Scanner `json:"scanner,omitzero"`
+	// The start time of the generating sbom report task
+	StartTime string `json:"start_time,omitempty"`
+}
+
+// ScanDataExportExecution is a Harbor API model.
+//
+// The replication execution
+type ScanDataExportExecution struct {
+	// The end time
+	EndTime string `json:"end_time,omitempty"`
+	// Indicates whether the export artifact is present in registry
+	FilePresent bool `json:"file_present"`
+	// The ID of the execution
+	ID int `json:"id,omitempty"`
+	// The start time
+	StartTime string `json:"start_time,omitempty"`
+	// The status of the execution
+	Status string `json:"status,omitempty"`
+	// The status text
+	StatusText string `json:"status_text"`
+	// The trigger mode
+	Trigger string `json:"trigger,omitempty"`
+	// The ID if the user triggering the export job
+	UserID int `json:"user_id,omitempty"`
+	// The name of the user triggering the job
+	UserName string `json:"user_name"`
+}
+
+// ScanDataExportExecutionList is a Harbor API model.
+//
+// The list of scan data export executions
+type ScanDataExportExecutionList struct {
+	// The list of scan data export executions
+	Items []ScanDataExportExecution `json:"items,omitempty"`
+}
+
+// ScanDataExportJob is a Harbor API model.
+//
+// The metadata associated with the scan data export job
+type ScanDataExportJob struct {
+	// The id of the scan data export job
+	ID int `json:"id,omitempty"`
+}
+
+// ScanDataExportRequest is a Harbor API model.
+//
+// The criteria to select the scan data to export.
+type ScanDataExportRequest struct {
+	// CVE-IDs for which to export data. Multiple CVE-IDs can be specified by separating using ',' and enclosed between '{}'. Defaults to all if empty
+	CVEIds string `json:"cveIds,omitempty"`
+	// Name of the scan data export job
+	JobName string `json:"job_name,omitempty"`
+	// A list of one or more labels for which to export the scan data, defaults to all if empty
+	Labels []int `json:"labels,omitempty"`
+	// A list of one or more projects for which to export the scan data, currently only one project is supported due to performance concerns, but define as array for extension in the future.
+	Projects []int `json:"projects,omitempty"`
+	// A list of repositories for which to export the scan data, defaults to all if empty
+	Repositories string `json:"repositories,omitempty"`
+	// A list of tags enclosed within '{}'. Defaults to all if empty
+	Tags string `json:"tags,omitempty"`
+}
+
+// ScanOverview is a Harbor API model.
+//
+// The scan overview attached in the metadata of tag
+type ScanOverview = map[string]NativeReportSummary
+
+// ScanType is a Harbor API model.
+type ScanType struct {
+	// The scan type for the scan request. Two options are currently supported, vulnerability and sbom
+	ScanType string `json:"scan_type,omitempty"`
+}
+
+// Scanner is a Harbor API model.
+type Scanner struct {
+	// Name of the scanner
+	Name string `json:"name,omitempty"`
+	// Name of the scanner provider
+	Vendor string `json:"vendor,omitempty"`
+	// Version of the scanner adapter
+	Version string `json:"version,omitempty"`
+}
+
+// ScannerAdapterMetadata is a Harbor API model.
+//
+// The metadata info of the scanner adapter
+type ScannerAdapterMetadata struct {
+	Capabilities []ScannerCapability `json:"capabilities,omitempty"`
+	Properties   map[string]string   `json:"properties,omitempty"`
+	Scanner      Scanner             `json:"scanner,omitzero"`
+}
+
+// ScannerCapability is a Harbor API model.
+type ScannerCapability struct {
+	ConsumesMimeTypes []string `json:"consumes_mime_types,omitempty"`
+	ProducesMimeTypes []string `json:"produces_mime_types,omitempty"`
+	// Specify the type of scanner capability, like vulnerability or sbom
+	Type string `json:"type"`
+}
+
+// ScannerRegistration is a Harbor API model.
+//
+// Registration represents a named configuration for invoking a scanner via its adapter.
+type ScannerRegistration struct {
+	// An optional value of the HTTP Authorization header sent with each request to the Scanner Adapter API.
+	AccessCredential string `json:"access_credential"`
+	// Optional property to describe the name of the scanner registration
+	Adapter string `json:"adapter,omitempty"`
+	// Specify what authentication approach is adopted for the HTTP communications.
+	// Supported types Basic", "Bearer" and api key header "X-ScannerAdapter-API-Key"
+	Auth string `json:"auth"`
+	// Indicates the capabilities of the scanner, e.g. support_vulnerability or support_sbom.
+	Capabilities map[string]any `json:"capabilities,omitempty"`
+	// The creation time of this registration
+	CreateTime string `json:"create_time,omitempty"`
+	// An optional description of this registration.
+	Description string `json:"description"`
+	// Indicate whether the registration is enabled or not
+	Disabled bool `json:"disabled"`
+	// Indicate the healthy of the registration
+	Health string `json:"health,omitempty"`
+	// Indicate if the registration is set as the system default one
+	IsDefault bool `json:"is_default"`
+	// The name of this registration.
+	Name string `json:"name,omitempty"`
+	// Indicate if skip the certificate verification when sending HTTP requests
+	SkipCertVerify bool `json:"skip_certVerify"`
+	// The update time of this registration
+	UpdateTime string `json:"update_time,omitempty"`
+	// A base URL of the scanner adapter
+	URL string `json:"url,omitempty"`
+	// Indicate whether use internal registry addr for the scanner to pull content or not
+	UseInternalAddr bool `json:"use_internal_addr"`
+	// The unique identifier of this registration.
+	UUID string `json:"uuid,omitempty"`
+	// Optional property to describe the vendor of the scanner registration
+	Vendor string `json:"vendor,omitempty"`
+	// Optional property to describe the version of the scanner registration
+	Version string `json:"version,omitempty"`
+}
+
+// ScannerRegistrationReq is a Harbor API model.
+type ScannerRegistrationReq struct {
+	// An optional value of the HTTP Authorization header sent with each request to the Scanner Adapter API.
+	//
+	// When updating a registration (PUT `/scanners/{registration_id}`), if this field is omitted or empty
+	// and `auth` is still one of Basic, Bearer, or APIKey, the previously stored credential is kept
+	// unchanged. Provide a non-empty value to set or rotate the credential. Clearing `auth` removes the
+	// need for a credential; in that case an empty `access_credential` does not restore the old secret.
+	AccessCredential string `json:"access_credential,omitempty"`
+	// Specify what authentication approach is adopted for the HTTP communications.
+	// Supported types Basic", "Bearer" and api key header "X-ScannerAdapter-API-Key"
+	Auth string `json:"auth,omitempty"`
+	// An optional description of this registration.
+	Description string `json:"description,omitempty"`
+	// Indicate whether the registration is enabled or not
+	Disabled bool `json:"disabled,omitempty"`
+	// The name of this registration
+	Name string `json:"name"`
+	// Indicate if skip the certificate verification when sending HTTP requests
+	SkipCertVerify bool `json:"skip_certVerify,omitempty"`
+	// A base URL of the scanner adapter.
+	URL string `json:"url"`
+	// Indicate whether use internal registry addr for the scanner to pull content or not
+	UseInternalAddr bool `json:"use_internal_addr,omitempty"`
+}
+
+// ScannerRegistrationSettings is a Harbor API model.
+type ScannerRegistrationSettings struct {
+	// An optional value of the HTTP Authorization header sent with each request to the Scanner Adapter API.
+	AccessCredential string `json:"access_credential,omitempty"`
+	// Specify what authentication approach is adopted for the HTTP communications.
+	// Supported types Basic", "Bearer" and api key header "X-ScannerAdapter-API-Key"
+	Auth string `json:"auth,omitempty"`
+	// The name of this registration
+	Name string `json:"name"`
+	// A base URL of the scanner adapter.
+	URL string `json:"url"`
+}
+
+// Schedule is a Harbor API model.
+type Schedule struct {
+	// the creation time of the schedule.
+	CreationTime string `json:"creation_time,omitempty"`
+	// The id of the schedule.
+	ID int `json:"id,omitempty"`
+	// The parameters of schedule job
+	Parameters map[string]any `json:"parameters,omitempty"`
+	Schedule   ScheduleObj    `json:"schedule,omitzero"`
+	// The status of the schedule.
+	Status string `json:"status,omitempty"`
+	// the update time of the schedule.
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// ScheduleObj is a Harbor API model.
+type ScheduleObj struct {
+	// A cron expression, a time-based job scheduler.
+	Cron string `json:"cron,omitempty"`
+	// The next time to schedule to run the job.
+	NextScheduledTime string `json:"next_scheduled_time,omitempty"`
+	// The schedule type. The valid values are 'Hourly', 'Daily', 'Weekly', 'Custom', 'Manual', 'None' and 'Schedule'.
+	// 'Manual' means to trigger it right away, 'Schedule' means to trigger it by a specified cron schedule and
+	// 'None' means to cancel the schedule.
+	Type string `json:"type,omitempty"`
+}
+
+// ScheduleTask is a Harbor API model.
+//
+// the schedule task info
+type ScheduleTask struct {
+	// the cron of the current schedule task
+	Cron string `json:"cron,omitempty"`
+	// the id of the Schedule task
+	ID int `json:"id,omitempty"`
+	// the update time of the schedule task
+	UpdateTime string `json:"update_time,omitempty"`
+	// the vendor id of the current task
+	VendorID int `json:"vendor_id,omitempty"`
+	// the vendor type of the current schedule task
+	VendorType string `json:"vendor_type,omitempty"`
+}
+
+// SchedulerStatus is a Harbor API model.
+//
+// the scheduler status
+type SchedulerStatus struct {
+	// if the scheduler is paused
+	Paused bool `json:"paused"`
+}
+
+// Search is a Harbor API model.
+type Search struct {
+	// Search results of the projects that matched the filter keywords.
+	Project []Project `json:"project,omitempty"`
+	// Search results of the repositories that matched the filter keywords.
+	Repository []SearchRepository `json:"repository,omitempty"`
+}
+
+// SearchRepository is a Harbor API model.
+type SearchRepository struct {
+	// The count of artifacts in the repository
+	ArtifactCount int `json:"artifact_count,omitempty"`
+	// The ID of the project that the repository belongs to
+	ProjectID int `json:"project_id,omitempty"`
+	// The name of the project that the repository belongs to
+	ProjectName string `json:"project_name,omitempty"`
+	// The flag to indicate the publicity of the project that the repository belongs to (1 is public, 0 is not)
+	ProjectPublic bool `json:"project_public,omitempty"`
+	// The count how many times the repository is pulled
+	PullCount int `json:"pull_count,omitempty"`
+	// The name of the repository
+	RepositoryName string `json:"repository_name,omitempty"`
+}
+
+// SecuritySummary is a Harbor API model.
+//
+// the security summary
+type SecuritySummary struct {
+	// the count of critical vulnerabilities
+	CriticalCnt int `json:"critical_cnt"`
+	// the list of dangerous artifacts
+	DangerousArtifacts []DangerousArtifact `json:"dangerous_artifacts,omitempty"`
+	// the list of dangerous CVEs
+	DangerousCves []DangerousCVE `json:"dangerous_cves,omitempty"`
+	// the count of fixable vulnerabilities
+	FixableCnt int `json:"fixable_cnt"`
+	// the count of high vulnerabilities
+	HighCnt int `json:"high_cnt,omitempty"`
+	// the count of low vulnerabilities
+	LowCnt int `json:"low_cnt"`
+	// the count of medium vulnerabilities
+	MediumCnt int `json:"medium_cnt"`
+	// the count of none vulnerabilities
+	NoneCnt int `json:"none_cnt,omitempty"`
+	// the count of scanned artifacts
+	ScannedCnt int `json:"scanned_cnt"`
+	// the total count of artifacts
+	TotalArtifact int `json:"total_artifact"`
+	// the count of total vulnerabilities
+	TotalVuls int `json:"total_vuls"`
+	// the count of unknown vulnerabilities
+	UnknownCnt int `json:"unknown_cnt,omitempty"`
+}
+
+// StartReplicationExecution is a Harbor API model.
+type StartReplicationExecution struct {
+	// The ID of policy that the execution belongs to.
+	PolicyID int `json:"policy_id,omitempty"`
+}
+
+// Statistic is a Harbor API model.
+type Statistic struct {
+	// The count of the private projects
+	PrivateProjectCount int `json:"private_project_count"`
+	// The count of the private repositories
+	PrivateRepoCount int `json:"private_repo_count"`
+	// The count of the public projects
+	PublicProjectCount int `json:"public_project_count"`
+	// The count of the public repositories
+	PublicRepoCount int `json:"public_repo_count"`
+	// The count of the total projects, only be seen by the system admin
+	TotalProjectCount int `json:"total_project_count"`
+	// The count of the total repositories, only be seen by the system admin
+	TotalRepoCount int `json:"total_repo_count"`
+	// The total storage consumption of blobs, only be seen by the system admin
+	TotalStorageConsumption int `json:"total_storage_consumption"`
+}
+
+// Stats is a Harbor API model.
+//
+// Stats provides the overall progress of the scan all process.
+type Stats struct {
+	// The number of the finished scan processes triggered by the scan all action
+	Completed int `json:"completed"`
+	// The metrics data for the each status
+	Metrics map[string]int `json:"metrics,omitempty"`
+	// A flag indicating job status of scan all.
+	Ongoing bool `json:"ongoing"`
+	// The total number of scan processes triggered by the scan all action
+	Total int `json:"total"`
+	// The trigger of the scan all job.
+	Trigger string `json:"trigger,omitempty"`
+}
+
+// Storage is a Harbor API model.
+type Storage struct {
+	// Free volume size.
+	Free int `json:"free,omitempty"`
+	// Total volume size.
+	Total int `json:"total,omitempty"`
+}
+
+// StringConfigItem is a Harbor API model.
+type StringConfigItem struct {
+	// The configure item can be updated or not
+	Editable bool `json:"editable"`
+	// The string value of current config item
+	Value string `json:"value"`
+}
+
+// SupportedWebhookEventTypes is a Harbor API model.
+//
+// Supported webhook event types and notify types.
+type SupportedWebhookEventTypes struct {
+	EventType      []EventType     `json:"event_type,omitempty"`
+	NotifyType     []NotifyType    `json:"notify_type,omitempty"`
+	PayloadFormats []PayloadFormat `json:"payload_formats,omitempty"`
+}
+
+// SystemInfo is a Harbor API model.
+type SystemInfo struct {
+	// The storage of system.
+	Storage []Storage `json:"storage,omitempty"`
+}
+
+// Tag is a Harbor API model.
+type Tag struct {
+	// The ID of the artifact that the tag attached to
+	ArtifactID int `json:"artifact_id,omitempty"`
+	// The ID of the tag
+	ID int `json:"id,omitempty"`
+	// The immutable status of the tag
+	Immutable bool `json:"immutable"`
+	// The name of the tag
+	Name string `json:"name,omitempty"`
+	// The latest pull time of the tag
+	PullTime string `json:"pull_time,omitempty"`
+	// The push time of the tag
+	PushTime string `json:"push_time,omitempty"`
+	// The ID of the repository that the tag belongs to
+	RepositoryID int `json:"repository_id,omitempty"`
+}
+
+// Task is a Harbor API model.
+type Task struct {
+	// The creation time of task
+	CreationTime string `json:"creation_time,omitempty"`
+	// The end time of task
+	EndTime string `json:"end_time,omitempty"`
+	// The ID of task execution
+	ExecutionID int        `json:"execution_id,omitempty"`
+	ExtraAttrs  ExtraAttrs `json:"extra_attrs,omitempty"`
+	// The ID of task
+	ID int `json:"id,omitempty"`
+	// The count of task run
+	RunCount int `json:"run_count,omitempty"`
+	// The start time of task
+	StartTime string `json:"start_time,omitempty"`
+	// The status of task
+	Status string `json:"status,omitempty"`
+	// The status message of task
+	StatusMessage string `json:"status_message,omitempty"`
+	// The update time of task
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// UserCreationReq is a Harbor API model.
+type UserCreationReq struct {
+	Comment  string `json:"comment,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Password string `json:"password,omitempty"`
+	Realname string `json:"realname,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+// UserEntity is a Harbor API model.
+type UserEntity struct {
+	// The ID of the user.
+	UserID int `json:"user_id,omitempty"`
+	// The name of the user.
+	Username string `json:"username,omitempty"`
+}
+
+// UserGroup is a Harbor API model.
+type UserGroup struct {
+	// The name of the user group
+	GroupName string `json:"group_name,omitempty"`
+	// The group type, 1 for LDAP group, 2 for HTTP group, 3 for OIDC group.
+	GroupType int `json:"group_type,omitempty"`
+	// The ID of the user group
+	ID int `json:"id,omitempty"`
+	// The DN of the LDAP group if group type is 1 (LDAP group).
+	LDAPGroupDN string `json:"ldap_group_dn,omitempty"`
+}
+
+// UserGroupSearchItem is a Harbor API model.
+type UserGroupSearchItem struct {
+	// The name of the user group
+	GroupName string `json:"group_name,omitempty"`
+	// The group type, 1 for LDAP group, 2 for HTTP group, 3 for OIDC group.
+	GroupType int `json:"group_type,omitempty"`
+	// The ID of the user group
+	ID int `json:"id,omitempty"`
+}
+
+// UserProfile is a Harbor API model.
+type UserProfile struct {
+	Comment  string `json:"comment,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Realname string `json:"realname,omitempty"`
+}
+
+// UserResp is a Harbor API model.
+type UserResp struct {
+	// indicate the admin privilege is grant by authenticator (LDAP), is always false unless it is the current login user
+	AdminRoleInAuth bool   `json:"admin_role_in_auth"`
+	Comment         string `json:"comment,omitempty"`
+	// The creation time of the user.
+	CreationTime string       `json:"creation_time,omitempty"`
+	Email        string       `json:"email,omitempty"`
+	OIDCUserMeta OIDCUserInfo `json:"oidc_user_meta,omitzero"`
+	Realname     string       `json:"realname,omitempty"`
+	SysadminFlag bool         `json:"sysadmin_flag"`
+	// The update time of the user.
+	UpdateTime string `json:"update_time,omitempty"`
+	UserID     int    `json:"user_id,omitempty"`
+	Username   string `json:"username,omitempty"`
+}
+
+// UserSearch is a Harbor API model.
+type UserSearch struct {
+	// The ID of the user.
+	UserID   int    `json:"user_id,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+// UserSearchRespItem is a Harbor API model.
+type UserSearchRespItem struct {
+	// The ID of the user.
+	UserID   int    `json:"user_id,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+// UserSysAdminFlag is a Harbor API model.
+type UserSysAdminFlag struct {
+	// true-admin, false-not admin.
+	SysadminFlag bool `json:"sysadmin_flag,omitempty"`
+}
+
+// VulnerabilityItem is a Harbor API model.
+//
+// the vulnerability item info
+type VulnerabilityItem struct {
+	// the CVE id of the vulnerability.
+	CVEID string `json:"cve_id,omitempty"`
+	// the nvd cvss v3 score of the vulnerability
+	CvssV3Score float64 `json:"cvss_v3_score,omitempty"`
+	// The description of the vulnerability
+	Desc string `json:"desc,omitempty"`
+	// the digest of the artifact
+	Digest string `json:"digest,omitempty"`
+	// the fixed version of the package
+	FixedVersion string `json:"fixed_version,omitempty"`
+	// Links of the vulnerability
+	Links []string `json:"links,omitempty"`
+	// the package of the vulnerability
+	Package string `json:"package,omitempty"`
+	// the project ID of the artifact
+	ProjectID int `json:"project_id,omitempty"`
+	// the repository name of the artifact
+	RepositoryName string `json:"repository_name,omitempty"`
+	// the severity of the vulnerability
+	Severity string `json:"severity,omitempty"`
+	// the status of the vulnerability, example "fixed", "won't fix"
+	Status string `json:"status,omitempty"`
+	// the tags of the artifact
+	Tags []string `json:"tags,omitempty"`
+	// the version of the package
+	Version string `json:"version,omitempty"`
+}
+
+// VulnerabilitySummary is a Harbor API model.
+//
+// VulnerabilitySummary contains the total number of the foun d vulnerabilities number and numbers of each severity level.
+type VulnerabilitySummary struct {
+	// The number of the fixable vulnerabilities
+	Fixable int `json:"fixable"`
+	// Numbers of the vulnerabilities with different severity
+	Summary map[string]int `json:"summary"`
+	// The total number of the found vulnerabilities
+	Total int `json:"total"`
+}
+
+// WebhookJob is a Harbor API model.
+//
+// The webhook job.
+type WebhookJob struct {
+	// The webhook job creation time.
+	CreationTime string `json:"creation_time,omitempty"`
+	// The webhook job event type.
+	EventType string `json:"event_type,omitempty"`
+	// The webhook job ID.
+	ID int `json:"id,omitempty"`
+	// The webhook job notify detailed data.
+	JobDetail string `json:"job_detail,omitempty"`
+	// The webhook job notify type.
+	NotifyType string `json:"notify_type,omitempty"`
+	// The webhook policy ID.
+	PolicyID int `json:"policy_id,omitempty"`
+	// The webhook job status.
+	Status string `json:"status,omitempty"`
+	// The webhook job update time.
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// WebhookLastTrigger is a Harbor API model.
+//
+// The webhook policy and last trigger time group by event type.
+type WebhookLastTrigger struct {
+	// The creation time of webhook policy.
+	CreationTime string `json:"creation_time,omitempty"`
+	// Whether or not the webhook policy enabled.
+	Enabled bool `json:"enabled,omitempty"`
+	// The webhook event type.
+	EventType string `json:"event_type,omitempty"`
+	// The last trigger time of webhook policy.
+	LastTriggerTime string `json:"last_trigger_time,omitempty"`
+	// The webhook policy name.
+	PolicyName string `json:"policy_name,omitempty"`
+}
+
+// WebhookPolicy is a Harbor API model.
+//
+// The webhook policy object
+type WebhookPolicy struct {
+	// The create time of the webhook policy.
+	CreationTime string `json:"creation_time,omitempty"`
+	// The creator of the webhook policy.
+	Creator string `json:"creator,omitempty"`
+	// The description of webhook policy.
+	Description string `json:"description,omitempty"`
+	// Whether the webhook policy is enabled or not.
+	Enabled    bool     `json:"enabled"`
+	EventTypes []string `json:"event_types,omitempty"`
+	// The webhook policy ID.
+	ID int `json:"id,omitempty"`
+	// The name of webhook policy.
+	Name string `json:"name,omitempty"`
+	// The project ID of webhook policy.
+	ProjectID int                   `json:"project_id,omitempty"`
+	Targets   []WebhookTargetObject `json:"targets,omitempty"`
+	// The update time of the webhook policy.
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// WebhookTargetObject is a Harbor API model.
+//
+// The webhook policy target object.
+type WebhookTargetObject struct {
+	// The webhook target address.
+	Address string `json:"address,omitempty"`
+	// The webhook auth header.
+	AuthHeader string `json:"auth_header,omitempty"`
+	// The payload format of webhook, by default is Default for http type.
+	PayloadFormat PayloadFormatType `json:"payload_format,omitempty"`
+	// Whether or not to skip cert verify.
+	SkipCertVerify bool `json:"skip_cert_verify,omitempty"`
+	// The webhook target notify type.
+	Type string `json:"type,omitempty"`
+}
+
+// Worker is a Harbor API model.
+//
+// worker in the pool
+type Worker struct {
+	// the checkin of the running job in the worker
+	CheckIn string `json:"check_in,omitempty"`
+	// The checkin time of the worker
+	CheckinAt *string `json:"checkin_at,omitempty"`
+	// the id of the worker
+	ID string `json:"id,omitempty"`
+	// the id of the running job in the worker
+	JobID string `json:"job_id,omitempty"`
+	// the name of the running job in the worker
+	JobName string `json:"job_name,omitempty"`
+	// the id of the worker pool
+	PoolID string `json:"pool_id,omitempty"`
+	// The start time of the worker
+	StartAt *string `json:"start_at,omitempty"`
+}
+
+// WorkerPool is a Harbor API model.
+//
+// the worker pool of job service
+type WorkerPool struct {
+	// The concurrency of the work pool
+	Concurrency int `json:"concurrency,omitempty"`
+	// The heartbeat time of the work pool
+	HeartbeatAt string `json:"heartbeat_at,omitempty"`
+	// The host of the work pool
+	Host string `json:"host,omitempty"`
+	// the process id of jobservice
+	Pid int `json:"pid,omitempty"`
+	// The start time of the work pool
+	StartAt string `json:"start_at,omitempty"`
+	// the id of the worker pool
+	WorkerPoolID string `json:"worker_pool_id,omitempty"`
+}
+
+// TriggerRetentionExecutionBody is a Harbor API model.
+type TriggerRetentionExecutionBody struct {
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
+// OperateRetentionExecutionBody is a Harbor API model.
+type OperateRetentionExecutionBody struct {
+	Action string `json:"action,omitempty"`
+}
+
+// PingOIDCBody is a Harbor API model.
+type PingOIDCBody struct {
+	// The URL of OIDC endpoint to be tested.
+	URL string `json:"url,omitempty"`
+	// Whether the certificate should be verified
+	VerifyCert bool `json:"verify_cert,omitempty"`
+}
+
+// ConfigurationsResponseScanAllPolicy is a Harbor API model.
+type ConfigurationsResponseScanAllPolicy struct {
+	// The parameters of the policy, the values are dependent on the type of the policy.
+	Parameter ConfigurationsResponseScanAllPolicyParameter `json:"parameter,omitzero"`
+	// The type of scan all policy, currently the valid values are "none" and "daily"
+	Type string `json:"type,omitempty"`
+}
+
+// ConfigurationsResponseScanAllPolicyParameter is a Harbor API model.
+//
+// The parameters of the policy, the values are dependent on the type of the policy.
+type ConfigurationsResponseScanAllPolicyParameter struct {
+	// The offset in seconds of UTC 0 o'clock, only valid when the policy type is "daily"
+	DailyTime int `json:"daily_time,omitempty"`
+}
@@ -0,0 +1,4514 @@
+// Code generated by hack/harborapi-gen from hack/harbor-openapi.yaml. DO NOT EDIT.
+
+package harborapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ListAuditLogsParams holds the parameters of ListAuditLogs.
+type ListAuditLogsParams struct {
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// ListAuditLogs sends GET /audit-logs.
+//
+// Get recent logs of projects which the user is a member with project admin role, or return all audit logs for system admin user (deprecated)
+func (c *Client) ListAuditLogs(ctx context.Context, params ListAuditLogsParams) ([]AuditLog, *http.Response, error) {
+	path := "/audit-logs"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []AuditLog
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// ListAuditLogExtsParams holds the parameters of ListAuditLogExts.
+type ListAuditLogExtsParams struct {
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// ListAuditLogExts sends GET /auditlog-exts.
+//
+// Get recent logs of the projects which the user is a member with project_admin role, or return all audit logs for system admin user
+func (c *Client) ListAuditLogExts(ctx context.Context, params ListAuditLogExtsParams) ([]AuditLogExt, *http.Response, error) {
+	path := "/auditlog-exts"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []AuditLogExt
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// ListAuditLogEventTypes sends GET /auditlog-exts/events.
+//
+// Get all event types of audit log
+func (c *Client) ListAuditLogEventTypes(ctx context.Context) ([]AuditLogEventType, *http.Response, error) {
+	path := "/auditlog-exts/events"
+	query := url.Values{}
+	var out []AuditLogEventType
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetConfigurations sends GET /configurations.
+//
+// Get system configurations.
+func (c *Client) GetConfigurations(ctx context.Context) (*ConfigurationsResponse, *http.Response, error) {
+	path := "/configurations"
+	query := url.Values{}
+	var out ConfigurationsResponse
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateConfigurationsParams holds the parameters of UpdateConfigurations.
+type UpdateConfigurationsParams struct {
+	// The configuration map can contain a subset of the attributes of the schema, which are to be updated.
+	Body Configurations
+}
+
+// UpdateConfigurations sends PUT /configurations.
+//
+// Modify system configurations.
+func (c *Client) UpdateConfigurations(ctx context.Context, params UpdateConfigurationsParams) (*http.Response, error) {
+	path := "/configurations"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// ExportScanDataParams holds the parameters of ExportScanData.
+type ExportScanDataParams struct {
+	// The criteria for the export
+	Body ScanDataExportRequest
+}
+
+// ExportScanData sends POST /export/cve.
+//
+// Export scan data for selected projects
+func (c *Client) ExportScanData(ctx context.Context, params ExportScanDataParams) (*ScanDataExportJob, *http.Response, error) {
+	path := "/export/cve"
+	query := url.Values{}
+	var out ScanDataExportJob
+	resp, err := c.do(ctx, http.MethodPost, path, query, params.Body, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// DownloadScanDataParams holds the parameters of DownloadScanData.
+type DownloadScanDataParams struct {
+	// Execution ID
+	ExecutionID int
+	// The format of the data to be exported. e.g. CSV or PDF
+	Format string
+}
+
+// DownloadScanData sends GET /export/cve/download/{execution_id}.
+//
+// Download the scan data export file
+func (c *Client) DownloadScanData(ctx context.Context, params DownloadScanDataParams) (any, *http.Response, error) {
+	path := "/export/cve/download/" + strconv.Itoa(params.ExecutionID)
+	query := url.Values{}
+	if params.Format != "" {
+		query.Set("format", params.Format)
+	}
+	var out any
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetScanDataExportExecutionParams holds the parameters of GetScanDataExportExecution.
+type GetScanDataExportExecutionParams struct {
+	// Execution ID
+	ExecutionID int
+}
+
+// GetScanDataExportExecution sends GET /export/cve/execution/{execution_id}.
+//
+// Get the specific scan data export execution
+func (c *Client) GetScanDataExportExecution(ctx context.Context, params GetScanDataExportExecutionParams) (*ScanDataExportExecution, *http.Response, error) {
+	path := "/export/cve/execution/" + strconv.Itoa(params.ExecutionID)
+	query := url.Values{}
+	var out ScanDataExportExecution
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetScanDataExportExecutionList sends GET /export/cve/executions.
+//
+// Get a list of specific scan data export execution jobs for a specified user
+func (c *Client) GetScanDataExportExecutionList(ctx context.Context) (*ScanDataExportExecutionList, *http.Response, error) {
+	path := "/export/cve/executions"
+	query := url.Values{}
+	var out ScanDataExportExecutionList
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetHealth sends GET /health.
+//
+// Check the status of Harbor components
+func (c *Client) GetHealth(ctx context.Context) (*OverallHealthStatus, *http.Response, error) {
+	path := "/health"
+	query := url.Values{}
+	var out OverallHealthStatus
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetIconParams holds the parameters of GetIcon.
+type GetIconParams struct {
+	// The digest of the resource
+	Digest string
+}
+
+// GetIcon sends GET /icons/{digest}.
+//
+// Get artifact icon
+func (c *Client) GetIcon(ctx context.Context, params GetIconParams) (*Icon, *http.Response, error) {
+	path := "/icons/" + url.PathEscape(params.Digest)
+	query := url.Values{}
+	var out Icon
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetInternalconfig sends GET /internalconfig.
+//
+// Get internal configurations.
+func (c *Client) GetInternalconfig(ctx context.Context) (*InternalConfigurationsResponse, *http.Response, error) {
+	path := "/internalconfig"
+	query := url.Values{}
+	var out InternalConfigurationsResponse
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// StopRunningJobParams holds the parameters of StopRunningJob.
+type StopRunningJobParams struct {
+	// The id of the job.
+	JobID string
+}
+
+// StopRunningJob sends PUT /jobservice/jobs/{job_id}.
+//
+// Stop running job
+func (c *Client) StopRunningJob(ctx context.Context, params StopRunningJobParams) (*http.Response, error) {
+	path := "/jobservice/jobs/" + url.PathEscape(params.JobID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, nil, nil)
+}
+
+// ActionGetJobLogParams holds the parameters of ActionGetJobLog.
+type ActionGetJobLogParams struct {
+	// The id of the job.
+	JobID string
+}
+
+// ActionGetJobLog sends GET /jobservice/jobs/{job_id}/log.
+//
+// Get job log by job id
+func (c *Client) ActionGetJobLog(ctx context.Context, params ActionGetJobLogParams) (*string, *http.Response, error) {
+	path := "/jobservice/jobs/" + url.PathEscape(params.JobID) + "/log"
+	query := url.Values{}
+	var out string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetWorkerPools sends GET /jobservice/pools.
+//
+// Get worker pools
+func (c *Client) GetWorkerPools(ctx context.Context) ([]WorkerPool, *http.Response, error) {
+	path := "/jobservice/pools"
+	query := url.Values{}
+	var out []WorkerPool
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetWorkersParams holds the parameters of GetWorkers.
+type GetWorkersParams struct {
+	// The name of the pool. 'all' stands for all pools
+	PoolID string
+}
+
+// GetWorkers sends GET /jobservice/pools/{pool_id}/workers.
+//
+// Get workers
+func (c *Client) GetWorkers(ctx context.Context, params GetWorkersParams) ([]Worker, *http.Response, error) {
+	path := "/jobservice/pools/" + url.PathEscape(params.PoolID) + "/workers"
+	query := url.Values{}
+	var out []Worker
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// ListJobQueues sends GET /jobservice/queues.
+//
+// list job queues
+func (c *Client) ListJobQueues(ctx context.Context) ([]JobQueue, *http.Response, error) {
+	path := "/jobservice/queues"
+	query := url.Values{}
+	var out []JobQueue
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// ActionPendingJobsParams holds the parameters of ActionPendingJobs.
+type ActionPendingJobsParams struct {
+	// The type of the job. 'all' stands for all job types
+	JobType string
+	Body    ActionRequest
+}
+
+// ActionPendingJobs sends PUT /jobservice/queues/{job_type}.
+//
+// stop and clean, pause, resume pending jobs in the queue
+func (c *Client) ActionPendingJobs(ctx context.Context, params ActionPendingJobsParams) (*http.Response, error) {
+	path := "/jobservice/queues/" + url.PathEscape(params.JobType)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// ListLabelsParams holds the parameters of ListLabels.
+type ListLabelsParams struct {
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// The label name.
+	Name string
+	// The label scope. Valid values are g and p. g for global labels and p for project labels.
+	Scope string
+	// Relevant project ID, required when scope is p.
+	ProjectID int
+}
+
+// ListLabels sends GET /labels.
+//
+// List labels according to the query strings.
+func (c *Client) ListLabels(ctx context.Context, params ListLabelsParams) ([]Label, *http.Response, error) {
+	path := "/labels"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Name != "" {
+		query.Set("name", params.Name)
+	}
+	if params.Scope != "" {
+		query.Set("scope", params.Scope)
+	}
+	if params.ProjectID != 0 {
+		query.Set("project_id", strconv.Itoa(params.ProjectID))
+	}
+	var out []Label
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreateLabelParams holds the parameters of CreateLabel.
+type CreateLabelParams struct {
+	// The json object of label.
+	Body Label
+}
+
+// CreateLabel sends POST /labels.
+//
+// Post creates a label
+func (c *Client) CreateLabel(ctx context.Context, params CreateLabelParams) (*http.Response, error) {
+	path := "/labels"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetLabelByIDParams holds the parameters of GetLabelByID.
+type GetLabelByIDParams struct {
+	// Label ID
+	LabelID int
+}
+
+// GetLabelByID sends GET /labels/{label_id}.
+//
+// Get the label specified by ID.
+func (c *Client) GetLabelByID(ctx context.Context, params GetLabelByIDParams) (*Label, *http.Response, error) {
+	path := "/labels/" + strconv.Itoa(params.LabelID)
+	query := url.Values{}
+	var out Label
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateLabelParams holds the parameters of UpdateLabel.
+type UpdateLabelParams struct {
+	// Label ID
+	LabelID int
+	// The updated label json object.
+	Body Label
+}
+
+// UpdateLabel sends PUT /labels/{label_id}.
+//
+// Update the label properties.
+func (c *Client) UpdateLabel(ctx context.Context, params UpdateLabelParams) (*http.Response, error) {
+	path := "/labels/" + strconv.Itoa(params.LabelID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// DeleteLabelParams holds the parameters of DeleteLabel.
+type DeleteLabelParams struct {
+	// Label ID
+	LabelID int
+}
+
+// DeleteLabel sends DELETE /labels/{label_id}.
+//
+// Delete the label specified by ID.
+func (c *Client) DeleteLabel(ctx context.Context, params DeleteLabelParams) (*http.Response, error) {
+	path := "/labels/" + strconv.Itoa(params.LabelID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// SearchLDAPGroupParams holds the parameters of SearchLDAPGroup.
+type SearchLDAPGroupParams struct {
+	// Ldap group name
+	Groupname string
+	// The LDAP group DN
+	Groupdn string
+}
+
+// SearchLDAPGroup sends GET /ldap/groups/search.
+//
+// Search available ldap groups.
+func (c *Client) SearchLDAPGroup(ctx context.Context, params SearchLDAPGroupParams) ([]UserGroup, *http.Response, error) {
+	path := "/ldap/groups/search"
+	query := url.Values{}
+	if params.Groupname != "" {
+		query.Set("groupname", params.Groupname)
+	}
+	if params.Groupdn != "" {
+		query.Set("groupdn", params.Groupdn)
+	}
+	var out []UserGroup
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// PingLDAPParams holds the parameters of PingLDAP.
+type PingLDAPParams struct {
+	// ldap configuration. support input ldap service configuration. If it is a empty request, will load current configuration from the system.
+	Body *LdapConf
+}
+
+// PingLDAP sends POST /ldap/ping.
+//
+// Ping available ldap service.
+func (c *Client) PingLDAP(ctx context.Context, params PingLDAPParams) (*LdapPingResult, *http.Response, error) {
+	path := "/ldap/ping"
+	query := url.Values{}
+	var in any
+	if params.Body != nil {
+		in = params.Body
+	}
+	var out LdapPingResult
+	resp, err := c.do(ctx, http.MethodPost, path, query, in, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// ImportLDAPUserParams holds the parameters of ImportLDAPUser.
+type ImportLDAPUserParams struct {
+	// The uid listed for importing. This list will check users validity of ldap service based on configuration from the system.
+	Body LdapImportUsers
+}
+
+// ImportLDAPUser sends POST /ldap/users/import.
+//
+// Import selected available ldap users.
+func (c *Client) ImportLDAPUser(ctx context.Context, params ImportLDAPUserParams) (*http.Response, error) {
+	path := "/ldap/users/import"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// SearchLDAPUserParams holds the parameters of SearchLDAPUser.
+type SearchLDAPUserParams struct {
+	// Registered user ID
+	Username string
+}
+
+// SearchLDAPUser sends GET /ldap/users/search.
+//
+// Search available ldap users.
+func (c *Client) SearchLDAPUser(ctx context.Context, params SearchLDAPUserParams) ([]LdapUser, *http.Response, error) {
+	path := "/ldap/users/search"
+	query := url.Values{}
+	if params.Username != "" {
+		query.Set("username", params.Username)
+	}
+	var out []LdapUser
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// ListInstancesParams holds the parameters of ListInstances.
+type ListInstancesParams struct {
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+}
+
+// ListInstances sends GET /p2p/preheat/instances.
+//
+// List P2P provider instances
+func (c *Client) ListInstances(ctx context.Context, params ListInstancesParams) ([]Instance, *http.Response, error) {
+	path := "/p2p/preheat/instances"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	var out []Instance
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreateInstanceParams holds the parameters of CreateInstance.
+type CreateInstanceParams struct {
+	// The JSON object of instance.
+	Body Instance
+}
+
+// CreateInstance sends POST /p2p/preheat/instances.
+//
+// Create p2p provider instances
+func (c *Client) CreateInstance(ctx context.Context, params CreateInstanceParams) (*http.Response, error) {
+	path := "/p2p/preheat/instances"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// PingInstancesParams holds the parameters of PingInstances.
+type PingInstancesParams struct {
+	// The JSON object of instance.
+	Body Instance
+}
+
+// PingInstances sends POST /p2p/preheat/instances/ping.
+//
+// Ping status of a instance.
+func (c *Client) PingInstances(ctx context.Context, params PingInstancesParams) (*http.Response, error) {
+	path := "/p2p/preheat/instances/ping"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetInstanceParams holds the parameters of GetInstance.
+type GetInstanceParams struct {
+	// Instance Name
+	PreheatInstanceName string
+}
+
+// GetInstance sends GET /p2p/preheat/instances/{preheat_instance_name}.
+//
+// Get a P2P provider instance
+func (c *Client) GetInstance(ctx context.Context, params GetInstanceParams) (*Instance, *http.Response, error) {
+	path := "/p2p/preheat/instances/" + url.PathEscape(params.PreheatInstanceName)
+	query := url.Values{}
+	var out Instance
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateInstanceParams holds the parameters of UpdateInstance.
+type UpdateInstanceParams struct {
+	// Instance Name
+	PreheatInstanceName string
+	// The instance to update
+	Body Instance
+}
+
+// UpdateInstance sends PUT /p2p/preheat/instances/{preheat_instance_name}.
+//
+// Update the specified P2P provider instance
+func (c *Client) UpdateInstance(ctx context.Context, params UpdateInstanceParams) (*http.Response, error) {
+	path := "/p2p/preheat/instances/" + url.PathEscape(params.PreheatInstanceName)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// DeleteInstanceParams holds the parameters of DeleteInstance.
+type DeleteInstanceParams struct {
+	// Instance Name
+	PreheatInstanceName string
+}
+
+// DeleteInstance sends DELETE /p2p/preheat/instances/{preheat_instance_name}.
+//
+// Delete the specified P2P provider instance
+func (c *Client) DeleteInstance(ctx context.Context, params DeleteInstanceParams) (*http.Response, error) {
+	path := "/p2p/preheat/instances/" + url.PathEscape(params.PreheatInstanceName)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// ListProviders sends GET /p2p/preheat/providers.
+//
+// List P2P providers
+func (c *Client) ListProviders(ctx context.Context) ([]Metadata, *http.Response, error) {
+	path := "/p2p/preheat/providers"
+	query := url.Values{}
+	var out []Metadata
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetPermissions sends GET /permissions.
+//
+// Get system or project level permissions info.
+func (c *Client) GetPermissions(ctx context.Context) (*Permissions, *http.Response, error) {
+	path := "/permissions"
+	query := url.Values{}
+	var out Permissions
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetPing sends GET /ping.
+//
+// Ping Harbor to check if the API server is alive.
+func (c *Client) GetPing(ctx context.Context) (*string, *http.Response, error) {
+	path := "/ping"
+	query := url.Values{}
+	var out string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// ListProjectsParams holds the parameters of ListProjects.
+type ListProjectsParams struct {
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The name of project.
+	Name string
+	// The project is public or private.
+	Public *bool
+	// The name of project owner.
+	Owner string
+	// Bool value indicating whether return detailed information of the project
+	WithDetail *bool
+}
+
+// ListProjects sends GET /projects.
+//
+// List projects
+func (c *Client) ListProjects(ctx context.Context, params ListProjectsParams) ([]Project, *http.Response, error) {
+	path := "/projects"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Name != "" {
+		query.Set("name", params.Name)
+	}
+	if params.Public != nil {
+		query.Set("public", strconv.FormatBool(*params.Public))
+	}
+	if params.Owner != "" {
+		query.Set("owner", params.Owner)
+	}
+	if params.WithDetail != nil {
+		query.Set("with_detail", strconv.FormatBool(*params.WithDetail))
+	}
+	var out []Project
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// HeadProjectParams holds the parameters of HeadProject.
+type HeadProjectParams struct {
+	// Project name for checking exists.
+	ProjectName string
+}
+
+// HeadProject sends HEAD /projects.
+//
+// Check if the project name user provided already exists.
+func (c *Client) HeadProject(ctx context.Context, params HeadProjectParams) (*http.Response, error) {
+	path := "/projects"
+	query := url.Values{}
+	query.Set("project_name", params.ProjectName)
+	return c.do(ctx, http.MethodHead, path, query, nil, nil)
+}
+
+// CreateProjectParams holds the parameters of CreateProject.
+type CreateProjectParams struct {
+	// New created project.
+	Body ProjectReq
+}
+
+// CreateProject sends POST /projects.
+//
+// Create a new project.
+func (c *Client) CreateProject(ctx context.Context, params CreateProjectParams) (*http.Response, error) {
+	path := "/projects"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetProjectParams holds the parameters of GetProject.
+type GetProjectParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+}
+
+// GetProject sends GET /projects/{project_name_or_id}.
+//
+// Return specific project detail information
+func (c *Client) GetProject(ctx context.Context, params GetProjectParams) (*Project, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID)
+	query := url.Values{}
+	var out Project
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateProjectParams holds the parameters of UpdateProject.
+type UpdateProjectParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// Updates of project.
+	Body ProjectReq
+}
+
+// UpdateProject sends PUT /projects/{project_name_or_id}.
+//
+// Update properties for a selected project.
+func (c *Client) UpdateProject(ctx context.Context, params UpdateProjectParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// DeleteProjectParams holds the parameters of DeleteProject.
+type DeleteProjectParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+}
+
+// DeleteProject sends DELETE /projects/{project_name_or_id}.
+//
+// Delete project by projectID
+func (c *Client) DeleteProject(ctx context.Context, params DeleteProjectParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// GetProjectDeletableParams holds the parameters of GetProjectDeletable.
+type GetProjectDeletableParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+}
+
+// GetProjectDeletable sends GET /projects/{project_name_or_id}/_deletable.
+//
+// Get the deletable status of the project
+func (c *Client) GetProjectDeletable(ctx context.Context, params GetProjectDeletableParams) (*ProjectDeletable, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/_deletable"
+	query := url.Values{}
+	var out ProjectDeletable
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// ListArtifactsOfProjectParams holds the parameters of ListArtifactsOfProject.
+type ListArtifactsOfProjectParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Specify whether the tags are included inside the returning artifacts
+	WithTag *bool
+	// Specify whether the labels are included inside the returning artifacts
+	WithLabel *bool
+	// Specify whether the scan overview is included inside the returning artifacts
+	WithScanOverview *bool
+	// Specify whether the SBOM overview is included in returning artifacts, when this option is true, the SBOM overview will be included in the response
+	WithSBOMOverview *bool
+	// Specify whether the immutable status is included inside the tags of the returning artifacts. Only works when setting "with_immutable_status=true"
+	WithImmutableStatus *bool
+	// Specify whether the accessories are included of the returning artifacts. Only works when setting "with_accessory=true"
+	WithAccessory *bool
+	// Specify whether only the latest pushed artifact of each repository is included inside the returning artifacts. Only works when either artifact_type or media_type is included in the query.
+	LatestInRepository *bool
+}
+
+// ListArtifactsOfProject sends GET /projects/{project_name_or_id}/artifacts.
+//
+// List artifacts
+func (c *Client) ListArtifactsOfProject(ctx context.Context, params ListArtifactsOfProjectParams) ([]Artifact, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/artifacts"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.WithTag != nil {
+		query.Set("with_tag", strconv.FormatBool(*params.WithTag))
+	}
+	if params.WithLabel != nil {
+		query.Set("with_label", strconv.FormatBool(*params.WithLabel))
+	}
+	if params.WithScanOverview != nil {
+		query.Set("with_scan_overview", strconv.FormatBool(*params.WithScanOverview))
+	}
+	if params.WithSBOMOverview != nil {
+		query.Set("with_sbom_overview", strconv.FormatBool(*params.WithSBOMOverview))
+	}
+	if params.WithImmutableStatus != nil {
+		query.Set("with_immutable_status", strconv.FormatBool(*params.WithImmutableStatus))
+	}
+	if params.WithAccessory != nil {
+		query.Set("with_accessory", strconv.FormatBool(*params.WithAccessory))
+	}
+	if params.LatestInRepository != nil {
+		query.Set("latest_in_repository", strconv.FormatBool(*params.LatestInRepository))
+	}
+	var out []Artifact
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// ListImmuRulesParams holds the parameters of ListImmuRules.
+type ListImmuRulesParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+}
+
+// ListImmuRules sends GET /projects/{project_name_or_id}/immutabletagrules.
+//
+// List all immutable tag rules of current project
+func (c *Client) ListImmuRules(ctx context.Context, params ListImmuRulesParams) ([]ImmutableRule, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/immutabletagrules"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	var out []ImmutableRule
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreateImmuRuleParams holds the parameters of CreateImmuRule.
+type CreateImmuRuleParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	Body            ImmutableRule
+}
+
+// CreateImmuRule sends POST /projects/{project_name_or_id}/immutabletagrules.
+//
+// Add an immutable tag rule to current project
+func (c *Client) CreateImmuRule(ctx context.Context, params CreateImmuRuleParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/immutabletagrules"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// UpdateImmuRuleParams holds the parameters of UpdateImmuRule.
+type UpdateImmuRuleParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The ID of the immutable rule
+	ImmutableRuleID int
+	Body            ImmutableRule
+}
+
+// UpdateImmuRule sends PUT /projects/{project_name_or_id}/immutabletagrules/{immutable_rule_id}.
+//
+// Update the immutable tag rule or enable or disable the rule
+func (c *Client) UpdateImmuRule(ctx context.Context, params UpdateImmuRuleParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/immutabletagrules/" + strconv.Itoa(params.ImmutableRuleID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// DeleteImmuRuleParams holds the parameters of DeleteImmuRule.
+type DeleteImmuRuleParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The ID of the immutable rule
+	ImmutableRuleID int
+}
+
+// DeleteImmuRule sends DELETE /projects/{project_name_or_id}/immutabletagrules/{immutable_rule_id}.
+//
+// Delete the immutable tag rule.
+func (c *Client) DeleteImmuRule(ctx context.Context, params DeleteImmuRuleParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/immutabletagrules/" + strconv.Itoa(params.ImmutableRuleID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// ListProjectMembersParams holds the parameters of ListProjectMembers.
+type ListProjectMembersParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// The entity name to search.
+	Entityname string
+}
+
+// ListProjectMembers sends GET /projects/{project_name_or_id}/members.
+//
+// Get all project member information
+func (c *Client) ListProjectMembers(ctx context.Context, params ListProjectMembersParams) ([]ProjectMemberEntity, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/members"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Entityname != "" {
+		query.Set("entityname", params.Entityname)
+	}
+	var out []ProjectMemberEntity
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreateProjectMemberParams holds the parameters of CreateProjectMember.
+type CreateProjectMemberParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	Body            *ProjectMember
+}
+
+// CreateProjectMember sends POST /projects/{project_name_or_id}/members.
+//
+// Create project member
+func (c *Client) CreateProjectMember(ctx context.Context, params CreateProjectMemberParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/members"
+	query := url.Values{}
+	var in any
+	if params.Body != nil {
+		in = params.Body
+	}
+	return c.do(ctx, http.MethodPost, path, query, in, nil)
+}
+
+// GetProjectMemberParams holds the parameters of GetProjectMember.
+type GetProjectMemberParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The member ID
+	Mid int
+}
+
+// GetProjectMember sends GET /projects/{project_name_or_id}/members/{mid}.
+//
+// Get the project member information
+func (c *Client) GetProjectMember(ctx context.Context, params GetProjectMemberParams) (*ProjectMemberEntity, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/members/" + strconv.Itoa(params.Mid)
+	query := url.Values{}
+	var out ProjectMemberEntity
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateProjectMemberParams holds the parameters of UpdateProjectMember.
+type UpdateProjectMemberParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// Member ID.
+	Mid  int
+	Body *RoleRequest
+}
+
+// UpdateProjectMember sends PUT /projects/{project_name_or_id}/members/{mid}.
+//
+// Update project member
+func (c *Client) UpdateProjectMember(ctx context.Context, params UpdateProjectMemberParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/members/" + strconv.Itoa(params.Mid)
+	query := url.Values{}
+	var in any
+	if params.Body != nil {
+		in = params.Body
+	}
+	return c.do(ctx, http.MethodPut, path, query, in, nil)
+}
+
+// DeleteProjectMemberParams holds the parameters of DeleteProjectMember.
+type DeleteProjectMemberParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// Member ID.
+	Mid int
+}
+
+// DeleteProjectMember sends DELETE /projects/{project_name_or_id}/members/{mid}.
+//
+// Delete project member
+func (c *Client) DeleteProjectMember(ctx context.Context, params DeleteProjectMemberParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/members/" + strconv.Itoa(params.Mid)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// ListProjectMetadatasParams holds the parameters of ListProjectMetadatas.
+type ListProjectMetadatasParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+}
+
+// ListProjectMetadatas sends GET /projects/{project_name_or_id}/metadatas/.
+//
+// Get the metadata of the specific project
+func (c *Client) ListProjectMetadatas(ctx context.Context, params ListProjectMetadatasParams) (map[string]string, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/metadatas/"
+	query := url.Values{}
+	var out map[string]string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// AddProjectMetadatasParams holds the parameters of AddProjectMetadatas.
+type AddProjectMetadatasParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	Body            map[string]string
+}
+
+// AddProjectMetadatas sends POST /projects/{project_name_or_id}/metadatas/.
+//
+// Add metadata for the specific project
+func (c *Client) AddProjectMetadatas(ctx context.Context, params AddProjectMetadatasParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/metadatas/"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetProjectMetadataParams holds the parameters of GetProjectMetadata.
+type GetProjectMetadataParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The name of metadata.
+	MetaName string
+}
+
+// GetProjectMetadata sends GET /projects/{project_name_or_id}/metadatas/{meta_name}.
+//
+// Get the specific metadata of the specific project
+func (c *Client) GetProjectMetadata(ctx context.Context, params GetProjectMetadataParams) (map[string]string, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/metadatas/" + url.PathEscape(params.MetaName)
+	query := url.Values{}
+	var out map[string]string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// UpdateProjectMetadataParams holds the parameters of UpdateProjectMetadata.
+type UpdateProjectMetadataParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The name of metadata.
+	MetaName string
+	Body     map[string]string
+}
+
+// UpdateProjectMetadata sends PUT /projects/{project_name_or_id}/metadatas/{meta_name}.
+//
+// Update the specific metadata for the specific project
+func (c *Client) UpdateProjectMetadata(ctx context.Context, params UpdateProjectMetadataParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/metadatas/" + url.PathEscape(params.MetaName)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// DeleteProjectMetadataParams holds the parameters of DeleteProjectMetadata.
+type DeleteProjectMetadataParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The name of metadata.
+	MetaName string
+}
+
+// DeleteProjectMetadata sends DELETE /projects/{project_name_or_id}/metadatas/{meta_name}.
+//
+// Delete the specific metadata for the specific project
+func (c *Client) DeleteProjectMetadata(ctx context.Context, params DeleteProjectMetadataParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/metadatas/" + url.PathEscape(params.MetaName)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// GetScannerOfProjectParams holds the parameters of GetScannerOfProject.
+type GetScannerOfProjectParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+}
+
+// GetScannerOfProject sends GET /projects/{project_name_or_id}/scanner.
+//
+// Get project level scanner
+func (c *Client) GetScannerOfProject(ctx context.Context, params GetScannerOfProjectParams) (*ScannerRegistration, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/scanner"
+	query := url.Values{}
+	var out ScannerRegistration
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// SetScannerOfProjectParams holds the parameters of SetScannerOfProject.
+type SetScannerOfProjectParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	Body            ProjectScanner
+}
+
+// SetScannerOfProject sends PUT /projects/{project_name_or_id}/scanner.
+//
+// Configure scanner for the specified project
+func (c *Client) SetScannerOfProject(ctx context.Context, params SetScannerOfProjectParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/scanner"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// ListScannerCandidatesOfProjectParams holds the parameters of ListScannerCandidatesOfProject.
+type ListScannerCandidatesOfProjectParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// ListScannerCandidatesOfProject sends GET /projects/{project_name_or_id}/scanner/candidates.
+//
+// Get scanner registration candidates for configurating project level scanner
+func (c *Client) ListScannerCandidatesOfProject(ctx context.Context, params ListScannerCandidatesOfProjectParams) ([]ScannerRegistration, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/scanner/candidates"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []ScannerRegistration
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetProjectSummaryParams holds the parameters of GetProjectSummary.
+type GetProjectSummaryParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+}
+
+// GetProjectSummary sends GET /projects/{project_name_or_id}/summary.
+//
+// Get summary of the project.
+func (c *Client) GetProjectSummary(ctx context.Context, params GetProjectSummaryParams) (*ProjectSummary, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/summary"
+	query := url.Values{}
+	var out ProjectSummary
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetSupportedEventTypesParams holds the parameters of GetSupportedEventTypes.
+type GetSupportedEventTypesParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+}
+
+// GetSupportedEventTypes sends GET /projects/{project_name_or_id}/webhook/events.
+//
+// Get supported event types and notify types.
+func (c *Client) GetSupportedEventTypes(ctx context.Context, params GetSupportedEventTypesParams) (*SupportedWebhookEventTypes, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/webhook/events"
+	query := url.Values{}
+	var out SupportedWebhookEventTypes
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// ListWebhookJobsParams holds the parameters of ListWebhookJobs.
+type ListWebhookJobsParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// The policy ID.
+	PolicyID int
+	// The status of webhook job.
+	Status []string
+}
+
+// ListWebhookJobs sends GET /projects/{project_name_or_id}/webhook/jobs.
+//
+// # List project webhook jobs
+//
+// Deprecated: Harbor marks this operation as deprecated.
+func (c *Client) ListWebhookJobs(ctx context.Context, params ListWebhookJobsParams) ([]WebhookJob, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/webhook/jobs"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	query.Set("policy_id", strconv.Itoa(params.PolicyID))
+	if len(params.Status) > 0 {
+		query.Set("status", strings.Join(params.Status, ","))
+	}
+	var out []WebhookJob
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// LastTriggerParams holds the parameters of LastTrigger.
+type LastTriggerParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+}
+
+// LastTrigger sends GET /projects/{project_name_or_id}/webhook/lasttrigger.
+//
+// # Get project webhook policy last trigger info
+//
+// Deprecated: Harbor marks this operation as deprecated.
+func (c *Client) LastTrigger(ctx context.Context, params LastTriggerParams) ([]WebhookLastTrigger, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/webhook/lasttrigger"
+	query := url.Values{}
+	var out []WebhookLastTrigger
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// ListWebhookPoliciesOfProjectParams holds the parameters of ListWebhookPoliciesOfProject.
+type ListWebhookPoliciesOfProjectParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// ListWebhookPoliciesOfProject sends GET /projects/{project_name_or_id}/webhook/policies.
+//
+// List project webhook policies.
+func (c *Client) ListWebhookPoliciesOfProject(ctx context.Context, params ListWebhookPoliciesOfProjectParams) ([]WebhookPolicy, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/webhook/policies"
+	query := url.Values{}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []WebhookPolicy
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreateWebhookPolicyOfProjectParams holds the parameters of CreateWebhookPolicyOfProject.
+type CreateWebhookPolicyOfProjectParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// Properties "targets" and "event_types" needed.
+	Body WebhookPolicy
+}
+
+// CreateWebhookPolicyOfProject sends POST /projects/{project_name_or_id}/webhook/policies.
+//
+// Create project webhook policy.
+func (c *Client) CreateWebhookPolicyOfProject(ctx context.Context, params CreateWebhookPolicyOfProjectParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/webhook/policies"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetWebhookPolicyOfProjectParams holds the parameters of GetWebhookPolicyOfProject.
+type GetWebhookPolicyOfProjectParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The ID of the webhook policy
+	WebhookPolicyID int
+}
+
+// GetWebhookPolicyOfProject sends GET /projects/{project_name_or_id}/webhook/policies/{webhook_policy_id}.
+//
+// Get project webhook policy
+func (c *Client) GetWebhookPolicyOfProject(ctx context.Context, params GetWebhookPolicyOfProjectParams) (*WebhookPolicy, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/webhook/policies/" + strconv.Itoa(params.WebhookPolicyID)
+	query := url.Values{}
+	var out WebhookPolicy
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateWebhookPolicyOfProjectParams holds the parameters of UpdateWebhookPolicyOfProject.
+type UpdateWebhookPolicyOfProjectParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The ID of the webhook policy
+	WebhookPolicyID int
+	// All properties needed except "id", "project_id", "creation_time", "update_time".
+	Body WebhookPolicy
+}
+
+// UpdateWebhookPolicyOfProject sends PUT /projects/{project_name_or_id}/webhook/policies/{webhook_policy_id}.
+//
+// Update webhook policy of a project.
+func (c *Client) UpdateWebhookPolicyOfProject(ctx context.Context, params UpdateWebhookPolicyOfProjectParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/webhook/policies/" + strconv.Itoa(params.WebhookPolicyID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// DeleteWebhookPolicyOfProjectParams holds the parameters of DeleteWebhookPolicyOfProject.
+type DeleteWebhookPolicyOfProjectParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The ID of the webhook policy
+	WebhookPolicyID int
+}
+
+// DeleteWebhookPolicyOfProject sends DELETE /projects/{project_name_or_id}/webhook/policies/{webhook_policy_id}.
+//
+// Delete webhook policy of a project
+func (c *Client) DeleteWebhookPolicyOfProject(ctx context.Context, params DeleteWebhookPolicyOfProjectParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/webhook/policies/" + strconv.Itoa(params.WebhookPolicyID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// ListExecutionsOfWebhookPolicyParams holds the parameters of ListExecutionsOfWebhookPolicy.
+type ListExecutionsOfWebhookPolicyParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The ID of the webhook policy
+	WebhookPolicyID int
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+}
+
+// ListExecutionsOfWebhookPolicy sends GET /projects/{project_name_or_id}/webhook/policies/{webhook_policy_id}/executions.
+//
+// List executions for a specific webhook policy
+func (c *Client) ListExecutionsOfWebhookPolicy(ctx context.Context, params ListExecutionsOfWebhookPolicyParams) ([]Execution, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/webhook/policies/" + strconv.Itoa(params.WebhookPolicyID) + "/executions"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	var out []Execution
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// ListTasksOfWebhookExecutionParams holds the parameters of ListTasksOfWebhookExecution.
+type ListTasksOfWebhookExecutionParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The ID of the webhook policy
+	WebhookPolicyID int
+	// Execution ID
+	ExecutionID int
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+}
+
+// ListTasksOfWebhookExecution sends GET /projects/{project_name_or_id}/webhook/policies/{webhook_policy_id}/executions/{execution_id}/tasks.
+//
+// List tasks for a specific webhook execution
+func (c *Client) ListTasksOfWebhookExecution(ctx context.Context, params ListTasksOfWebhookExecutionParams) ([]Task, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/webhook/policies/" + strconv.Itoa(params.WebhookPolicyID) + "/executions/" + strconv.Itoa(params.ExecutionID) + "/tasks"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	var out []Task
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetLogsOfWebhookTaskParams holds the parameters of GetLogsOfWebhookTask.
+type GetLogsOfWebhookTaskParams struct {
+	// The name or id of the project
+	ProjectNameOrID string
+	// The ID of the webhook policy
+	WebhookPolicyID int
+	// Execution ID
+	ExecutionID int
+	// Task ID
+	TaskID int
+}
+
+// GetLogsOfWebhookTask sends GET /projects/{project_name_or_id}/webhook/policies/{webhook_policy_id}/executions/{execution_id}/tasks/{task_id}/log.
+//
+// Get logs for a specific webhook task
+func (c *Client) GetLogsOfWebhookTask(ctx context.Context, params GetLogsOfWebhookTaskParams) (*string, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectNameOrID) + "/webhook/policies/" + strconv.Itoa(params.WebhookPolicyID) + "/executions/" + strconv.Itoa(params.ExecutionID) + "/tasks/" + strconv.Itoa(params.TaskID) + "/log"
+	query := url.Values{}
+	var out string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetLogExtsParams holds the parameters of GetLogExts.
+type GetLogExtsParams struct {
+	// The name of the project
+	ProjectName string
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// GetLogExts sends GET /projects/{project_name}/auditlog-exts.
+//
+// Get recent logs of the projects
+func (c *Client) GetLogExts(ctx context.Context, params GetLogExtsParams) ([]AuditLogExt, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/auditlog-exts"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []AuditLogExt
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetLogsParams holds the parameters of GetLogs.
+type GetLogsParams struct {
+	// The name of the project
+	ProjectName string
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// GetLogs sends GET /projects/{project_name}/logs.
+//
+// Get recent logs of the projects (deprecated)
+func (c *Client) GetLogs(ctx context.Context, params GetLogsParams) ([]AuditLog, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/logs"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []AuditLog
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// ListPoliciesParams holds the parameters of ListPolicies.
+type ListPoliciesParams struct {
+	// The name of the project
+	ProjectName string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+}
+
+// ListPolicies sends GET /projects/{project_name}/preheat/policies.
+//
+// List preheat policies
+func (c *Client) ListPolicies(ctx context.Context, params ListPoliciesParams) ([]PreheatPolicy, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/preheat/policies"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	var out []PreheatPolicy
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreatePolicyParams holds the parameters of CreatePolicy.
+type CreatePolicyParams struct {
+	// The name of the project
+	ProjectName string
+	// The policy schema info
+	Body PreheatPolicy
+}
+
+// CreatePolicy sends POST /projects/{project_name}/preheat/policies.
+//
+// Create a preheat policy under a project
+func (c *Client) CreatePolicy(ctx context.Context, params CreatePolicyParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/preheat/policies"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetPolicyParams holds the parameters of GetPolicy.
+type GetPolicyParams struct {
+	// The name of the project
+	ProjectName string
+	// Preheat Policy Name
+	PreheatPolicyName string
+}
+
+// GetPolicy sends GET /projects/{project_name}/preheat/policies/{preheat_policy_name}.
+//
+// Get a preheat policy
+func (c *Client) GetPolicy(ctx context.Context, params GetPolicyParams) (*PreheatPolicy, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/preheat/policies/" + url.PathEscape(params.PreheatPolicyName)
+	query := url.Values{}
+	var out PreheatPolicy
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// ManualPreheatParams holds the parameters of ManualPreheat.
+type ManualPreheatParams struct {
+	// The name of the project
+	ProjectName string
+	// Preheat Policy Name
+	PreheatPolicyName string
+	// The policy schema info
+	Body PreheatPolicy
+}
+
+// ManualPreheat sends POST /projects/{project_name}/preheat/policies/{preheat_policy_name}.
+//
+// Manual preheat
+func (c *Client) ManualPreheat(ctx context.Context, params ManualPreheatParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/preheat/policies/" + url.PathEscape(params.PreheatPolicyName)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// UpdatePolicyParams holds the parameters of UpdatePolicy.
+type UpdatePolicyParams struct {
+	// The name of the project
+	ProjectName string
+	// Preheat Policy Name
+	PreheatPolicyName string
+	// The policy schema info
+	Body PreheatPolicy
+}
+
+// UpdatePolicy sends PUT /projects/{project_name}/preheat/policies/{preheat_policy_name}.
+//
+// Update preheat policy
+func (c *Client) UpdatePolicy(ctx context.Context, params UpdatePolicyParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/preheat/policies/" + url.PathEscape(params.PreheatPolicyName)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// DeletePolicyParams holds the parameters of DeletePolicy.
+type DeletePolicyParams struct {
+	// The name of the project
+	ProjectName string
+	// Preheat Policy Name
+	PreheatPolicyName string
+}
+
+// DeletePolicy sends DELETE /projects/{project_name}/preheat/policies/{preheat_policy_name}.
+//
+// Delete a preheat policy
+func (c *Client) DeletePolicy(ctx context.Context, params DeletePolicyParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/preheat/policies/" + url.PathEscape(params.PreheatPolicyName)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// ListExecutionsParams holds the parameters of ListExecutions.
+type ListExecutionsParams struct {
+	// The name of the project
+	ProjectName string
+	// Preheat Policy Name
+	PreheatPolicyName string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+}
+
+// ListExecutions sends GET /projects/{project_name}/preheat/policies/{preheat_policy_name}/executions.
+//
+// List executions for the given policy
+func (c *Client) ListExecutions(ctx context.Context, params ListExecutionsParams) ([]Execution, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/preheat/policies/" + url.PathEscape(params.PreheatPolicyName) + "/executions"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	var out []Execution
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetExecutionParams holds the parameters of GetExecution.
+type GetExecutionParams struct {
+	// The name of the project
+	ProjectName string
+	// Preheat Policy Name
+	PreheatPolicyName string
+	// Execution ID
+	ExecutionID int
+}
+
+// GetExecution sends GET /projects/{project_name}/preheat/policies/{preheat_policy_name}/executions/{execution_id}.
+//
+// Get a execution detail by id
+func (c *Client) GetExecution(ctx context.Context, params GetExecutionParams) (*Execution, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/preheat/policies/" + url.PathEscape(params.PreheatPolicyName) + "/executions/" + strconv.Itoa(params.ExecutionID)
+	query := url.Values{}
+	var out Execution
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// StopExecutionParams holds the parameters of StopExecution.
+type StopExecutionParams struct {
+	// The name of the project
+	ProjectName string
+	// Preheat Policy Name
+	PreheatPolicyName string
+	// Execution ID
+	ExecutionID int
+	// The data of execution
+	Body Execution
+}
+
+// StopExecution sends PATCH /projects/{project_name}/preheat/policies/{preheat_policy_name}/executions/{execution_id}.
+//
+// Stop a execution
+func (c *Client) StopExecution(ctx context.Context, params StopExecutionParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/preheat/policies/" + url.PathEscape(params.PreheatPolicyName) + "/executions/" + strconv.Itoa(params.ExecutionID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPatch, path, query, params.Body, nil)
+}
+
+// ListTasksParams holds the parameters of ListTasks.
+type ListTasksParams struct {
+	// The name of the project
+	ProjectName string
+	// Preheat Policy Name
+	PreheatPolicyName string
+	// Execution ID
+	ExecutionID int
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+}
+
+// ListTasks sends GET /projects/{project_name}/preheat/policies/{preheat_policy_name}/executions/{execution_id}/tasks.
+//
+// List all the related tasks for the given execution
+func (c *Client) ListTasks(ctx context.Context, params ListTasksParams) ([]Task, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/preheat/policies/" + url.PathEscape(params.PreheatPolicyName) + "/executions/" + strconv.Itoa(params.ExecutionID) + "/tasks"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	var out []Task
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetPreheatLogParams holds the parameters of GetPreheatLog.
+type GetPreheatLogParams struct {
+	// The name of the project
+	ProjectName string
+	// Preheat Policy Name
+	PreheatPolicyName string
+	// Execution ID
+	ExecutionID int
+	// Task ID
+	TaskID int
+}
+
+// GetPreheatLog sends GET /projects/{project_name}/preheat/policies/{preheat_policy_name}/executions/{execution_id}/tasks/{task_id}/logs.
+//
+// Get the log text stream of the specified task for the given execution
+func (c *Client) GetPreheatLog(ctx context.Context, params GetPreheatLogParams) (*string, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/preheat/policies/" + url.PathEscape(params.PreheatPolicyName) + "/executions/" + strconv.Itoa(params.ExecutionID) + "/tasks/" + strconv.Itoa(params.TaskID) + "/logs"
+	query := url.Values{}
+	var out string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// ListProvidersUnderProjectParams holds the parameters of ListProvidersUnderProject.
+type ListProvidersUnderProjectParams struct {
+	// The name of the project
+	ProjectName string
+}
+
+// ListProvidersUnderProject sends GET /projects/{project_name}/preheat/providers.
+//
+// Get all providers at project level
+func (c *Client) ListProvidersUnderProject(ctx context.Context, params ListProvidersUnderProjectParams) ([]ProviderUnderProject, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/preheat/providers"
+	query := url.Values{}
+	var out []ProviderUnderProject
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// ListRepositoriesParams holds the parameters of ListRepositories.
+type ListRepositoriesParams struct {
+	// The name of the project
+	ProjectName string
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// ListRepositories sends GET /projects/{project_name}/repositories.
+//
+// List repositories
+func (c *Client) ListRepositories(ctx context.Context, params ListRepositoriesParams) ([]Repository, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []Repository
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetRepositoryParams holds the parameters of GetRepository.
+type GetRepositoryParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+}
+
+// GetRepository sends GET /projects/{project_name}/repositories/{repository_name}.
+//
+// Get repository
+func (c *Client) GetRepository(ctx context.Context, params GetRepositoryParams) (*Repository, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName)
+	query := url.Values{}
+	var out Repository
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateRepositoryParams holds the parameters of UpdateRepository.
+type UpdateRepositoryParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The JSON object of repository.
+	Body Repository
+}
+
+// UpdateRepository sends PUT /projects/{project_name}/repositories/{repository_name}.
+//
+// Update repository
+func (c *Client) UpdateRepository(ctx context.Context, params UpdateRepositoryParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// DeleteRepositoryParams holds the parameters of DeleteRepository.
+type DeleteRepositoryParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+}
+
+// DeleteRepository sends DELETE /projects/{project_name}/repositories/{repository_name}.
+//
+// Delete repository
+func (c *Client) DeleteRepository(ctx context.Context, params DeleteRepositoryParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// ListArtifactsParams holds the parameters of ListArtifacts.
+type ListArtifactsParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Specify whether the tags are included inside the returning artifacts
+	WithTag *bool
+	// Specify whether the labels are included inside the returning artifacts
+	WithLabel *bool
+	// Specify whether the scan overview is included inside the returning artifacts
+	WithScanOverview *bool
+	// Specify whether the SBOM overview is included in returning artifacts, when this option is true, the SBOM overview will be included in the response
+	WithSBOMOverview *bool
+	// Specify whether the immutable status is included inside the tags of the returning artifacts. Only works when setting "with_immutable_status=true"
+	WithImmutableStatus *bool
+	// Specify whether the accessories are included of the returning artifacts. Only works when setting "with_accessory=true"
+	WithAccessory *bool
+}
+
+// ListArtifacts sends GET /projects/{project_name}/repositories/{repository_name}/artifacts.
+//
+// List artifacts
+func (c *Client) ListArtifacts(ctx context.Context, params ListArtifactsParams) ([]Artifact, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.WithTag != nil {
+		query.Set("with_tag", strconv.FormatBool(*params.WithTag))
+	}
+	if params.WithLabel != nil {
+		query.Set("with_label", strconv.FormatBool(*params.WithLabel))
+	}
+	if params.WithScanOverview != nil {
+		query.Set("with_scan_overview", strconv.FormatBool(*params.WithScanOverview))
+	}
+	if params.WithSBOMOverview != nil {
+		query.Set("with_sbom_overview", strconv.FormatBool(*params.WithSBOMOverview))
+	}
+	if params.WithImmutableStatus != nil {
+		query.Set("with_immutable_status", strconv.FormatBool(*params.WithImmutableStatus))
+	}
+	if params.WithAccessory != nil {
+		query.Set("with_accessory", strconv.FormatBool(*params.WithAccessory))
+	}
+	var out []Artifact
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CopyArtifactParams holds the parameters of CopyArtifact.
+type CopyArtifactParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The artifact from which the new artifact is copied from, the format should be "project/repository:tag" or "project/repository@digest".
+	From string
+}
+
+// CopyArtifact sends POST /projects/{project_name}/repositories/{repository_name}/artifacts.
+//
+// Copy artifact
+func (c *Client) CopyArtifact(ctx context.Context, params CopyArtifactParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts"
+	query := url.Values{}
+	query.Set("from", params.From)
+	return c.do(ctx, http.MethodPost, path, query, nil, nil)
+}
+
+// GetArtifactParams holds the parameters of GetArtifact.
+type GetArtifactParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The reference of the artifact, can be digest or tag
+	Reference string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Specify whether the tags are inclued inside the returning artifacts
+	WithTag *bool
+	// Specify whether the labels are inclued inside the returning artifacts
+	WithLabel *bool
+	// Specify whether the scan overview is inclued inside the returning artifacts
+	WithScanOverview *bool
+	// Specify whether the SBOM overview is included in returning artifact, when this option is true, the SBOM overview will be included in the response
+	WithSBOMOverview *bool
+	// Specify whether the accessories are included of the returning artifacts.
+	WithAccessory *bool
+	// Specify whether the signature is inclued inside the returning artifacts
+	WithSignature *bool
+	// Specify whether the immutable status is inclued inside the tags of the returning artifacts.
+	WithImmutableStatus *bool
+}
+
+// GetArtifact sends GET /projects/{project_name}/repositories/{repository_name}/artifacts/{reference}.
+//
+// Get the specific artifact
+func (c *Client) GetArtifact(ctx context.Context, params GetArtifactParams) (*Artifact, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts/" + url.PathEscape(params.Reference)
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.WithTag != nil {
+		query.Set("with_tag", strconv.FormatBool(*params.WithTag))
+	}
+	if params.WithLabel != nil {
+		query.Set("with_label", strconv.FormatBool(*params.WithLabel))
+	}
+	if params.WithScanOverview != nil {
+		query.Set("with_scan_overview", strconv.FormatBool(*params.WithScanOverview))
+	}
+	if params.WithSBOMOverview != nil {
+		query.Set("with_sbom_overview", strconv.FormatBool(*params.WithSBOMOverview))
+	}
+	if params.WithAccessory != nil {
+		query.Set("with_accessory", strconv.FormatBool(*params.WithAccessory))
+	}
+	if params.WithSignature != nil {
+		query.Set("with_signature", strconv.FormatBool(*params.WithSignature))
+	}
+	if params.WithImmutableStatus != nil {
+		query.Set("with_immutable_status", strconv.FormatBool(*params.WithImmutableStatus))
+	}
+	var out Artifact
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// DeleteArtifactParams holds the parameters of DeleteArtifact.
+type DeleteArtifactParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The reference of the artifact, can be digest or tag
+	Reference string
+}
+
+// DeleteArtifact sends DELETE /projects/{project_name}/repositories/{repository_name}/artifacts/{reference}.
+//
+// Delete the specific artifact
+func (c *Client) DeleteArtifact(ctx context.Context, params DeleteArtifactParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts/" + url.PathEscape(params.Reference)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// ListAccessoriesParams holds the parameters of ListAccessories.
+type ListAccessoriesParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The reference of the artifact, can be digest or tag
+	Reference string
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// ListAccessories sends GET /projects/{project_name}/repositories/{repository_name}/artifacts/{reference}/accessories.
+//
+// List accessories
+func (c *Client) ListAccessories(ctx context.Context, params ListAccessoriesParams) ([]Accessory, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts/" + url.PathEscape(params.Reference) + "/accessories"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []Accessory
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetVulnerabilitiesAdditionParams holds the parameters of GetVulnerabilitiesAddition.
+type GetVulnerabilitiesAdditionParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The reference of the artifact, can be digest or tag
+	Reference string
+}
+
+// GetVulnerabilitiesAddition sends GET /projects/{project_name}/repositories/{repository_name}/artifacts/{reference}/additions/vulnerabilities.
+//
+// Get the vulnerabilities addition of the specific artifact
+func (c *Client) GetVulnerabilitiesAddition(ctx context.Context, params GetVulnerabilitiesAdditionParams) (*string, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts/" + url.PathEscape(params.Reference) + "/additions/vulnerabilities"
+	query := url.Values{}
+	var out string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetAdditionParams holds the parameters of GetAddition.
+type GetAdditionParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The reference of the artifact, can be digest or tag
+	Reference string
+	// The type of addition.
+	Addition string
+}
+
+// GetAddition sends GET /projects/{project_name}/repositories/{repository_name}/artifacts/{reference}/additions/{addition}.
+//
+// Get the addition of the specific artifact
+func (c *Client) GetAddition(ctx context.Context, params GetAdditionParams) (*string, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts/" + url.PathEscape(params.Reference) + "/additions/" + url.PathEscape(params.Addition)
+	query := url.Values{}
+	var out string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// AddLabelParams holds the parameters of AddLabel.
+type AddLabelParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The reference of the artifact, can be digest or tag
+	Reference string
+	// The label that added to the artifact. Only the ID property is needed.
+	Body Label
+}
+
+// AddLabel sends POST /projects/{project_name}/repositories/{repository_name}/artifacts/{reference}/labels.
+//
+// Add label to artifact
+func (c *Client) AddLabel(ctx context.Context, params AddLabelParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts/" + url.PathEscape(params.Reference) + "/labels"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// RemoveLabelParams holds the parameters of RemoveLabel.
+type RemoveLabelParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The reference of the artifact, can be digest or tag
+	Reference string
+	// The ID of the label that removed from the artifact.
+	LabelID int
+}
+
+// RemoveLabel sends DELETE /projects/{project_name}/repositories/{repository_name}/artifacts/{reference}/labels/{label_id}.
+//
+// Remove label from artifact
+func (c *Client) RemoveLabel(ctx context.Context, params RemoveLabelParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts/" + url.PathEscape(params.Reference) + "/labels/" + strconv.Itoa(params.LabelID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// ScanArtifactParams holds the parameters of ScanArtifact.
+type ScanArtifactParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The reference of the artifact, can be digest or tag
+	Reference string
+	Body      *ScanType
+}
+
+// ScanArtifact sends POST /projects/{project_name}/repositories/{repository_name}/artifacts/{reference}/scan.
+//
+// Scan the artifact
+func (c *Client) ScanArtifact(ctx context.Context, params ScanArtifactParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts/" + url.PathEscape(params.Reference) + "/scan"
+	query := url.Values{}
+	var in any
+	if params.Body != nil {
+		in = params.Body
+	}
+	return c.do(ctx, http.MethodPost, path, query, in, nil)
+}
+
+// StopScanArtifactParams holds the parameters of StopScanArtifact.
+type StopScanArtifactParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The reference of the artifact, can be digest or tag
+	Reference string
+	// The scan type: Vulnerabilities, SBOM
+	Body ScanType
+}
+
+// StopScanArtifact sends POST /projects/{project_name}/repositories/{repository_name}/artifacts/{reference}/scan/stop.
+//
+// Cancelling a scan job for a particular artifact
+func (c *Client) StopScanArtifact(ctx context.Context, params StopScanArtifactParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts/" + url.PathEscape(params.Reference) + "/scan/stop"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetReportLogParams holds the parameters of GetReportLog.
+type GetReportLogParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The reference of the artifact, can be digest or tag
+	Reference string
+	// The report id to get the log
+	ReportID string
+}
+
+// GetReportLog sends GET /projects/{project_name}/repositories/{repository_name}/artifacts/{reference}/scan/{report_id}/log.
+//
+// Get the log of the scan report
+func (c *Client) GetReportLog(ctx context.Context, params GetReportLogParams) (*string, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts/" + url.PathEscape(params.Reference) + "/scan/" + url.PathEscape(params.ReportID) + "/log"
+	query := url.Values{}
+	var out string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// ListTagsParams holds the parameters of ListTags.
+type ListTagsParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The reference of the artifact, can be digest or tag
+	Reference string
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Specify whether the immutable status is included inside the returning tags
+	WithImmutableStatus *bool
+}
+
+// ListTags sends GET /projects/{project_name}/repositories/{repository_name}/artifacts/{reference}/tags.
+//
+// List tags
+func (c *Client) ListTags(ctx context.Context, params ListTagsParams) ([]Tag, *http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts/" + url.PathEscape(params.Reference) + "/tags"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.WithImmutableStatus != nil {
+		query.Set("with_immutable_status", strconv.FormatBool(*params.WithImmutableStatus))
+	}
+	var out []Tag
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreateTagParams holds the parameters of CreateTag.
+type CreateTagParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The reference of the artifact, can be digest or tag
+	Reference string
+	// The JSON object of tag.
+	Body NewTag
+}
+
+// CreateTag sends POST /projects/{project_name}/repositories/{repository_name}/artifacts/{reference}/tags.
+//
+// Create tag
+func (c *Client) CreateTag(ctx context.Context, params CreateTagParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts/" + url.PathEscape(params.Reference) + "/tags"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// DeleteTagParams holds the parameters of DeleteTag.
+type DeleteTagParams struct {
+	// The name of the project
+	ProjectName string
+	// The name of the repository. If it contains slash, encode it twice over with URL encoding. e.g. a/b -> a%2Fb -> a%252Fb
+	RepositoryName string
+	// The reference of the artifact, can be digest or tag
+	Reference string
+	// The name of the tag
+	TagName string
+}
+
+// DeleteTag sends DELETE /projects/{project_name}/repositories/{repository_name}/artifacts/{reference}/tags/{tag_name}.
+//
+// Delete tag
+func (c *Client) DeleteTag(ctx context.Context, params DeleteTagParams) (*http.Response, error) {
+	path := "/projects/" + url.PathEscape(params.ProjectName) + "/repositories/" + url.PathEscape(params.RepositoryName) + "/artifacts/" + url.PathEscape(params.Reference) + "/tags/" + url.PathEscape(params.TagName)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// ListQuotasParams holds the parameters of ListQuotas.
+type ListQuotasParams struct {
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// The reference type of quota.
+	Reference string
+	// The reference id of quota.
+	ReferenceID string
+	// Sort method, valid values include:
+	// 'hard.resource_name', '-hard.resource_name', 'used.resource_name', '-used.resource_name'.
+	// Here '-' stands for descending order, resource_name should be the real resource name of the quota.
+	Sort string
+}
+
+// ListQuotas sends GET /quotas.
+//
+// List quotas
+func (c *Client) ListQuotas(ctx context.Context, params ListQuotasParams) ([]Quota, *http.Response, error) {
+	path := "/quotas"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Reference != "" {
+		query.Set("reference", params.Reference)
+	}
+	if params.ReferenceID != "" {
+		query.Set("reference_id", params.ReferenceID)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	var out []Quota
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetQuotaParams holds the parameters of GetQuota.
+type GetQuotaParams struct {
+	// Quota ID
+	ID int
+}
+
+// GetQuota sends GET /quotas/{id}.
+//
+// Get the specified quota
+func (c *Client) GetQuota(ctx context.Context, params GetQuotaParams) (*Quota, *http.Response, error) {
+	path := "/quotas/" + strconv.Itoa(params.ID)
+	query := url.Values{}
+	var out Quota
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateQuotaParams holds the parameters of UpdateQuota.
+type UpdateQuotaParams struct {
+	// Quota ID
+	ID int
+	// The new hard limits for the quota
+	Body QuotaUpdateReq
+}
+
+// UpdateQuota sends PUT /quotas/{id}.
+//
+// Update the specified quota
+func (c *Client) UpdateQuota(ctx context.Context, params UpdateQuotaParams) (*http.Response, error) {
+	path := "/quotas/" + strconv.Itoa(params.ID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// ListRegistriesParams holds the parameters of ListRegistries.
+type ListRegistriesParams struct {
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Deprecated, use `q` instead.
+	Name string
+}
+
+// ListRegistries sends GET /registries.
+//
+// List the registries
+func (c *Client) ListRegistries(ctx context.Context, params ListRegistriesParams) ([]Registry, *http.Response, error) {
+	path := "/registries"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Name != "" {
+		query.Set("name", params.Name)
+	}
+	var out []Registry
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreateRegistryParams holds the parameters of CreateRegistry.
+type CreateRegistryParams struct {
+	// The registry
+	Body Registry
+}
+
+// CreateRegistry sends POST /registries.
+//
+// Create a registry
+func (c *Client) CreateRegistry(ctx context.Context, params CreateRegistryParams) (*http.Response, error) {
+	path := "/registries"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// PingRegistryParams holds the parameters of PingRegistry.
+type PingRegistryParams struct {
+	// The registry
+	Body RegistryPing
+}
+
+// PingRegistry sends POST /registries/ping.
+//
+// Check status of a registry
+func (c *Client) PingRegistry(ctx context.Context, params PingRegistryParams) (*http.Response, error) {
+	path := "/registries/ping"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetRegistryParams holds the parameters of GetRegistry.
+type GetRegistryParams struct {
+	// Registry ID
+	ID int
+}
+
+// GetRegistry sends GET /registries/{id}.
+//
+// Get the specific registry
+func (c *Client) GetRegistry(ctx context.Context, params GetRegistryParams) (*Registry, *http.Response, error) {
+	path := "/registries/" + strconv.Itoa(params.ID)
+	query := url.Values{}
+	var out Registry
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateRegistryParams holds the parameters of UpdateRegistry.
+type UpdateRegistryParams struct {
+	// The registry ID
+	ID int
+	// The registry
+	Body RegistryUpdate
+}
+
+// UpdateRegistry sends PUT /registries/{id}.
+//
+// Update the registry
+func (c *Client) UpdateRegistry(ctx context.Context, params UpdateRegistryParams) (*http.Response, error) {
+	path := "/registries/" + strconv.Itoa(params.ID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// DeleteRegistryParams holds the parameters of DeleteRegistry.
+type DeleteRegistryParams struct {
+	// Registry ID
+	ID int
+}
+
+// DeleteRegistry sends DELETE /registries/{id}.
+//
+// Delete the specific registry
+func (c *Client) DeleteRegistry(ctx context.Context, params DeleteRegistryParams) (*http.Response, error) {
+	path := "/registries/" + strconv.Itoa(params.ID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// GetRegistryInfoParams holds the parameters of GetRegistryInfo.
+type GetRegistryInfoParams struct {
+	// Registry ID
+	ID int
+}
+
+// GetRegistryInfo sends GET /registries/{id}/info.
+//
+// Get the registry info
+func (c *Client) GetRegistryInfo(ctx context.Context, params GetRegistryInfoParams) (*RegistryInfo, *http.Response, error) {
+	path := "/registries/" + strconv.Itoa(params.ID) + "/info"
+	query := url.Values{}
+	var out RegistryInfo
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// ListRegistryProviderInfos sends GET /replication/adapterinfos.
+//
+// List all registered registry provider information
+func (c *Client) ListRegistryProviderInfos(ctx context.Context) (map[string]RegistryProviderInfo, *http.Response, error) {
+	path := "/replication/adapterinfos"
+	query := url.Values{}
+	var out map[string]RegistryProviderInfo
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// ListRegistryProviderTypes sends GET /replication/adapters.
+//
+// List registry adapters
+func (c *Client) ListRegistryProviderTypes(ctx context.Context) ([]string, *http.Response, error) {
+	path := "/replication/adapters"
+	query := url.Values{}
+	var out []string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// ListReplicationExecutionsParams holds the parameters of ListReplicationExecutions.
+type ListReplicationExecutionsParams struct {
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// The ID of the policy that the executions belong to.
+	PolicyID int
+	// The execution status.
+	Status string
+	// The trigger mode.
+	Trigger string
+}
+
+// ListReplicationExecutions sends GET /replication/executions.
+//
+// List replication executions
+func (c *Client) ListReplicationExecutions(ctx context.Context, params ListReplicationExecutionsParams) ([]ReplicationExecution, *http.Response, error) {
+	path := "/replication/executions"
+	query := url.Values{}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.PolicyID != 0 {
+		query.Set("policy_id", strconv.Itoa(params.PolicyID))
+	}
+	if params.Status != "" {
+		query.Set("status", params.Status)
+	}
+	if params.Trigger != "" {
+		query.Set("trigger", params.Trigger)
+	}
+	var out []ReplicationExecution
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// StartReplicationParams holds the parameters of StartReplication.
+type StartReplicationParams struct {
+	// The ID of policy that the execution belongs to
+	Body StartReplicationExecution
+}
+
+// StartReplication sends POST /replication/executions.
+//
+// Start one replication execution
+func (c *Client) StartReplication(ctx context.Context, params StartReplicationParams) (*http.Response, error) {
+	path := "/replication/executions"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetReplicationExecutionParams holds the parameters of GetReplicationExecution.
+type GetReplicationExecutionParams struct {
+	// The ID of the execution.
+	ID int
+}
+
+// GetReplicationExecution sends GET /replication/executions/{id}.
+//
+// Get the specific replication execution
+func (c *Client) GetReplicationExecution(ctx context.Context, params GetReplicationExecutionParams) (*ReplicationExecution, *http.Response, error) {
+	path := "/replication/executions/" + strconv.Itoa(params.ID)
+	query := url.Values{}
+	var out ReplicationExecution
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// StopReplicationParams holds the parameters of StopReplication.
+type StopReplicationParams struct {
+	// The ID of the execution.
+	ID int
+}
+
+// StopReplication sends PUT /replication/executions/{id}.
+//
+// Stop the specific replication execution
+func (c *Client) StopReplication(ctx context.Context, params StopReplicationParams) (*http.Response, error) {
+	path := "/replication/executions/" + strconv.Itoa(params.ID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, nil, nil)
+}
+
+// ListReplicationTasksParams holds the parameters of ListReplicationTasks.
+type ListReplicationTasksParams struct {
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// The ID of the execution that the tasks belongs to.
+	ID int
+	// The task status.
+	Status string
+	// The resource type.
+	ResourceType string
+}
+
+// ListReplicationTasks sends GET /replication/executions/{id}/tasks.
+//
+// List replication tasks for a specific execution
+func (c *Client) ListReplicationTasks(ctx context.Context, params ListReplicationTasksParams) ([]ReplicationTask, *http.Response, error) {
+	path := "/replication/executions/" + strconv.Itoa(params.ID) + "/tasks"
+	query := url.Values{}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Status != "" {
+		query.Set("status", params.Status)
+	}
+	if params.ResourceType != "" {
+		query.Set("resource_type", params.ResourceType)
+	}
+	var out []ReplicationTask
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetReplicationLogParams holds the parameters of GetReplicationLog.
+type GetReplicationLogParams struct {
+	// The ID of the execution that the tasks belongs to.
+	ID int
+	// The ID of the task.
+	TaskID int
+}
+
+// GetReplicationLog sends GET /replication/executions/{id}/tasks/{task_id}/log.
+//
+// Get the log of the specific replication task
+func (c *Client) GetReplicationLog(ctx context.Context, params GetReplicationLogParams) (*string, *http.Response, error) {
+	path := "/replication/executions/" + strconv.Itoa(params.ID) + "/tasks/" + strconv.Itoa(params.TaskID) + "/log"
+	query := url.Values{}
+	var out string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// ListReplicationPoliciesParams holds the parameters of ListReplicationPolicies.
+type ListReplicationPoliciesParams struct {
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Deprecated, use "query" instead. The policy name.
+	Name string
+}
+
+// ListReplicationPolicies sends GET /replication/policies.
+//
+// List replication policies
+func (c *Client) ListReplicationPolicies(ctx context.Context, params ListReplicationPoliciesParams) ([]ReplicationPolicy, *http.Response, error) {
+	path := "/replication/policies"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.Name != "" {
+		query.Set("name", params.Name)
+	}
+	var out []ReplicationPolicy
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreateReplicationPolicyParams holds the parameters of CreateReplicationPolicy.
+type CreateReplicationPolicyParams struct {
+	// The replication policy
+	Body ReplicationPolicy
+}
+
+// CreateReplicationPolicy sends POST /replication/policies.
+//
+// Create a replication policy
+func (c *Client) CreateReplicationPolicy(ctx context.Context, params CreateReplicationPolicyParams) (*http.Response, error) {
+	path := "/replication/policies"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetReplicationPolicyParams holds the parameters of GetReplicationPolicy.
+type GetReplicationPolicyParams struct {
+	// Policy ID
+	ID int
+}
+
+// GetReplicationPolicy sends GET /replication/policies/{id}.
+//
+// Get the specific replication policy
+func (c *Client) GetReplicationPolicy(ctx context.Context, params GetReplicationPolicyParams) (*ReplicationPolicy, *http.Response, error) {
+	path := "/replication/policies/" + strconv.Itoa(params.ID)
+	query := url.Values{}
+	var out ReplicationPolicy
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateReplicationPolicyParams holds the parameters of UpdateReplicationPolicy.
+type UpdateReplicationPolicyParams struct {
+	// The policy ID
+	ID int
+	// The replication policy
+	Body ReplicationPolicy
+}
+
+// UpdateReplicationPolicy sends PUT /replication/policies/{id}.
+//
+// Update the replication policy
+func (c *Client) UpdateReplicationPolicy(ctx context.Context, params UpdateReplicationPolicyParams) (*http.Response, error) {
+	path := "/replication/policies/" + strconv.Itoa(params.ID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// DeleteReplicationPolicyParams holds the parameters of DeleteReplicationPolicy.
+type DeleteReplicationPolicyParams struct {
+	// Replication policy ID
+	ID int
+}
+
+// DeleteReplicationPolicy sends DELETE /replication/policies/{id}.
+//
+// Delete the specific replication policy
+func (c *Client) DeleteReplicationPolicy(ctx context.Context, params DeleteReplicationPolicyParams) (*http.Response, error) {
+	path := "/replication/policies/" + strconv.Itoa(params.ID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// ListAllRepositoriesParams holds the parameters of ListAllRepositories.
+type ListAllRepositoriesParams struct {
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// ListAllRepositories sends GET /repositories.
+//
+// List all authorized repositories
+func (c *Client) ListAllRepositories(ctx context.Context, params ListAllRepositoriesParams) ([]Repository, *http.Response, error) {
+	path := "/repositories"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []Repository
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreateRetentionParams holds the parameters of CreateRetention.
+type CreateRetentionParams struct {
+	// Create Retention Policy successfully.
+	Body RetentionPolicy
+}
+
+// CreateRetention sends POST /retentions.
+//
+// Create Retention Policy
+func (c *Client) CreateRetention(ctx context.Context, params CreateRetentionParams) (*http.Response, error) {
+	path := "/retentions"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetRentenitionMetadata sends GET /retentions/metadatas.
+//
+// Get Retention Metadatas
+func (c *Client) GetRentenitionMetadata(ctx context.Context) (*RetentionMetadata, *http.Response, error) {
+	path := "/retentions/metadatas"
+	query := url.Values{}
+	var out RetentionMetadata
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetRetentionParams holds the parameters of GetRetention.
+type GetRetentionParams struct {
+	// Retention ID.
+	ID int
+}
+
+// GetRetention sends GET /retentions/{id}.
+//
+// Get Retention Policy
+func (c *Client) GetRetention(ctx context.Context, params GetRetentionParams) (*RetentionPolicy, *http.Response, error) {
+	path := "/retentions/" + strconv.Itoa(params.ID)
+	query := url.Values{}
+	var out RetentionPolicy
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateRetentionParams holds the parameters of UpdateRetention.
+type UpdateRetentionParams struct {
+	// Retention ID.
+	ID   int
+	Body RetentionPolicy
+}
+
+// UpdateRetention sends PUT /retentions/{id}.
+//
+// Update Retention Policy
+func (c *Client) UpdateRetention(ctx context.Context, params UpdateRetentionParams) (*http.Response, error) {
+	path := "/retentions/" + strconv.Itoa(params.ID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// DeleteRetentionParams holds the parameters of DeleteRetention.
+type DeleteRetentionParams struct {
+	// Retention ID.
+	ID int
+}
+
+// DeleteRetention sends DELETE /retentions/{id}.
+//
+// Delete Retention Policy
+func (c *Client) DeleteRetention(ctx context.Context, params DeleteRetentionParams) (*http.Response, error) {
+	path := "/retentions/" + strconv.Itoa(params.ID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// ListRetentionExecutionsParams holds the parameters of ListRetentionExecutions.
+type ListRetentionExecutionsParams struct {
+	// Retention ID.
+	ID int
+	// The page number.
+	Page int
+	// The size of per page.
+	PageSize int
+}
+
+// ListRetentionExecutions sends GET /retentions/{id}/executions.
+//
+// Get Retention executions
+func (c *Client) ListRetentionExecutions(ctx context.Context, params ListRetentionExecutionsParams) ([]RetentionExecution, *http.Response, error) {
+	path := "/retentions/" + strconv.Itoa(params.ID) + "/executions"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []RetentionExecution
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// TriggerRetentionExecutionParams holds the parameters of TriggerRetentionExecution.
+type TriggerRetentionExecutionParams struct {
+	// Retention ID.
+	ID   int
+	Body TriggerRetentionExecutionBody
+}
+
+// TriggerRetentionExecution sends POST /retentions/{id}/executions.
+//
+// Trigger a Retention Execution
+func (c *Client) TriggerRetentionExecution(ctx context.Context, params TriggerRetentionExecutionParams) (*http.Response, error) {
+	path := "/retentions/" + strconv.Itoa(params.ID) + "/executions"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// OperateRetentionExecutionParams holds the parameters of OperateRetentionExecution.
+type OperateRetentionExecutionParams struct {
+	// Retention ID.
+	ID int
+	// Retention execution ID.
+	Eid int
+	// The action, only support "stop" now.
+	Body OperateRetentionExecutionBody
+}
+
+// OperateRetentionExecution sends PATCH /retentions/{id}/executions/{eid}.
+//
+// Stop a Retention execution
+func (c *Client) OperateRetentionExecution(ctx context.Context, params OperateRetentionExecutionParams) (*http.Response, error) {
+	path := "/retentions/" + strconv.Itoa(params.ID) + "/executions/" + strconv.Itoa(params.Eid)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPatch, path, query, params.Body, nil)
+}
+
+// ListRetentionTasksParams holds the parameters of ListRetentionTasks.
+type ListRetentionTasksParams struct {
+	// Retention ID.
+	ID int
+	// Retention execution ID.
+	Eid int
+	// The page number.
+	Page int
+	// The size of per page.
+	PageSize int
+}
+
+// ListRetentionTasks sends GET /retentions/{id}/executions/{eid}/tasks.
+//
+// Get Retention tasks
+func (c *Client) ListRetentionTasks(ctx context.Context, params ListRetentionTasksParams) ([]RetentionExecutionTask, *http.Response, error) {
+	path := "/retentions/" + strconv.Itoa(params.ID) + "/executions/" + strconv.Itoa(params.Eid) + "/tasks"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []RetentionExecutionTask
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetRetentionTaskLogParams holds the parameters of GetRetentionTaskLog.
+type GetRetentionTaskLogParams struct {
+	// Retention ID.
+	ID int
+	// Retention execution ID.
+	Eid int
+	// Retention execution ID.
+	Tid int
+}
+
+// GetRetentionTaskLog sends GET /retentions/{id}/executions/{eid}/tasks/{tid}.
+//
+// Get Retention job task log
+func (c *Client) GetRetentionTaskLog(ctx context.Context, params GetRetentionTaskLogParams) (*string, *http.Response, error) {
+	path := "/retentions/" + strconv.Itoa(params.ID) + "/executions/" + strconv.Itoa(params.Eid) + "/tasks/" + strconv.Itoa(params.Tid)
+	query := url.Values{}
+	var out string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// ListRobotParams holds the parameters of ListRobot.
+type ListRobotParams struct {
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// ListRobot sends GET /robots.
+//
+// Get robot account
+func (c *Client) ListRobot(ctx context.Context, params ListRobotParams) ([]Robot, *http.Response, error) {
+	path := "/robots"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []Robot
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreateRobotParams holds the parameters of CreateRobot.
+type CreateRobotParams struct {
+	// The JSON object of a robot account.
+	Body RobotCreate
+}
+
+// CreateRobot sends POST /robots.
+//
+// Create a robot account
+func (c *Client) CreateRobot(ctx context.Context, params CreateRobotParams) (*RobotCreated, *http.Response, error) {
+	path := "/robots"
+	query := url.Values{}
+	var out RobotCreated
+	resp, err := c.do(ctx, http.MethodPost, path, query, params.Body, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetRobotByIDParams holds the parameters of GetRobotByID.
+type GetRobotByIDParams struct {
+	// Robot ID
+	RobotID int
+}
+
+// GetRobotByID sends GET /robots/{robot_id}.
+//
+// Get a robot account
+func (c *Client) GetRobotByID(ctx context.Context, params GetRobotByIDParams) (*Robot, *http.Response, error) {
+	path := "/robots/" + strconv.Itoa(params.RobotID)
+	query := url.Values{}
+	var out Robot
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateRobotParams holds the parameters of UpdateRobot.
+type UpdateRobotParams struct {
+	// Robot ID
+	RobotID int
+	// The JSON object of a robot account.
+	Body Robot
+}
+
+// UpdateRobot sends PUT /robots/{robot_id}.
+//
+// Update a robot account
+func (c *Client) UpdateRobot(ctx context.Context, params UpdateRobotParams) (*http.Response, error) {
+	path := "/robots/" + strconv.Itoa(params.RobotID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// RefreshSecParams holds the parameters of RefreshSec.
+type RefreshSecParams struct {
+	// Robot ID
+	RobotID int
+	// The JSON object of a robot account.
+	Body RobotSec
+}
+
+// RefreshSec sends PATCH /robots/{robot_id}.
+//
+// Refresh the robot secret
+func (c *Client) RefreshSec(ctx context.Context, params RefreshSecParams) (*RobotSec, *http.Response, error) {
+	path := "/robots/" + strconv.Itoa(params.RobotID)
+	query := url.Values{}
+	var out RobotSec
+	resp, err := c.do(ctx, http.MethodPatch, path, query, params.Body, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// DeleteRobotParams holds the parameters of DeleteRobot.
+type DeleteRobotParams struct {
+	// Robot ID
+	RobotID int
+}
+
+// DeleteRobot sends DELETE /robots/{robot_id}.
+//
+// Delete a robot account
+func (c *Client) DeleteRobot(ctx context.Context, params DeleteRobotParams) (*http.Response, error) {
+	path := "/robots/" + strconv.Itoa(params.RobotID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// ListScannersParams holds the parameters of ListScanners.
+type ListScannersParams struct {
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// ListScanners sends GET /scanners.
+//
+// List scanner registrations
+func (c *Client) ListScanners(ctx context.Context, params ListScannersParams) ([]ScannerRegistration, *http.Response, error) {
+	path := "/scanners"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []ScannerRegistration
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreateScannerParams holds the parameters of CreateScanner.
+type CreateScannerParams struct {
+	// A scanner registration to be created.
+	Body ScannerRegistrationReq
+}
+
+// CreateScanner sends POST /scanners.
+//
+// Create a scanner registration
+func (c *Client) CreateScanner(ctx context.Context, params CreateScannerParams) (*http.Response, error) {
+	path := "/scanners"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// PingScannerParams holds the parameters of PingScanner.
+type PingScannerParams struct {
+	// A scanner registration settings to be tested.
+	Body ScannerRegistrationSettings
+}
+
+// PingScanner sends POST /scanners/ping.
+//
+// Tests scanner registration settings
+func (c *Client) PingScanner(ctx context.Context, params PingScannerParams) (*http.Response, error) {
+	path := "/scanners/ping"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetScannerParams holds the parameters of GetScanner.
+type GetScannerParams struct {
+	// The scanner registration identifer.
+	RegistrationID string
+}
+
+// GetScanner sends GET /scanners/{registration_id}.
+//
+// Get a scanner registration details
+func (c *Client) GetScanner(ctx context.Context, params GetScannerParams) (*ScannerRegistration, *http.Response, error) {
+	path := "/scanners/" + url.PathEscape(params.RegistrationID)
+	query := url.Values{}
+	var out ScannerRegistration
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateScannerParams holds the parameters of UpdateScanner.
+type UpdateScannerParams struct {
+	// The scanner registration identifier.
+	RegistrationID string
+	// A scanner registration to be updated.
+	Body ScannerRegistrationReq
+}
+
+// UpdateScanner sends PUT /scanners/{registration_id}.
+//
+// Update a scanner registration
+func (c *Client) UpdateScanner(ctx context.Context, params UpdateScannerParams) (*http.Response, error) {
+	path := "/scanners/" + url.PathEscape(params.RegistrationID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// SetScannerAsDefaultParams holds the parameters of SetScannerAsDefault.
+type SetScannerAsDefaultParams struct {
+	// The scanner registration identifier.
+	RegistrationID string
+	Body           IsDefault
+}
+
+// SetScannerAsDefault sends PATCH /scanners/{registration_id}.
+//
+// Set system default scanner registration
+func (c *Client) SetScannerAsDefault(ctx context.Context, params SetScannerAsDefaultParams) (*http.Response, error) {
+	path := "/scanners/" + url.PathEscape(params.RegistrationID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPatch, path, query, params.Body, nil)
+}
+
+// DeleteScannerParams holds the parameters of DeleteScanner.
+type DeleteScannerParams struct {
+	// The scanner registration identifier.
+	RegistrationID string
+}
+
+// DeleteScanner sends DELETE /scanners/{registration_id}.
+//
+// Delete a scanner registration
+func (c *Client) DeleteScanner(ctx context.Context, params DeleteScannerParams) (*ScannerRegistration, *http.Response, error) {
+	path := "/scanners/" + url.PathEscape(params.RegistrationID)
+	query := url.Values{}
+	var out ScannerRegistration
+	resp, err := c.do(ctx, http.MethodDelete, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetScannerMetadataParams holds the parameters of GetScannerMetadata.
+type GetScannerMetadataParams struct {
+	// The scanner registration identifier.
+	RegistrationID string
+}
+
+// GetScannerMetadata sends GET /scanners/{registration_id}/metadata.
+//
+// Get the metadata of the specified scanner registration
+func (c *Client) GetScannerMetadata(ctx context.Context, params GetScannerMetadataParams) (*ScannerAdapterMetadata, *http.Response, error) {
+	path := "/scanners/" + url.PathEscape(params.RegistrationID) + "/metadata"
+	query := url.Values{}
+	var out ScannerAdapterMetadata
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetLatestScanAllMetrics sends GET /scans/all/metrics.
+//
+// Get the metrics of the latest scan all process
+func (c *Client) GetLatestScanAllMetrics(ctx context.Context) (*Stats, *http.Response, error) {
+	path := "/scans/all/metrics"
+	query := url.Values{}
+	var out Stats
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetLatestScheduledScanAllMetrics sends GET /scans/schedule/metrics.
+//
+// # Get the metrics of the latest scheduled scan all process
+//
+// Deprecated: Harbor marks this operation as deprecated.
+func (c *Client) GetLatestScheduledScanAllMetrics(ctx context.Context) (*Stats, *http.Response, error) {
+	path := "/scans/schedule/metrics"
+	query := url.Values{}
+	var out Stats
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// ListSchedulesParams holds the parameters of ListSchedules.
+type ListSchedulesParams struct {
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// ListSchedules sends GET /schedules.
+//
+// List schedules
+func (c *Client) ListSchedules(ctx context.Context, params ListSchedulesParams) ([]ScheduleTask, *http.Response, error) {
+	path := "/schedules"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []ScheduleTask
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetSchedulePausedParams holds the parameters of GetSchedulePaused.
+type GetSchedulePausedParams struct {
+	// The type of the job. 'all' stands for all job types, current only support query with all
+	JobType string
+}
+
+// GetSchedulePaused sends GET /schedules/{job_type}/paused.
+//
+// Get scheduler paused status
+func (c *Client) GetSchedulePaused(ctx context.Context, params GetSchedulePausedParams) (*SchedulerStatus, *http.Response, error) {
+	path := "/schedules/" + url.PathEscape(params.JobType) + "/paused"
+	query := url.Values{}
+	var out SchedulerStatus
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// SearchParams holds the parameters of Search.
+type SearchParams struct {
+	// Search parameter for project and repository name.
+	Q string
+}
+
+// Search sends GET /search.
+//
+// Search for projects and repositories
+func (c *Client) Search(ctx context.Context, params SearchParams) (*Search, *http.Response, error) {
+	path := "/search"
+	query := url.Values{}
+	query.Set("q", params.Q)
+	var out Search
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetSecuritySummaryParams holds the parameters of GetSecuritySummary.
+type GetSecuritySummaryParams struct {
+	// Specify whether the dangerous CVEs are included inside summary information
+	WithDangerousCVE *bool
+	// Specify whether the dangerous Artifact are included inside summary information
+	WithDangerousArtifact *bool
+}
+
+// GetSecuritySummary sends GET /security/summary.
+//
+// Get vulnerability system summary
+func (c *Client) GetSecuritySummary(ctx context.Context, params GetSecuritySummaryParams) (*SecuritySummary, *http.Response, error) {
+	path := "/security/summary"
+	query := url.Values{}
+	if params.WithDangerousCVE != nil {
+		query.Set("with_dangerous_cve", strconv.FormatBool(*params.WithDangerousCVE))
+	}
+	if params.WithDangerousArtifact != nil {
+		query.Set("with_dangerous_artifact", strconv.FormatBool(*params.WithDangerousArtifact))
+	}
+	var out SecuritySummary
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// ListVulnerabilitiesParams holds the parameters of ListVulnerabilities.
+type ListVulnerabilitiesParams struct {
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Enable to ignore X-Total-Count when the total count > 1000, if the total count is less than 1000, the real total count is returned, else -1.
+	TuneCount *bool
+	// Specify whether the tag information is included inside vulnerability information
+	WithTag *bool
+}
+
+// ListVulnerabilities sends GET /security/vul.
+//
+// Get the vulnerability list.
+func (c *Client) ListVulnerabilities(ctx context.Context, params ListVulnerabilitiesParams) ([]VulnerabilityItem, *http.Response, error) {
+	path := "/security/vul"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.TuneCount != nil {
+		query.Set("tune_count", strconv.FormatBool(*params.TuneCount))
+	}
+	if params.WithTag != nil {
+		query.Set("with_tag", strconv.FormatBool(*params.WithTag))
+	}
+	var out []VulnerabilityItem
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetStatistic sends GET /statistics.
+//
+// Get the statistic information about the projects and repositories
+func (c *Client) GetStatistic(ctx context.Context) (*Statistic, *http.Response, error) {
+	path := "/statistics"
+	query := url.Values{}
+	var out Statistic
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetSystemCVEAllowlist sends GET /system/CVEAllowlist.
+//
+// Get the system level allowlist of CVE.
+func (c *Client) GetSystemCVEAllowlist(ctx context.Context) (*CVEAllowlist, *http.Response, error) {
+	path := "/system/CVEAllowlist"
+	query := url.Values{}
+	var out CVEAllowlist
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// PutSystemCVEAllowlistParams holds the parameters of PutSystemCVEAllowlist.
+type PutSystemCVEAllowlistParams struct {
+	// The allowlist with new content
+	Body *CVEAllowlist
+}
+
+// PutSystemCVEAllowlist sends PUT /system/CVEAllowlist.
+//
+// Update the system level allowlist of CVE.
+func (c *Client) PutSystemCVEAllowlist(ctx context.Context, params PutSystemCVEAllowlistParams) (*http.Response, error) {
+	path := "/system/CVEAllowlist"
+	query := url.Values{}
+	var in any
+	if params.Body != nil {
+		in = params.Body
+	}
+	return c.do(ctx, http.MethodPut, path, query, in, nil)
+}
+
+// GetGCHistoryParams holds the parameters of GetGCHistory.
+type GetGCHistoryParams struct {
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// GetGCHistory sends GET /system/gc.
+//
+// Get gc results.
+func (c *Client) GetGCHistory(ctx context.Context, params GetGCHistoryParams) ([]GCHistory, *http.Response, error) {
+	path := "/system/gc"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []GCHistory
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetGCSchedule sends GET /system/gc/schedule.
+//
+// Get gc's schedule.
+func (c *Client) GetGCSchedule(ctx context.Context) (*GCHistory, *http.Response, error) {
+	path := "/system/gc/schedule"
+	query := url.Values{}
+	var out GCHistory
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// CreateGCScheduleParams holds the parameters of CreateGCSchedule.
+type CreateGCScheduleParams struct {
+	// Updates of gc's schedule.
+	Body Schedule
+}
+
+// CreateGCSchedule sends POST /system/gc/schedule.
+//
+// Create a gc schedule.
+func (c *Client) CreateGCSchedule(ctx context.Context, params CreateGCScheduleParams) (*http.Response, error) {
+	path := "/system/gc/schedule"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// UpdateGCScheduleParams holds the parameters of UpdateGCSchedule.
+type UpdateGCScheduleParams struct {
+	// Updates of gc's schedule.
+	Body Schedule
+}
+
+// UpdateGCSchedule sends PUT /system/gc/schedule.
+//
+// Update gc's schedule.
+func (c *Client) UpdateGCSchedule(ctx context.Context, params UpdateGCScheduleParams) (*http.Response, error) {
+	path := "/system/gc/schedule"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// GetGCParams holds the parameters of GetGC.
+type GetGCParams struct {
+	// The ID of the gc log
+	GCID int
+}
+
+// GetGC sends GET /system/gc/{gc_id}.
+//
+// Get gc status.
+func (c *Client) GetGC(ctx context.Context, params GetGCParams) (*GCHistory, *http.Response, error) {
+	path := "/system/gc/" + strconv.Itoa(params.GCID)
+	query := url.Values{}
+	var out GCHistory
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// StopGCParams holds the parameters of StopGC.
+type StopGCParams struct {
+	// The ID of the gc log
+	GCID int
+}
+
+// StopGC sends PUT /system/gc/{gc_id}.
+//
+// Stop the specific GC execution
+func (c *Client) StopGC(ctx context.Context, params StopGCParams) (*http.Response, error) {
+	path := "/system/gc/" + strconv.Itoa(params.GCID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, nil, nil)
+}
+
+// GetGCLogParams holds the parameters of GetGCLog.
+type GetGCLogParams struct {
+	// The ID of the gc log
+	GCID int
+}
+
+// GetGCLog sends GET /system/gc/{gc_id}/log.
+//
+// Get gc job log.
+func (c *Client) GetGCLog(ctx context.Context, params GetGCLogParams) (*string, *http.Response, error) {
+	path := "/system/gc/" + strconv.Itoa(params.GCID) + "/log"
+	query := url.Values{}
+	var out string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// PingOIDCParams holds the parameters of PingOIDC.
+type PingOIDCParams struct {
+	// Request body for OIDC endpoint to be tested.
+	Body PingOIDCBody
+}
+
+// PingOIDC sends POST /system/oidc/ping.
+//
+// Test the OIDC endpoint.
+func (c *Client) PingOIDC(ctx context.Context, params PingOIDCParams) (*http.Response, error) {
+	path := "/system/oidc/ping"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetPurgeHistoryParams holds the parameters of GetPurgeHistory.
+type GetPurgeHistoryParams struct {
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// GetPurgeHistory sends GET /system/purgeaudit.
+//
+// Get purge job results.
+func (c *Client) GetPurgeHistory(ctx context.Context, params GetPurgeHistoryParams) ([]ExecHistory, *http.Response, error) {
+	path := "/system/purgeaudit"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []ExecHistory
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetPurgeSchedule sends GET /system/purgeaudit/schedule.
+//
+// Get purge's schedule.
+func (c *Client) GetPurgeSchedule(ctx context.Context) (*ExecHistory, *http.Response, error) {
+	path := "/system/purgeaudit/schedule"
+	query := url.Values{}
+	var out ExecHistory
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// CreatePurgeScheduleParams holds the parameters of CreatePurgeSchedule.
+type CreatePurgeScheduleParams struct {
+	// The purge job's schedule, it is a json object. |
+	// The sample format is |
+	// {"parameters":{"audit_retention_hour":168,"dry_run":true,"include_event_types":"create_artifact,delete_artifact,pull_artifact"},"schedule":{"type":"Hourly","cron":"0 0 * * * *"}} |
+	// the include_operation should be a comma separated string, e.g. create,delete,pull, if it is empty, no operation will be purged.
+	Body Schedule
+}
+
+// CreatePurgeSchedule sends POST /system/purgeaudit/schedule.
+//
+// Create a purge job schedule.
+func (c *Client) CreatePurgeSchedule(ctx context.Context, params CreatePurgeScheduleParams) (*http.Response, error) {
+	path := "/system/purgeaudit/schedule"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// UpdatePurgeScheduleParams holds the parameters of UpdatePurgeSchedule.
+type UpdatePurgeScheduleParams struct {
+	// The purge job's schedule, it is a json object. |
+	// The sample format is |
+	// {"parameters":{"audit_retention_hour":168,"dry_run":true,"include_event_types":"create_artifact,delete_artifact,pull_artifact"},"schedule":{"type":"Hourly","cron":"0 0 * * * *"}} |
+	// the include_operation should be a comma separated string, e.g. create,delete,pull, if it is empty, no operation will be purged.
+	Body Schedule
+}
+
+// UpdatePurgeSchedule sends PUT /system/purgeaudit/schedule.
+//
+// Update purge job's schedule.
+func (c *Client) UpdatePurgeSchedule(ctx context.Context, params UpdatePurgeScheduleParams) (*http.Response, error) {
+	path := "/system/purgeaudit/schedule"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// GetPurgeJobParams holds the parameters of GetPurgeJob.
+type GetPurgeJobParams struct {
+	// The ID of the purge log
+	PurgeID int
+}
+
+// GetPurgeJob sends GET /system/purgeaudit/{purge_id}.
+//
+// Get purge job status.
+func (c *Client) GetPurgeJob(ctx context.Context, params GetPurgeJobParams) (*ExecHistory, *http.Response, error) {
+	path := "/system/purgeaudit/" + strconv.Itoa(params.PurgeID)
+	query := url.Values{}
+	var out ExecHistory
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// StopPurgeParams holds the parameters of StopPurge.
+type StopPurgeParams struct {
+	// The ID of the purge log
+	PurgeID int
+}
+
+// StopPurge sends PUT /system/purgeaudit/{purge_id}.
+//
+// Stop the specific purge audit log execution
+func (c *Client) StopPurge(ctx context.Context, params StopPurgeParams) (*http.Response, error) {
+	path := "/system/purgeaudit/" + strconv.Itoa(params.PurgeID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, nil, nil)
+}
+
+// GetPurgeJobLogParams holds the parameters of GetPurgeJobLog.
+type GetPurgeJobLogParams struct {
+	// The ID of the purge log
+	PurgeID int
+}
+
+// GetPurgeJobLog sends GET /system/purgeaudit/{purge_id}/log.
+//
+// Get purge job log.
+func (c *Client) GetPurgeJobLog(ctx context.Context, params GetPurgeJobLogParams) (*string, *http.Response, error) {
+	path := "/system/purgeaudit/" + strconv.Itoa(params.PurgeID) + "/log"
+	query := url.Values{}
+	var out string
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetScanAllSchedule sends GET /system/scanAll/schedule.
+//
+// Get scan all's schedule.
+func (c *Client) GetScanAllSchedule(ctx context.Context) (*Schedule, *http.Response, error) {
+	path := "/system/scanAll/schedule"
+	query := url.Values{}
+	var out Schedule
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// CreateScanAllScheduleParams holds the parameters of CreateScanAllSchedule.
+type CreateScanAllScheduleParams struct {
+	// Create a schedule or a manual trigger for the scan all job.
+	Body Schedule
+}
+
+// CreateScanAllSchedule sends POST /system/scanAll/schedule.
+//
+// Create a schedule or a manual trigger for the scan all job.
+func (c *Client) CreateScanAllSchedule(ctx context.Context, params CreateScanAllScheduleParams) (*http.Response, error) {
+	path := "/system/scanAll/schedule"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// UpdateScanAllScheduleParams holds the parameters of UpdateScanAllSchedule.
+type UpdateScanAllScheduleParams struct {
+	// Updates the schedule of scan all job, which scans all of images in Harbor.
+	Body Schedule
+}
+
+// UpdateScanAllSchedule sends PUT /system/scanAll/schedule.
+//
+// Update scan all's schedule.
+func (c *Client) UpdateScanAllSchedule(ctx context.Context, params UpdateScanAllScheduleParams) (*http.Response, error) {
+	path := "/system/scanAll/schedule"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// StopScanAll sends POST /system/scanAll/stop.
+//
+// Stop scanAll job execution
+func (c *Client) StopScanAll(ctx context.Context) (*http.Response, error) {
+	path := "/system/scanAll/stop"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, nil, nil)
+}
+
+// GetSystemInfo sends GET /systeminfo.
+//
+// Get general system info
+func (c *Client) GetSystemInfo(ctx context.Context) (*GeneralInfo, *http.Response, error) {
+	path := "/systeminfo"
+	query := url.Values{}
+	var out GeneralInfo
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetCert sends GET /systeminfo/getcert.
+//
+// Get default root certificate.
+func (c *Client) GetCert(ctx context.Context) (any, *http.Response, error) {
+	path := "/systeminfo/getcert"
+	query := url.Values{}
+	var out any
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetVolumes sends GET /systeminfo/volumes.
+//
+// Get system volume info (total/free size).
+func (c *Client) GetVolumes(ctx context.Context) (*SystemInfo, *http.Response, error) {
+	path := "/systeminfo/volumes"
+	query := url.Values{}
+	var out SystemInfo
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// ListUserGroupsParams holds the parameters of ListUserGroups.
+type ListUserGroupsParams struct {
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// search with ldap group DN
+	LDAPGroupDN string
+	// group name need to search, fuzzy matches
+	GroupName string
+}
+
+// ListUserGroups sends GET /usergroups.
+//
+// Get all user groups information
+func (c *Client) ListUserGroups(ctx context.Context, params ListUserGroupsParams) ([]UserGroup, *http.Response, error) {
+	path := "/usergroups"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	if params.LDAPGroupDN != "" {
+		query.Set("ldap_group_dn", params.LDAPGroupDN)
+	}
+	if params.GroupName != "" {
+		query.Set("group_name", params.GroupName)
+	}
+	var out []UserGroup
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreateUserGroupParams holds the parameters of CreateUserGroup.
+type CreateUserGroupParams struct {
+	Body *UserGroup
+}
+
+// CreateUserGroup sends POST /usergroups.
+//
+// Create user group
+func (c *Client) CreateUserGroup(ctx context.Context, params CreateUserGroupParams) (*http.Response, error) {
+	path := "/usergroups"
+	query := url.Values{}
+	var in any
+	if params.Body != nil {
+		in = params.Body
+	}
+	return c.do(ctx, http.MethodPost, path, query, in, nil)
+}
+
+// SearchUserGroupsParams holds the parameters of SearchUserGroups.
+type SearchUserGroupsParams struct {
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Group name for filtering results.
+	Groupname string
+}
+
+// SearchUserGroups sends GET /usergroups/search.
+//
+// Search groups by groupname
+func (c *Client) SearchUserGroups(ctx context.Context, params SearchUserGroupsParams) ([]UserGroupSearchItem, *http.Response, error) {
+	path := "/usergroups/search"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	query.Set("groupname", params.Groupname)
+	var out []UserGroupSearchItem
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetUserGroupParams holds the parameters of GetUserGroup.
+type GetUserGroupParams struct {
+	// Group ID
+	GroupID int
+}
+
+// GetUserGroup sends GET /usergroups/{group_id}.
+//
+// Get user group information
+func (c *Client) GetUserGroup(ctx context.Context, params GetUserGroupParams) (*UserGroup, *http.Response, error) {
+	path := "/usergroups/" + strconv.Itoa(params.GroupID)
+	query := url.Values{}
+	var out UserGroup
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateUserGroupParams holds the parameters of UpdateUserGroup.
+type UpdateUserGroupParams struct {
+	// Group ID
+	GroupID int
+	Body    *UserGroup
+}
+
+// UpdateUserGroup sends PUT /usergroups/{group_id}.
+//
+// Update group information
+func (c *Client) UpdateUserGroup(ctx context.Context, params UpdateUserGroupParams) (*http.Response, error) {
+	path := "/usergroups/" + strconv.Itoa(params.GroupID)
+	query := url.Values{}
+	var in any
+	if params.Body != nil {
+		in = params.Body
+	}
+	return c.do(ctx, http.MethodPut, path, query, in, nil)
+}
+
+// DeleteUserGroupParams holds the parameters of DeleteUserGroup.
+type DeleteUserGroupParams struct {
+	GroupID int
+}
+
+// DeleteUserGroup sends DELETE /usergroups/{group_id}.
+//
+// Delete user group
+func (c *Client) DeleteUserGroup(ctx context.Context, params DeleteUserGroupParams) (*http.Response, error) {
+	path := "/usergroups/" + strconv.Itoa(params.GroupID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// ListUsersParams holds the parameters of ListUsers.
+type ListUsersParams struct {
+	// Query string to query resources. Supported query patterns are "exact match(k=v)", "fuzzy match(k=~v)", "range(k=[min~max])", "list with union releationship(k={v1 v2 v3})" and "list with intersetion relationship(k=(v1 v2 v3))". The value of range and list can be string(enclosed by " or '), integer or time(in format "2020-04-09 02:36:00"). All of these query patterns should be put in the query string "q=xxx" and splitted by ",". e.g. q=k1=v1,k2=~v2,k3=[min~max]
+	Q string
+	// Sort the resource list in ascending or descending order. e.g. sort by field1 in ascending order and field2 in descending order with "sort=field1,-field2"
+	Sort string
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+}
+
+// ListUsers sends GET /users.
+//
+// List users
+func (c *Client) ListUsers(ctx context.Context, params ListUsersParams) ([]UserResp, *http.Response, error) {
+	path := "/users"
+	query := url.Values{}
+	if params.Q != "" {
+		query.Set("q", params.Q)
+	}
+	if params.Sort != "" {
+		query.Set("sort", params.Sort)
+	}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	var out []UserResp
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// CreateUserParams holds the parameters of CreateUser.
+type CreateUserParams struct {
+	// The new user
+	Body UserCreationReq
+}
+
+// CreateUser sends POST /users.
+//
+// Create a local user.
+func (c *Client) CreateUser(ctx context.Context, params CreateUserParams) (*http.Response, error) {
+	path := "/users"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, path, query, params.Body, nil)
+}
+
+// GetCurrentUserInfo sends GET /users/current.
+//
+// Get current user info.
+func (c *Client) GetCurrentUserInfo(ctx context.Context) (*UserResp, *http.Response, error) {
+	path := "/users/current"
+	query := url.Values{}
+	var out UserResp
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// GetCurrentUserPermissionsParams holds the parameters of GetCurrentUserPermissions.
+type GetCurrentUserPermissionsParams struct {
+	// The scope for the permission
+	Scope string
+	// If true, the resources in the response are relative to the scope,
+	// eg for resource '/project/1/repository' if relative is 'true' then the resource in response will be 'repository'.
+	Relative *bool
+}
+
+// GetCurrentUserPermissions sends GET /users/current/permissions.
+//
+// Get current user permissions.
+func (c *Client) GetCurrentUserPermissions(ctx context.Context, params GetCurrentUserPermissionsParams) ([]Permission, *http.Response, error) {
+	path := "/users/current/permissions"
+	query := url.Values{}
+	if params.Scope != "" {
+		query.Set("scope", params.Scope)
+	}
+	if params.Relative != nil {
+		query.Set("relative", strconv.FormatBool(*params.Relative))
+	}
+	var out []Permission
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// SearchUsersParams holds the parameters of SearchUsers.
+type SearchUsersParams struct {
+	// The page number
+	Page int
+	// The size of per page
+	PageSize int
+	// Username for filtering results.
+	Username string
+}
+
+// SearchUsers sends GET /users/search.
+//
+// Search users by username
+func (c *Client) SearchUsers(ctx context.Context, params SearchUsersParams) ([]UserSearchRespItem, *http.Response, error) {
+	path := "/users/search"
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(params.PageSize))
+	}
+	query.Set("username", params.Username)
+	var out []UserSearchRespItem
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	return out, resp, err
+}
+
+// GetUserParams holds the parameters of GetUser.
+type GetUserParams struct {
+	UserID int
+}
+
+// GetUser sends GET /users/{user_id}.
+//
+// Get a user's profile.
+func (c *Client) GetUser(ctx context.Context, params GetUserParams) (*UserResp, *http.Response, error) {
+	path := "/users/" + strconv.Itoa(params.UserID)
+	query := url.Values{}
+	var out UserResp
+	resp, err := c.do(ctx, http.MethodGet, path, query, nil, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// UpdateUserProfileParams holds the parameters of UpdateUserProfile.
+type UpdateUserProfileParams struct {
+	// Registered user ID
+	UserID int
+	// Only email, realname and comment can be modified.
+	Body UserProfile
+}
+
+// UpdateUserProfile sends PUT /users/{user_id}.
+//
+// Update user's profile.
+func (c *Client) UpdateUserProfile(ctx context.Context, params UpdateUserProfileParams) (*http.Response, error) {
+	path := "/users/" + strconv.Itoa(params.UserID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// DeleteUserParams holds the parameters of DeleteUser.
+type DeleteUserParams struct {
+	// User ID for marking as to be removed.
+	UserID int
+}
+
+// DeleteUser sends DELETE /users/{user_id}.
+//
+// Mark a registered user as be removed.
+func (c *Client) DeleteUser(ctx context.Context, params DeleteUserParams) (*http.Response, error) {
+	path := "/users/" + strconv.Itoa(params.UserID)
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, path, query, nil, nil)
+}
+
+// SetCLISecretParams holds the parameters of SetCLISecret.
+type SetCLISecretParams struct {
+	// User ID
+	UserID int
+	Body   OIDCCliSecretReq
+}
+
+// SetCLISecret sends PUT /users/{user_id}/cli_secret.
+//
+// Set CLI secret for a user.
+func (c *Client) SetCLISecret(ctx context.Context, params SetCLISecretParams) (*http.Response, error) {
+	path := "/users/" + strconv.Itoa(params.UserID) + "/cli_secret"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// UpdateUserPasswordParams holds the parameters of UpdateUserPassword.
+type UpdateUserPasswordParams struct {
+	UserID int
+	// Password to be updated, the attribute 'old_password' is optional when the API is called by the system administrator.
+	Body PasswordReq
+}
+
+// UpdateUserPassword sends PUT /users/{user_id}/password.
+//
+// Change the password on a user that already exists.
+func (c *Client) UpdateUserPassword(ctx context.Context, params UpdateUserPasswordParams) (*http.Response, error) {
+	path := "/users/" + strconv.Itoa(params.UserID) + "/password"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
+
+// SetUserSysAdminParams holds the parameters of SetUserSysAdmin.
+type SetUserSysAdminParams struct {
+	UserID int
+	// Toggle a user to admin or not.
+	Body UserSysAdminFlag
+}
+
+// SetUserSysAdmin sends PUT /users/{user_id}/sysadmin.
+//
+// Update a registered user to change to be an administrator of Harbor.
+func (c *Client) SetUserSysAdmin(ctx context.Context, params SetUserSysAdminParams) (*http.Response, error) {
+	path := "/users/" + strconv.Itoa(params.UserID) + "/sysadmin"
+	query := url.Values{}
+	return c.do(ctx, http.MethodPut, path, query, params.Body, nil)
+}
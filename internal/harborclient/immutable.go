@@ -2,45 +2,38 @@ package harborclient
 
 import (
 	"context"
-	"fmt"
-)
+	"net/http"
 
-// ImmutableSelector defines an immutable tag selector.
-type ImmutableSelector struct {
-	Kind       string `json:"kind,omitempty"`
-	Decoration string `json:"decoration,omitempty"`
-	Pattern    string `json:"pattern,omitempty"`
-	Extras     string `json:"extras,omitempty"`
-}
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
+)
 
-// ImmutableRule represents an immutable tag rule.
-type ImmutableRule struct {
-	ID             int                            `json:"id,omitempty"`
-	Priority       int                            `json:"priority,omitempty"`
-	Disabled       bool                           `json:"disabled,omitempty"`
-	Action         string                         `json:"action,omitempty"`
-	Template       string                         `json:"template,omitempty"`
-	Params         map[string]any                 `json:"params,omitempty"`
-	TagSelectors   []ImmutableSelector            `json:"tag_selectors,omitempty"`
-	ScopeSelectors map[string][]ImmutableSelector `json:"scope_selectors,omitempty"`
-}
+type (
+	// ImmutableSelector defines an immutable tag selector.
+	ImmutableSelector = harborapi.ImmutableSelector
+	// ImmutableRule represents an immutable tag rule.
+	ImmutableRule = harborapi.ImmutableRule
+)
 
 // ListImmutableRules lists immutable tag rules for a project.
 func (c *Client) ListImmutableRules(ctx context.Context, projectRef string) ([]ImmutableRule, error) {
-	return getPaged[ImmutableRule](ctx, c, fmt.Sprintf("/api/v2.0/projects/%s/immutabletagrules", projectRef), nil)
+	return listAll(func(page, pageSize int) ([]ImmutableRule, *http.Response, error) {
+		return c.API().ListImmuRules(ctx, harborapi.ListImmuRulesParams{ProjectNameOrID: projectRef, Page: page, PageSize: pageSize})
+	})
 }
 
 // CreateImmutableRule creates a new immutable tag rule.
 func (c *Client) CreateImmutableRule(ctx context.Context, projectRef string, in ImmutableRule) error {
-	return c.post(ctx, fmt.Sprintf("/api/v2.0/projects/%s/immutabletagrules", projectRef), &in, nil)
+	_, err := c.API().CreateImmuRule(ctx, harborapi.CreateImmuRuleParams{ProjectNameOrID: projectRef, Body: in})
+	return err
 }
 
 // UpdateImmutableRule updates an immutable tag rule.
 func (c *Client) UpdateImmutableRule(ctx context.Context, projectRef string, id int, in ImmutableRule) error {
-	return c.put(ctx, fmt.Sprintf("/api/v2.0/projects/%s/immutabletagrules/%d", projectRef, id), &in)
+	_, err := c.API().UpdateImmuRule(ctx, harborapi.UpdateImmuRuleParams{ProjectNameOrID: projectRef, ImmutableRuleID: id, Body: in})
+	return err
 }
 
 // DeleteImmutableRule deletes an immutable tag rule.
 func (c *Client) DeleteImmutableRule(ctx context.Context, projectRef string, id int) error {
-	return c.deleteIgnoringNotFound(ctx, fmt.Sprintf("/api/v2.0/projects/%s/immutabletagrules/%d", projectRef, id))
+	return deleted(c.API().DeleteImmuRule(ctx, harborapi.DeleteImmuRuleParams{ProjectNameOrID: projectRef, ImmutableRuleID: id}))
 }
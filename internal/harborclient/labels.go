@@ -2,56 +2,43 @@ package harborclient
 
 import (
 	"context"
-	"fmt"
-	"net/url"
-	"strconv"
+	"net/http"
+
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
 )
 
 // Label represents a Harbor label.
-type Label struct {
-	ID           int    `json:"id,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Description  string `json:"description,omitempty"`
-	Color        string `json:"color,omitempty"`
-	Scope        string `json:"scope,omitempty"`
-	ProjectID    int    `json:"project_id,omitempty"`
-	CreationTime string `json:"creation_time,omitempty"`
-	UpdateTime   string `json:"update_time,omitempty"`
-}
+type Label = harborapi.Label
 
 // ListLabels lists labels with optional filters.
 func (c *Client) ListLabels(ctx context.Context, name, scope string, projectID *int) ([]Label, error) {
-	values := url.Values{}
-	if name != "" {
-		values.Set("name", name)
-	}
-	if scope != "" {
-		values.Set("scope", scope)
-	}
+	params := harborapi.ListLabelsParams{Name: name, Scope: scope}
 	if projectID != nil {
-		values.Set("project_id", strconv.Itoa(*projectID))
+		params.ProjectID = *projectID
 	}
-	return getPaged[Label](ctx, c, "/api/v2.0/labels", values)
+	return listAll(func(page, pageSize int) ([]Label, *http.Response, error) {
+		params.Page, params.PageSize = page, pageSize
+		return c.API().ListLabels(ctx, params)
+	})
 }
 
 // GetLabel retrieves a label by ID.
 func (c *Client) GetLabel(ctx context.Context, id int) (*Label, error) {
-	var out Label
-	err := c.get(ctx, fmt.Sprintf("/api/v2.0/labels/%d", id), &out)
-	return &out, err
+	return result(c.API().GetLabelByID(ctx, harborapi.GetLabelByIDParams{LabelID: id}))
 }
 
 // CreateLabel creates a label.
 func (c *Client) CreateLabel(ctx context.Context, in Label) (int, error) {
-	return c.createWithNumericLocationID(ctx, "/api/v2.0/labels", &in)
+	return createdID(c.API().CreateLabel(ctx, harborapi.CreateLabelParams{Body: in}))
 }
 
 // UpdateLabel updates a label.
 func (c *Client) UpdateLabel(ctx context.Context, id int, in Label) error {
-	return c.put(ctx, fmt.Sprintf("/api/v2.0/labels/%d", id), &in)
+	_, err := c.API().UpdateLabel(ctx, harborapi.UpdateLabelParams{LabelID: id, Body: in})
+	return err
 }
 
 // DeleteLabel deletes a label.
 func (c *Client) DeleteLabel(ctx context.Context, id int) error {
-	return c.deleteIgnoringNotFound(ctx, fmt.Sprintf("/api/v2.0/labels/%d", id))
+	return deleted(c.API().DeleteLabel(ctx, harborapi.DeleteLabelParams{LabelID: id}))
 }
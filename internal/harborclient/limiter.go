@@ -0,0 +1,112 @@
+package harborclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rkthtrifork/harbor-operator/internal/metrics"
+	"golang.org/x/time/rate"
+)
+
+// DefaultLimiterMaxWait is how long a request may queue for a Limiter before
+// it fails with a ThrottledError.
+const DefaultLimiterMaxWait = 2 * time.Second
+
+// Limiter bounds the concurrency and rate of requests that all clients of one
+// Harbor connection send. A nil Limiter does not limit anything.
+type Limiter struct {
+	name    string
+	slots   chan struct{}
+	rate    *rate.Limiter
+	maxWait time.Duration
+}
+
+// NewLimiter returns a limiter that allows at most maxInFlight concurrent
+// requests and requestsPerSecond sustained requests. A zero value disables
+// the corresponding limit. name labels the limiter metrics.
+func NewLimiter(name string, maxInFlight int, requestsPerSecond float64, burst int) *Limiter {
+	l := &Limiter{name: name, maxWait: DefaultLimiterMaxWait}
+	if maxInFlight > 0 {
+		l.slots = make(chan struct{}, maxInFlight)
+	}
+	if requestsPerSecond > 0 {
+		if burst < 1 {
+			burst = 1
+		}
+		l.rate = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	return l
+}
+
+// ThrottledError is returned when a request could not get through the
+// connection limiter within the maximum wait. The request was not sent, so
+// the caller should requeue and try again later instead of blocking.
+type ThrottledError struct {
+	Limiter string
+	Wait    time.Duration
+}
+
+// Error implements the error interface.
+func (e *ThrottledError) Error() string {
+	return fmt.Sprintf("harbor API requests for %s are throttled; gave up after waiting %s", e.Limiter, e.Wait)
+}
+
+// acquire waits for a request slot and returns the function that releases it.
+func (l *Limiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil || l.slots == nil && l.rate == nil {
+		return func() {}, nil
+	}
+
+	start := time.Now()
+	metrics.AddHarborLimiterQueueDepth(l.name, 1)
+	release, err := l.wait(ctx, start)
+	metrics.AddHarborLimiterQueueDepth(l.name, -1)
+	metrics.ObserveHarborLimiterWait(l.name, time.Since(start).Seconds())
+	return release, err
+}
+
+func (l *Limiter) wait(ctx context.Context, start time.Time) (func(), error) {
+	deadline := start.Add(l.maxWait)
+	timer := time.NewTimer(l.maxWait)
+	defer timer.Stop()
+
+	release := func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			release = func() { <-l.slots }
+		case <-timer.C:
+			return nil, &ThrottledError{Limiter: l.name, Wait: l.maxWait}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if l.rate != nil {
+		reservation := l.rate.Reserve()
+		delay := reservation.Delay()
+		if !reservation.OK() || time.Now().Add(delay).After(deadline) {
+			reservation.Cancel()
+			release()
+			return nil, &ThrottledError{Limiter: l.name, Wait: l.maxWait}
+		}
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				reservation.Cancel()
+				release()
+				return nil, ctx.Err()
+			}
+		}
+	}
+	return release, nil
+}
+
+// IsThrottled reports whether err came from a connection limiter.
+func IsThrottled(err error) bool {
+	var throttled *ThrottledError
+	return errors.As(err, &throttled)
+}
@@ -2,71 +2,54 @@ package harborclient
 
 import (
 	"context"
-	"fmt"
-)
-
-// MemberUser is the Harbor API payload for a user member.
-type MemberUser struct {
-	Username string `json:"username,omitempty"`
-}
-
-// MemberGroup is the Harbor API payload for a group member.
-type MemberGroup struct {
-	GroupName   string `json:"group_name,omitempty"`
-	GroupType   int    `json:"group_type,omitempty"`
-	LDAPGroupDN string `json:"ldap_group_dn,omitempty"`
-}
+	"net/http"
 
-// CreateMemberRequest is the payload for creating a project member.
-type CreateMemberRequest struct {
-	RoleID      int          `json:"role_id"`
-	MemberUser  *MemberUser  `json:"member_user,omitempty"`
-	MemberGroup *MemberGroup `json:"member_group,omitempty"`
-}
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
+)
 
-// ProjectMember represents the member object returned by Harbor.
-type ProjectMember struct {
-	ID         int    `json:"id"`
-	ProjectID  int    `json:"project_id"`
-	EntityName string `json:"entity_name"`
-	EntityType string `json:"entity_type"` // "u" for user, "g" for group
-	EntityID   int    `json:"entity_id"`
-	RoleID     int    `json:"role_id"`
-	RoleName   string `json:"role_name"`
-}
+type (
+	// MemberUser is the Harbor API payload for a user member.
+	MemberUser = harborapi.UserEntity
+	// MemberGroup is the Harbor API payload for a group member.
+	MemberGroup = harborapi.UserGroup
+	// CreateMemberRequest is the payload for creating a project member.
+	CreateMemberRequest = harborapi.ProjectMember
+	// ProjectMember represents the member object returned by Harbor.
+	// EntityType is "u" for users and "g" for groups.
+	ProjectMember = harborapi.ProjectMemberEntity
+)
 
 // ListProjectMembers lists all members of a Harbor project.
 // Harbor accepts a project path segment here; controllers pass the resolved project name.
 func (c *Client) ListProjectMembers(ctx context.Context, projectRef string) ([]ProjectMember, error) {
-	return getPaged[ProjectMember](ctx, c, fmt.Sprintf("/api/v2.0/projects/%s/members", projectRef), nil)
+	return listAll(func(page, pageSize int) ([]ProjectMember, *http.Response, error) {
+		return c.API().ListProjectMembers(ctx, harborapi.ListProjectMembersParams{ProjectNameOrID: projectRef, Page: page, PageSize: pageSize})
+	})
 }
 
 // CreateProjectMember creates a new project member.
 // Returns the numeric member ID parsed from the Location header, if present.
 func (c *Client) CreateProjectMember(ctx context.Context, projectRef string, in CreateMemberRequest) (int, error) {
-	return c.createWithNumericLocationID(ctx, fmt.Sprintf("/api/v2.0/projects/%s/members", projectRef), &in)
+	return createdID(c.API().CreateProjectMember(ctx, harborapi.CreateProjectMemberParams{ProjectNameOrID: projectRef, Body: &in}))
 }
 
 // GetProjectMember retrieves a specific project member by ID.
 func (c *Client) GetProjectMember(ctx context.Context, projectRef string, memberID int) (*ProjectMember, error) {
-	var m ProjectMember
-	err := c.get(ctx, fmt.Sprintf("/api/v2.0/projects/%s/members/%d", projectRef, memberID), &m)
-	return &m, err
+	return result(c.API().GetProjectMember(ctx, harborapi.GetProjectMemberParams{ProjectNameOrID: projectRef, Mid: memberID}))
 }
 
 // UpdateProjectMemberRole updates only the role_id of an existing project member.
 func (c *Client) UpdateProjectMemberRole(ctx context.Context, projectRef string, memberID int, roleID int) error {
-	body := struct {
-		RoleID int `json:"role_id"`
-	}{
-		RoleID: roleID,
-	}
-
-	return c.put(ctx, fmt.Sprintf("/api/v2.0/projects/%s/members/%d", projectRef, memberID), &body)
+	_, err := c.API().UpdateProjectMember(ctx, harborapi.UpdateProjectMemberParams{
+		ProjectNameOrID: projectRef,
+		Mid:             memberID,
+		Body:            &harborapi.RoleRequest{RoleID: roleID},
+	})
+	return err
 }
 
 // DeleteProjectMember deletes a project member.
 // 404 is treated as success (already gone).
 func (c *Client) DeleteProjectMember(ctx context.Context, projectRef string, memberID int) error {
-	return c.deleteIgnoringNotFound(ctx, fmt.Sprintf("/api/v2.0/projects/%s/members/%d", projectRef, memberID))
+	return deleted(c.API().DeleteProjectMember(ctx, harborapi.DeleteProjectMemberParams{ProjectNameOrID: projectRef, Mid: memberID}))
 }
@@ -4,6 +4,8 @@ package harborclient
 import (
 	"context"
 	"net/http"
+
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
 )
 
 // Ping calls /​api/v2.0/ping. It returns nil when Harbor is reachable.
@@ -20,6 +22,6 @@ func (c *Client) Ping(ctx context.Context) error {
 // CheckAuthentication verifies credentials against an endpoint supported by
 // both user and robot security contexts.
 func (c *Client) CheckAuthentication(ctx context.Context) error {
-	var permissions []struct{}
-	return c.get(ctx, "/api/v2.0/users/current/permissions", &permissions)
+	_, _, err := c.API().GetCurrentUserPermissions(ctx, harborapi.GetCurrentUserPermissionsParams{})
+	return err
 }
@@ -2,45 +2,32 @@ package harborclient
 
 import (
 	"context"
-	"fmt"
-	"net/url"
+	"net/http"
+
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
 )
 
-// Quota represents a Harbor quota.
-type Quota struct {
-	ID           int              `json:"id,omitempty"`
-	Ref          map[string]any   `json:"ref,omitempty"`
-	Hard         map[string]int64 `json:"hard,omitempty"`
-	Used         map[string]int64 `json:"used,omitempty"`
-	CreationTime string           `json:"creation_time,omitempty"`
-	UpdateTime   string           `json:"update_time,omitempty"`
-}
+type (
+	// Quota represents a Harbor quota.
+	Quota = harborapi.Quota
+	// ResourceList maps quota resources to their limits.
+	ResourceList = harborapi.ResourceList
+)
 
 // ListQuotas lists quotas with optional reference filters.
 func (c *Client) ListQuotas(ctx context.Context, reference, referenceID string) ([]Quota, error) {
-	values := url.Values{}
-	if reference != "" {
-		values.Set("reference", reference)
-	}
-	if referenceID != "" {
-		values.Set("reference_id", referenceID)
-	}
-	return getPaged[Quota](ctx, c, "/api/v2.0/quotas", values)
+	return listAll(func(page, pageSize int) ([]Quota, *http.Response, error) {
+		return c.API().ListQuotas(ctx, harborapi.ListQuotasParams{Reference: reference, ReferenceID: referenceID, Page: page, PageSize: pageSize})
+	})
 }
 
 // GetQuota retrieves a quota by ID.
 func (c *Client) GetQuota(ctx context.Context, id int) (*Quota, error) {
-	var out Quota
-	err := c.get(ctx, fmt.Sprintf("/api/v2.0/quotas/%d", id), &out)
-	return &out, err
+	return result(c.API().GetQuota(ctx, harborapi.GetQuotaParams{ID: id}))
 }
 
 // UpdateQuota updates a quota's hard limits.
-func (c *Client) UpdateQuota(ctx context.Context, id int, hard map[string]int64) error {
-	body := struct {
-		Hard map[string]int64 `json:"hard,omitempty"`
-	}{
-		Hard: hard,
-	}
-	return c.put(ctx, fmt.Sprintf("/api/v2.0/quotas/%d", id), &body)
+func (c *Client) UpdateQuota(ctx context.Context, id int, hard ResourceList) error {
+	_, err := c.API().UpdateQuota(ctx, harborapi.UpdateQuotaParams{ID: id, Body: harborapi.QuotaUpdateReq{Hard: hard}})
+	return err
 }
@@ -2,55 +2,31 @@ package harborclient
 
 import (
 	"context"
-	"fmt"
-	"net/url"
-)
-
-type Registry struct {
-	ID            int                 `json:"id"`
-	URL           string              `json:"url"`
-	Name          string              `json:"name"`
-	Description   string              `json:"description"`
-	Type          string              `json:"type"`
-	Insecure      bool                `json:"insecure"`
-	CACertificate string              `json:"ca_certificate,omitempty"`
-	Credential    *RegistryCredential `json:"credential,omitempty"`
-}
+	"net/http"
 
-type RegistryCredential struct {
-	Type         string `json:"type,omitempty"`
-	AccessKey    string `json:"access_key,omitempty"`
-	AccessSecret string `json:"access_secret,omitempty"`
-}
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
+)
 
-type CreateRegistryRequest struct {
-	URL           string              `json:"url,omitempty"`
-	Name          string              `json:"name,omitempty"`
-	Description   string              `json:"description,omitempty"`
-	Type          string              `json:"type,omitempty"`
-	Insecure      bool                `json:"insecure,omitempty"`
-	CACertificate string              `json:"ca_certificate,omitempty"`
-	Credential    *RegistryCredential `json:"credential,omitempty"`
-}
+type (
+	Registry              = harborapi.Registry
+	RegistryCredential    = harborapi.RegistryCredential
+	CreateRegistryRequest = harborapi.Registry
+	UpdateRegistryRequest = harborapi.RegistryUpdate
+)
 
-type UpdateRegistryRequest struct {
-	Name           string `json:"name,omitempty"`
-	Description    string `json:"description,omitempty"`
-	URL            string `json:"url,omitempty"`
-	CredentialType string `json:"credential_type,omitempty"`
-	AccessKey      string `json:"access_key,omitempty"`
-	AccessSecret   string `json:"access_secret,omitempty"`
-	Insecure       bool   `json:"insecure,omitempty"`
-	CACertificate  string `json:"ca_certificate,omitempty"`
+func (c *Client) ListRegistries(ctx context.Context) ([]Registry, error) {
+	return listAll(func(page, pageSize int) ([]Registry, *http.Response, error) {
+		return c.API().ListRegistries(ctx, harborapi.ListRegistriesParams{Page: page, PageSize: pageSize})
+	})
 }
 
 func (c *Client) FindRegistryByName(ctx context.Context, name string) (*Registry, error) {
 	if name == "" {
 		return nil, nil
 	}
-	values := url.Values{}
-	values.Set("q", "name="+name)
-	regs, err := getPaged[Registry](ctx, c, "/api/v2.0/registries", values)
+	regs, err := listAll(func(page, pageSize int) ([]Registry, *http.Response, error) {
+		return c.API().ListRegistries(ctx, harborapi.ListRegistriesParams{Q: "name=" + name, Page: page, PageSize: pageSize})
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -63,19 +39,18 @@ func (c *Client) FindRegistryByName(ctx context.Context, name string) (*Registry
 }
 
 func (c *Client) GetRegistryByID(ctx context.Context, id int) (*Registry, error) {
-	var reg Registry
-	err := c.get(ctx, fmt.Sprintf("/api/v2.0/registries/%d", id), &reg)
-	return &reg, err
+	return result(c.API().GetRegistry(ctx, harborapi.GetRegistryParams{ID: id}))
 }
 
 func (c *Client) CreateRegistry(ctx context.Context, in CreateRegistryRequest) (int, error) {
-	return c.createWithNumericLocationID(ctx, "/api/v2.0/registries", &in)
+	return createdID(c.API().CreateRegistry(ctx, harborapi.CreateRegistryParams{Body: in}))
 }
 
 func (c *Client) UpdateRegistry(ctx context.Context, id int, in UpdateRegistryRequest) error {
-	return c.put(ctx, fmt.Sprintf("/api/v2.0/registries/%d", id), &in)
+	_, err := c.API().UpdateRegistry(ctx, harborapi.UpdateRegistryParams{ID: id, Body: in})
+	return err
 }
 
 func (c *Client) DeleteRegistry(ctx context.Context, id int) error {
-	return c.deleteIgnoringNotFound(ctx, fmt.Sprintf("/api/v2.0/registries/%d", id))
+	return deleted(c.API().DeleteRegistry(ctx, harborapi.DeleteRegistryParams{ID: id}))
 }
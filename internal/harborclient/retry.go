@@ -0,0 +1,149 @@
+package harborclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how transient Harbor failures are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 1 are treated as a single attempt.
+	MaxAttempts int
+	// BaseDelay is the backoff before the second attempt. Later attempts double
+	// it up to MaxDelay, and every delay is jittered.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff. A Retry-After longer than MaxDelay is not
+	// waited for; the error is returned to the caller instead.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy is used by clients built with New and NewWithHTTPClient.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   250 * time.Millisecond,
+	MaxDelay:    5 * time.Second,
+}
+
+func (c *Client) retryPolicy() RetryPolicy {
+	policy := c.Retry
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+	return policy
+}
+
+// delay returns the wait before the attempt following attempt. A negative
+// result means the retry must not be made.
+func (p RetryPolicy) delay(attempt int, retryAfter time.Duration) time.Duration {
+	backoff := p.BaseDelay
+	for i := 1; i < attempt && backoff < p.MaxDelay; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxDelay {
+		backoff = p.MaxDelay
+	}
+	if backoff > 0 {
+		// Equal jitter keeps at least half of the backoff while spreading
+		// retries from many reconcilers hitting the same Harbor.
+		backoff = backoff/2 + rand.N(backoff/2+1)
+	}
+	if retryAfter > 0 {
+		if retryAfter > p.MaxDelay {
+			return -1
+		}
+		if retryAfter > backoff {
+			return retryAfter
+		}
+	}
+	return backoff
+}
+
+// shouldRetry reports whether err is transient for method. Idempotent verbs
+// retry on gateway errors and dropped connections. POST and PATCH retry only
+// when Harbor cannot have acted on the request: the connection was refused,
+// or Harbor answered 429 or 503 before processing it.
+func shouldRetry(ctx context.Context, method string, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var he *HTTPError
+	if errors.As(err, &he) {
+		switch he.StatusCode {
+		case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+			return true
+		case http.StatusBadGateway, http.StatusGatewayTimeout:
+			return isIdempotent(method)
+		}
+		return false
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	if !isIdempotent(method) {
+		return false
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func retryAfter(err error) time.Duration {
+	var he *HTTPError
+	if errors.As(err, &he) {
+		return he.RetryAfter
+	}
+	return 0
+}
+
+// waitForRetry sleeps for delay. It returns false without waiting when the
+// delay is negative or would outlast the context deadline, and false when the
+// context is cancelled while waiting.
+func waitForRetry(ctx context.Context, delay time.Duration) bool {
+	if delay < 0 {
+		return false
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return false
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// parseRetryAfter accepts both forms of the Retry-After header: a number of
+// seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
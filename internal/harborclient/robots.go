@@ -2,103 +2,58 @@ package harborclient
 
 import (
 	"context"
-	"fmt"
-	"net/url"
+	"net/http"
 	"strconv"
-)
-
-// Robot represents a Harbor robot account.
-type Robot struct {
-	ID          int               `json:"id,omitempty"`
-	Name        string            `json:"name,omitempty"`
-	Description string            `json:"description,omitempty"`
-	Secret      string            `json:"secret,omitempty"`
-	Level       string            `json:"level,omitempty"`
-	Duration    *int              `json:"duration,omitempty"`
-	Editable    bool              `json:"editable,omitempty"`
-	Disable     bool              `json:"disable,omitempty"`
-	ExpiresAt   int               `json:"expires_at,omitempty"`
-	Permissions []RobotPermission `json:"permissions,omitempty"`
-}
-
-// RobotPermission defines access control for a robot account.
-type RobotPermission struct {
-	Kind      string   `json:"kind,omitempty"`
-	Namespace string   `json:"namespace,omitempty"`
-	Access    []Access `json:"access,omitempty"`
-}
-
-// Access defines a resource/action/effect tuple.
-type Access struct {
-	Resource string `json:"resource,omitempty"`
-	Action   string `json:"action,omitempty"`
-	Effect   string `json:"effect,omitempty"`
-}
 
-// RobotCreateRequest is the payload for robot account creation.
-type RobotCreateRequest struct {
-	Name        string            `json:"name,omitempty"`
-	Description string            `json:"description,omitempty"`
-	Secret      string            `json:"secret,omitempty"`
-	Level       string            `json:"level,omitempty"`
-	Disable     *bool             `json:"disable,omitempty"`
-	Duration    *int              `json:"duration,omitempty"`
-	Permissions []RobotPermission `json:"permissions,omitempty"`
-}
-
-// RobotCreated is the response for robot account creation.
-type RobotCreated struct {
-	ID         int    `json:"id,omitempty"`
-	Name       string `json:"name,omitempty"`
-	Secret     string `json:"secret,omitempty"`
-	ExpiresAt  int    `json:"expires_at,omitempty"`
-	CreateTime string `json:"creation_time,omitempty"`
-}
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
+)
 
-// RobotSec is the response for refreshing a robot secret.
-type RobotSec struct {
-	Secret string `json:"secret,omitempty"`
-}
+type (
+	// Robot represents a Harbor robot account.
+	Robot = harborapi.Robot
+	// RobotPermission defines access control for a robot account.
+	RobotPermission = harborapi.RobotPermission
+	// Access defines a resource/action/effect tuple.
+	Access = harborapi.Access
+	// RobotCreateRequest is the payload for robot account creation.
+	RobotCreateRequest = harborapi.RobotCreate
+	// RobotCreated is the response for robot account creation.
+	RobotCreated = harborapi.RobotCreated
+	// RobotSec is the response for refreshing a robot secret.
+	RobotSec = harborapi.RobotSec
+)
 
 // ListRobots lists robot accounts with an optional query.
 func (c *Client) ListRobots(ctx context.Context, query string) ([]Robot, error) {
-	values := url.Values{}
-	if query != "" {
-		values.Set("q", query)
-	}
-	return getPaged[Robot](ctx, c, "/api/v2.0/robots", values)
+	return listAll(func(page, pageSize int) ([]Robot, *http.Response, error) {
+		return c.API().ListRobot(ctx, harborapi.ListRobotParams{Q: query, Page: page, PageSize: pageSize})
+	})
 }
 
 // GetRobotByID retrieves a robot account by ID.
 func (c *Client) GetRobotByID(ctx context.Context, id int) (*Robot, error) {
-	var robot Robot
-	err := c.get(ctx, fmt.Sprintf("/api/v2.0/robots/%d", id), &robot)
-	return &robot, err
+	return result(c.API().GetRobotByID(ctx, harborapi.GetRobotByIDParams{RobotID: id}))
 }
 
 // CreateRobot creates a new robot account.
 func (c *Client) CreateRobot(ctx context.Context, in RobotCreateRequest) (*RobotCreated, error) {
-	var created RobotCreated
-	err := c.post(ctx, "/api/v2.0/robots", &in, &created)
-	return &created, err
+	return result(c.API().CreateRobot(ctx, harborapi.CreateRobotParams{Body: in}))
 }
 
 // UpdateRobot updates an existing robot account.
 func (c *Client) UpdateRobot(ctx context.Context, id int, in Robot) error {
-	return c.put(ctx, fmt.Sprintf("/api/v2.0/robots/%d", id), &in)
+	_, err := c.API().UpdateRobot(ctx, harborapi.UpdateRobotParams{RobotID: id, Body: in})
+	return err
 }
 
 // RefreshRobotSecret refreshes or sets a robot account secret.
 func (c *Client) RefreshRobotSecret(ctx context.Context, id int, secret string) (*RobotSec, error) {
-	var sec RobotSec
-	body := RobotSec{Secret: secret}
-	err := c.patch(ctx, fmt.Sprintf("/api/v2.0/robots/%d", id), &body, &sec)
-	return &sec, err
+	return result(c.API().RefreshSec(ctx, harborapi.RefreshSecParams{RobotID: id, Body: RobotSec{Secret: secret}}))
 }
 
 // DeleteRobot deletes a robot account.
 func (c *Client) DeleteRobot(ctx context.Context, id int) error {
-	return c.deleteIgnoringNotFound(ctx, fmt.Sprintf("/api/v2.0/robots/%d", id))
+	return deleted(c.API().DeleteRobot(ctx, harborapi.DeleteRobotParams{RobotID: id}))
 }
 
 // ParseRobotID converts string robot IDs to int.
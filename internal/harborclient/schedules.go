@@ -2,78 +2,74 @@ package harborclient
 
 import (
 	"context"
-	"fmt"
 	"strings"
-)
 
-type Schedule struct {
-	Schedule   ScheduleObj    `json:"schedule"`
-	Parameters map[string]any `json:"parameters,omitempty"`
-}
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
+)
 
-type ScheduleObj struct {
-	Type string `json:"type,omitempty"`
-	Cron string `json:"cron,omitempty"`
-}
+type (
+	Schedule    = harborapi.Schedule
+	ScheduleObj = harborapi.ScheduleObj
+)
 
-func (c *Client) GetGCSchedule(ctx context.Context) (*Schedule, error) {
-	var out Schedule
-	err := c.get(ctx, "/api/v2.0/system/gc/schedule", &out)
-	return &out, err
+func (c *Client) GetGCSchedule(ctx context.Context) (*harborapi.GCHistory, error) {
+	return result(c.API().GetGCSchedule(ctx))
 }
 
 func (c *Client) CreateGCSchedule(ctx context.Context, in Schedule) error {
-	return c.post(ctx, "/api/v2.0/system/gc/schedule", &in, nil)
+	_, err := c.API().CreateGCSchedule(ctx, harborapi.CreateGCScheduleParams{Body: in})
+	return err
 }
 
 func (c *Client) UpdateGCSchedule(ctx context.Context, in Schedule) error {
-	return c.put(ctx, "/api/v2.0/system/gc/schedule", &in)
+	_, err := c.API().UpdateGCSchedule(ctx, harborapi.UpdateGCScheduleParams{Body: in})
+	return err
 }
 
-func (c *Client) GetPurgeSchedule(ctx context.Context) (*Schedule, error) {
-	var out Schedule
-	err := c.get(ctx, "/api/v2.0/system/purgeaudit/schedule", &out)
-	return &out, err
+func (c *Client) GetPurgeSchedule(ctx context.Context) (*harborapi.ExecHistory, error) {
+	return result(c.API().GetPurgeSchedule(ctx))
 }
 
 func (c *Client) CreatePurgeSchedule(ctx context.Context, in Schedule) error {
-	return c.post(ctx, "/api/v2.0/system/purgeaudit/schedule", &in, nil)
+	_, err := c.API().CreatePurgeSchedule(ctx, harborapi.CreatePurgeScheduleParams{Body: in})
+	return err
 }
 
 func (c *Client) UpdatePurgeSchedule(ctx context.Context, in Schedule) error {
-	return c.put(ctx, "/api/v2.0/system/purgeaudit/schedule", &in)
+	_, err := c.API().UpdatePurgeSchedule(ctx, harborapi.UpdatePurgeScheduleParams{Body: in})
+	return err
 }
 
 func (c *Client) GetScanAllSchedule(ctx context.Context) (*Schedule, error) {
-	var out Schedule
-	err := c.get(ctx, "/api/v2.0/system/scanAll/schedule", &out)
-	return &out, err
+	return result(c.API().GetScanAllSchedule(ctx))
 }
 
 func (c *Client) CreateScanAllSchedule(ctx context.Context, in Schedule) error {
-	return c.post(ctx, "/api/v2.0/system/scanAll/schedule", &in, nil)
+	_, err := c.API().CreateScanAllSchedule(ctx, harborapi.CreateScanAllScheduleParams{Body: in})
+	return err
 }
 
 func (c *Client) UpdateScanAllSchedule(ctx context.Context, in Schedule) error {
-	return c.put(ctx, "/api/v2.0/system/scanAll/schedule", &in)
+	_, err := c.API().UpdateScanAllSchedule(ctx, harborapi.UpdateScanAllScheduleParams{Body: in})
+	return err
 }
 
 func (c *Client) GetRetentionByID(ctx context.Context, id int) (*RetentionPolicy, error) {
-	var out RetentionPolicy
-	err := c.get(ctx, fmt.Sprintf("/api/v2.0/retentions/%d", id), &out)
-	return &out, err
+	return result(c.API().GetRetention(ctx, harborapi.GetRetentionParams{ID: id}))
 }
 
 func (c *Client) CreateRetention(ctx context.Context, in RetentionPolicy) (int, error) {
-	return c.createWithNumericLocationID(ctx, "/api/v2.0/retentions", &in)
+	return createdID(c.API().CreateRetention(ctx, harborapi.CreateRetentionParams{Body: in}))
 }
 
 func (c *Client) UpdateRetention(ctx context.Context, id int, in RetentionPolicy) error {
-	return c.put(ctx, fmt.Sprintf("/api/v2.0/retentions/%d", id), &in)
+	_, err := c.API().UpdateRetention(ctx, harborapi.UpdateRetentionParams{ID: id, Body: in})
+	return err
 }
 
 func (c *Client) DeleteRetention(ctx context.Context, id int) error {
-	return c.deleteIgnoringNotFound(ctx, fmt.Sprintf("/api/v2.0/retentions/%d", id), isRetentionGone)
+	resp, err := c.API().DeleteRetention(ctx, harborapi.DeleteRetentionParams{ID: id})
+	return deleted(resp, err, isRetentionGone)
 }
 
 func isRetentionGone(err error) bool {
@@ -87,39 +83,10 @@ func isRetentionGone(err error) bool {
 	return false
 }
 
-type RetentionPolicy struct {
-	ID        int               `json:"id,omitempty"`
-	Algorithm string            `json:"algorithm,omitempty"`
-	Rules     []RetentionRule   `json:"rules,omitempty"`
-	Trigger   *RetentionTrigger `json:"trigger,omitempty"`
-	Scope     *RetentionScope   `json:"scope,omitempty"`
-}
-
-type RetentionRule struct {
-	ID             int                            `json:"id,omitempty"`
-	Priority       int                            `json:"priority,omitempty"`
-	Disabled       bool                           `json:"disabled,omitempty"`
-	Action         string                         `json:"action,omitempty"`
-	Template       string                         `json:"template,omitempty"`
-	Params         any                            `json:"params,omitempty"`
-	TagSelectors   []RetentionSelector            `json:"tag_selectors,omitempty"`
-	ScopeSelectors map[string][]RetentionSelector `json:"scope_selectors,omitempty"`
-}
-
-type RetentionSelector struct {
-	Kind       string `json:"kind,omitempty"`
-	Decoration string `json:"decoration,omitempty"`
-	Pattern    string `json:"pattern,omitempty"`
-	Extras     string `json:"extras,omitempty"`
-}
-
-type RetentionTrigger struct {
-	Kind       string         `json:"kind,omitempty"`
-	Settings   map[string]any `json:"settings,omitempty"`
-	References map[string]any `json:"references,omitempty"`
-}
-
-type RetentionScope struct {
-	Level string `json:"level,omitempty"`
-	Ref   int    `json:"ref,omitempty"`
-}
+type (
+	RetentionPolicy   = harborapi.RetentionPolicy
+	RetentionRule     = harborapi.RetentionRule
+	RetentionSelector = harborapi.RetentionSelector
+	RetentionTrigger  = harborapi.RetentionRuleTrigger
+	RetentionScope    = harborapi.RetentionPolicyScope
+)
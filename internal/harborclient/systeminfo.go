@@ -0,0 +1,18 @@
+package harborclient
+
+import (
+	"context"
+
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
+)
+
+// SystemInfo is the general information returned by /api/v2.0/systeminfo.
+// Harbor omits the version and read-only flag for anonymous callers, so every
+// field is optional.
+type SystemInfo = harborapi.GeneralInfo
+
+// GetSystemInfo returns the general information of the Harbor instance.
+func (c *Client) GetSystemInfo(ctx context.Context) (*SystemInfo, error) {
+	info, _, err := c.API().GetSystemInfo(ctx)
+	return info, err
+}
@@ -2,22 +2,22 @@ package harborclient
 
 import (
 	"context"
+	"net/http"
+
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
 )
 
 // UserGroup represents a Harbor user group.
-type UserGroup struct {
-	ID          int    `json:"id,omitempty"`
-	GroupName   string `json:"group_name,omitempty"`
-	GroupType   int    `json:"group_type,omitempty"`
-	LDAPGroupDN string `json:"ldap_group_dn,omitempty"`
-}
+type UserGroup = harborapi.UserGroup
 
 // ListUserGroups lists all user groups.
 func (c *Client) ListUserGroups(ctx context.Context) ([]UserGroup, error) {
-	return getPaged[UserGroup](ctx, c, "/api/v2.0/usergroups", nil)
+	return listAll(func(page, pageSize int) ([]UserGroup, *http.Response, error) {
+		return c.API().ListUserGroups(ctx, harborapi.ListUserGroupsParams{Page: page, PageSize: pageSize})
+	})
 }
 
 // CreateUserGroup creates a user group.
 func (c *Client) CreateUserGroup(ctx context.Context, in UserGroup) (int, error) {
-	return c.createWithNumericLocationID(ctx, "/api/v2.0/usergroups", &in)
+	return createdID(c.API().CreateUserGroup(ctx, harborapi.CreateUserGroupParams{Body: &in}))
 }
@@ -2,55 +2,40 @@ package harborclient
 
 import (
 	"context"
-	"fmt"
-	"net/url"
-)
-
-type User struct {
-	UserID       int    `json:"user_id"`
-	Username     string `json:"username"`
-	Email        string `json:"email"`
-	Realname     string `json:"realname"`
-	Comment      string `json:"comment"`
-	SysadminFlag bool   `json:"sysadmin_flag"`
-}
+	"net/http"
 
-type CreateUserRequest struct {
-	Email    string `json:"email,omitempty"`
-	Realname string `json:"realname,omitempty"`
-	Comment  string `json:"comment,omitempty"`
-	Password string `json:"password,omitempty"`
-	Username string `json:"username,omitempty"`
-}
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
+)
 
-type UpdateUserRequest struct {
-	Email    string `json:"email,omitempty"`
-	Realname string `json:"realname,omitempty"`
-	Comment  string `json:"comment,omitempty"`
-}
+type (
+	User              = harborapi.UserResp
+	CreateUserRequest = harborapi.UserCreationReq
+	UpdateUserRequest = harborapi.UserProfile
+)
 
 func (c *Client) ListUsers(ctx context.Context, query string) ([]User, error) {
-	values := url.Values{}
-	if query != "" {
-		values.Set("q", query)
-	}
-	return getPaged[User](ctx, c, "/api/v2.0/users", values)
+	return listAll(func(page, pageSize int) ([]User, *http.Response, error) {
+		return c.API().ListUsers(ctx, harborapi.ListUsersParams{Q: query, Page: page, PageSize: pageSize})
+	})
 }
 
 func (c *Client) GetUserByID(ctx context.Context, id int) (User, error) {
-	var u User
-	err := c.get(ctx, fmt.Sprintf("/api/v2.0/users/%d", id), &u)
-	return u, err
+	u, _, err := c.API().GetUser(ctx, harborapi.GetUserParams{UserID: id})
+	if err != nil {
+		return User{}, err
+	}
+	return *u, nil
 }
 
 func (c *Client) CreateUser(ctx context.Context, in CreateUserRequest) (int, error) {
-	return c.createWithNumericLocationID(ctx, "/api/v2.0/users", &in)
+	return createdID(c.API().CreateUser(ctx, harborapi.CreateUserParams{Body: in}))
 }
 
-func (c *Client) UpdateUser(ctx context.Context, id int, in CreateUserRequest) error {
-	return c.put(ctx, fmt.Sprintf("/api/v2.0/users/%d", id), &in)
+func (c *Client) UpdateUser(ctx context.Context, id int, in UpdateUserRequest) error {
+	_, err := c.API().UpdateUserProfile(ctx, harborapi.UpdateUserProfileParams{UserID: id, Body: in})
+	return err
 }
 
 func (c *Client) DeleteUser(ctx context.Context, id int) error {
-	return c.deleteIgnoringNotFound(ctx, fmt.Sprintf("/api/v2.0/users/%d", id))
+	return deleted(c.API().DeleteUser(ctx, harborapi.DeleteUserParams{UserID: id}))
 }
@@ -0,0 +1,449 @@
+package harborfake
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
+)
+
+func (s *Server) routeAccounts() {
+	s.handle(http.MethodGet, "/users", s.listUsers)
+	s.handle(http.MethodPost, "/users", s.createUser)
+	s.handle(http.MethodGet, "/users/current", s.getCurrentUser)
+	s.handle(http.MethodGet, "/users/{user_id}", s.getUser)
+	s.handle(http.MethodPut, "/users/{user_id}", s.updateUser)
+	s.handle(http.MethodDelete, "/users/{user_id}", s.deleteUser)
+
+	s.handle(http.MethodGet, "/usergroups", s.listGroups)
+	s.handle(http.MethodPost, "/usergroups", s.createGroup)
+	s.handle(http.MethodGet, "/usergroups/{group_id}", s.getGroup)
+	s.handle(http.MethodPut, "/usergroups/{group_id}", s.updateGroup)
+	s.handle(http.MethodDelete, "/usergroups/{group_id}", s.deleteGroup)
+
+	s.handle(http.MethodGet, "/robots", s.listRobots)
+	s.handle(http.MethodPost, "/robots", s.createRobot)
+	s.handle(http.MethodGet, "/robots/{robot_id}", s.getRobot)
+	s.handle(http.MethodPut, "/robots/{robot_id}", s.updateRobot)
+	s.handle(http.MethodPatch, "/robots/{robot_id}", s.refreshRobotSecret)
+	s.handle(http.MethodDelete, "/robots/{robot_id}", s.deleteRobot)
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	items, ok := filter(w, r, s.users.all(), func(user harborapi.UserResp) map[string]string {
+		return map[string]string{"username": user.Username, "email": user.Email}
+	})
+	if ok {
+		writePage(w, r, items)
+	}
+}
+
+func (s *Server) userConflict(username, email string, id int) bool {
+	_, exists := s.users.find(func(user harborapi.UserResp) bool {
+		return user.UserID != id && (user.Username == username || email != "" && user.Email == email)
+	})
+	return exists
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	var in harborapi.UserCreationReq
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Username == "" || in.Password == "" {
+		writeError(w, http.StatusBadRequest, "username and password are required")
+		return
+	}
+	if s.userConflict(in.Username, in.Email, 0) {
+		writeError(w, http.StatusConflict, "username or email already exists")
+		return
+	}
+	id := s.users.insert(func(id int) harborapi.UserResp {
+		return harborapi.UserResp{
+			UserID:       id,
+			Username:     in.Username,
+			Email:        in.Email,
+			Realname:     in.Realname,
+			Comment:      in.Comment,
+			CreationTime: s.now(),
+			UpdateTime:   s.now(),
+		}
+	})
+	writeCreated(w, "/users/"+strconv.Itoa(id))
+}
+
+func (s *Server) getCurrentUser(w http.ResponseWriter, r *http.Request) {
+	username := s.username(r)
+	id, ok := s.users.find(func(user harborapi.UserResp) bool { return user.Username == username })
+	if !ok {
+		writeError(w, http.StatusNotFound, "user %s not found", username)
+		return
+	}
+	user, _ := s.users.get(id)
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) userID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, ok := pathID(w, r, "user_id")
+	if !ok {
+		return 0, false
+	}
+	if !s.users.has(id) {
+		writeError(w, http.StatusNotFound, "user %d not found", id)
+		return 0, false
+	}
+	return id, true
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.userID(w, r); ok {
+		user, _ := s.users.get(id)
+		writeJSON(w, http.StatusOK, user)
+	}
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.userID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.UserProfile
+	if !decode(w, r, &in) {
+		return
+	}
+	user, _ := s.users.get(id)
+	if s.userConflict(user.Username, in.Email, id) {
+		writeError(w, http.StatusConflict, "email %s already exists", in.Email)
+		return
+	}
+	user.Email, user.Realname, user.Comment = in.Email, in.Realname, in.Comment
+	user.UpdateTime = s.now()
+	s.users.put(id, user)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.userID(w, r)
+	if !ok {
+		return
+	}
+	if user, _ := s.users.get(id); user.Username == AdminUsername {
+		writeError(w, http.StatusForbidden, "the admin user cannot be deleted")
+		return
+	}
+	s.users.delete(id)
+	for memberID, member := range s.members.rows {
+		if member.EntityType == "u" && member.EntityID == id {
+			s.members.delete(memberID)
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var items []harborapi.UserGroup
+	for _, group := range s.groups.all() {
+		if name := query.Get("group_name"); name != "" && !strings.Contains(group.GroupName, name) {
+			continue
+		}
+		if dn := query.Get("ldap_group_dn"); dn != "" && group.LDAPGroupDN != dn {
+			continue
+		}
+		items = append(items, group)
+	}
+	writePage(w, r, items)
+}
+
+func (s *Server) groupConflict(in harborapi.UserGroup, id int) bool {
+	_, exists := s.groups.find(func(group harborapi.UserGroup) bool {
+		return group.ID != id && group.GroupType == in.GroupType && group.GroupName == in.GroupName && group.LDAPGroupDN == in.LDAPGroupDN
+	})
+	return exists
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) {
+	var in harborapi.UserGroup
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.GroupName == "" {
+		writeError(w, http.StatusBadRequest, "group_name is required")
+		return
+	}
+	if s.groupConflict(in, 0) {
+		writeError(w, http.StatusConflict, "user group %s already exists", in.GroupName)
+		return
+	}
+	id := s.groups.insert(func(id int) harborapi.UserGroup {
+		in.ID = id
+		return in
+	})
+	writeCreated(w, "/usergroups/"+strconv.Itoa(id))
+}
+
+func (s *Server) groupID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, ok := pathID(w, r, "group_id")
+	if !ok {
+		return 0, false
+	}
+	if !s.groups.has(id) {
+		writeError(w, http.StatusNotFound, "user group %d not found", id)
+		return 0, false
+	}
+	return id, true
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.groupID(w, r); ok {
+		group, _ := s.groups.get(id)
+		writeJSON(w, http.StatusOK, group)
+	}
+}
+
+// updateGroup only renames the group; Harbor ignores the other fields.
+func (s *Server) updateGroup(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.groupID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.UserGroup
+	if !decode(w, r, &in) {
+		return
+	}
+	group, _ := s.groups.get(id)
+	group.GroupName = in.GroupName
+	if s.groupConflict(group, id) {
+		writeError(w, http.StatusConflict, "user group %s already exists", in.GroupName)
+		return
+	}
+	s.groups.put(id, group)
+	for memberID, member := range s.members.rows {
+		if member.EntityType == "g" && member.EntityID == id {
+			member.EntityName = group.GroupName
+			s.members.put(memberID, member)
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.groupID(w, r)
+	if !ok {
+		return
+	}
+	s.groups.delete(id)
+	for memberID, member := range s.members.rows {
+		if member.EntityType == "g" && member.EntityID == id {
+			s.members.delete(memberID)
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// robotName returns the full name Harbor gives a robot: the configured prefix,
+// then "<project>+" for project robots, then the requested name.
+func (s *Server) robotName(level, project, name string) string {
+	if level == "project" {
+		return s.configurationString("robot_name_prefix") + project + "+" + name
+	}
+	return s.configurationString("robot_name_prefix") + name
+}
+
+// robotProject returns the project of a project robot's full name.
+func robotProject(fullName string) string {
+	_, rest, _ := strings.Cut(fullName, "$")
+	project, _, ok := strings.Cut(rest, "+")
+	if !ok {
+		return ""
+	}
+	return project
+}
+
+// robotShortName strips the prefix and project from a robot's full name,
+// which is the name the q parameter matches against.
+func robotShortName(fullName string) string {
+	_, rest, _ := strings.Cut(fullName, "$")
+	if _, name, ok := strings.Cut(rest, "+"); ok {
+		return name
+	}
+	return rest
+}
+
+func newSecret() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// robotExpiry returns the expires_at Harbor reports for a robot with the
+// given duration in days. -1 never expires.
+func (s *Server) robotExpiry(duration int) int {
+	if duration == -1 {
+		return -1
+	}
+	return int(s.opts.Now().Unix()) + duration*24*60*60
+}
+
+func (s *Server) listRobots(w http.ResponseWriter, r *http.Request) {
+	items, ok := filter(w, r, s.robots.all(), func(robot harborapi.Robot) map[string]string {
+		return map[string]string{
+			"name":  robotShortName(robot.Name),
+			"level": robot.Level,
+		}
+	})
+	if ok {
+		writePage(w, r, items)
+	}
+}
+
+// createRobot validates the level against the permissions like Harbor does:
+// a project robot has exactly one permission, naming an existing project.
+func (s *Server) createRobot(w http.ResponseWriter, r *http.Request) {
+	var in harborapi.RobotCreate
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Name == "" || len(in.Permissions) == 0 {
+		writeError(w, http.StatusBadRequest, "name and permissions are required")
+		return
+	}
+	var project string
+	switch in.Level {
+	case "system":
+	case "project":
+		if len(in.Permissions) != 1 || in.Permissions[0].Kind != "project" {
+			writeError(w, http.StatusBadRequest, "a project robot needs exactly one project permission")
+			return
+		}
+		project = in.Permissions[0].Namespace
+		if _, ok := s.projects.find(func(p harborapi.Project) bool { return p.Name == project }); !ok {
+			writeError(w, http.StatusBadRequest, "project %s not found", project)
+			return
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "invalid level %q", in.Level)
+		return
+	}
+	name := s.robotName(in.Level, project, in.Name)
+	if _, exists := s.robots.find(func(robot harborapi.Robot) bool { return robot.Name == name }); exists {
+		writeError(w, http.StatusConflict, "robot account %s already exists", name)
+		return
+	}
+
+	secret := in.Secret
+	if secret == "" {
+		secret = newSecret()
+	}
+	duration := in.Duration
+	id := s.robots.insert(func(id int) harborapi.Robot {
+		return harborapi.Robot{
+			ID:           id,
+			Name:         name,
+			Description:  in.Description,
+			Level:        in.Level,
+			Disable:      in.Disable,
+			Duration:     &duration,
+			ExpiresAt:    s.robotExpiry(duration),
+			Editable:     true,
+			Permissions:  in.Permissions,
+			CreationTime: s.now(),
+			UpdateTime:   s.now(),
+		}
+	})
+	s.robotSecrets[id] = secret
+	robot, _ := s.robots.get(id)
+
+	w.Header().Set("Location", basePath+"/robots/"+strconv.Itoa(id))
+	writeJSON(w, http.StatusCreated, harborapi.RobotCreated{
+		ID:           id,
+		Name:         name,
+		Secret:       secret,
+		CreationTime: robot.CreationTime,
+		ExpiresAt:    robot.ExpiresAt,
+	})
+}
+
+func (s *Server) robotID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, ok := pathID(w, r, "robot_id")
+	if !ok {
+		return 0, false
+	}
+	if !s.robots.has(id) {
+		writeError(w, http.StatusNotFound, "robot %d not found", id)
+		return 0, false
+	}
+	return id, true
+}
+
+func (s *Server) getRobot(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.robotID(w, r); ok {
+		robot, _ := s.robots.get(id)
+		writeJSON(w, http.StatusOK, robot)
+	}
+}
+
+// updateRobot changes the mutable fields. The name and level cannot change.
+func (s *Server) updateRobot(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.robotID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.Robot
+	if !decode(w, r, &in) {
+		return
+	}
+	robot, _ := s.robots.get(id)
+	if in.Name != robot.Name || in.Level != robot.Level {
+		writeError(w, http.StatusBadRequest, "the name and level of a robot cannot change")
+		return
+	}
+	robot.Description, robot.Disable, robot.Permissions = in.Description, in.Disable, in.Permissions
+	if in.Duration != nil && (robot.Duration == nil || *in.Duration != *robot.Duration) {
+		duration := *in.Duration
+		robot.Duration = &duration
+		robot.ExpiresAt = s.robotExpiry(duration)
+	}
+	robot.UpdateTime = s.now()
+	s.robots.put(id, robot)
+	w.WriteHeader(http.StatusOK)
+}
+
+// refreshRobotSecret sets the secret from the body, or generates one when the
+// body has none, and returns it.
+func (s *Server) refreshRobotSecret(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.robotID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.RobotSec
+	if !decode(w, r, &in) {
+		return
+	}
+	secret := in.Secret
+	if secret == "" {
+		secret = newSecret()
+	}
+	s.robotSecrets[id] = secret
+	writeJSON(w, http.StatusOK, harborapi.RobotSec{Secret: secret})
+}
+
+func (s *Server) deleteRobot(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.robotID(w, r); ok {
+		s.robots.delete(id)
+		delete(s.robotSecrets, id)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// RobotSecret returns the current secret of a robot, for asserting that a
+// rotation reached Harbor.
+func (s *Server) RobotSecret(id int) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	secret, ok := s.robotSecrets[id]
+	return secret, ok
+}
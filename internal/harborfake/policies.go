@@ -0,0 +1,412 @@
+package harborfake
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
+)
+
+func (s *Server) routePolicies() {
+	s.handle(http.MethodPost, "/retentions", s.createRetention)
+	s.handle(http.MethodGet, "/retentions/{id}", s.getRetention)
+	s.handle(http.MethodPut, "/retentions/{id}", s.updateRetention)
+	s.handle(http.MethodDelete, "/retentions/{id}", s.deleteRetention)
+
+	s.handle(http.MethodGet, "/projects/{project_name_or_id}/immutabletagrules", s.listImmutableRules)
+	s.handle(http.MethodPost, "/projects/{project_name_or_id}/immutabletagrules", s.createImmutableRule)
+	s.handle(http.MethodPut, "/projects/{project_name_or_id}/immutabletagrules/{immutable_rule_id}", s.updateImmutableRule)
+	s.handle(http.MethodDelete, "/projects/{project_name_or_id}/immutabletagrules/{immutable_rule_id}", s.deleteImmutableRule)
+
+	s.handle(http.MethodGet, "/projects/{project_name_or_id}/webhook/policies", s.listWebhooks)
+	s.handle(http.MethodPost, "/projects/{project_name_or_id}/webhook/policies", s.createWebhook)
+	s.handle(http.MethodGet, "/projects/{project_name_or_id}/webhook/policies/{webhook_policy_id}", s.getWebhook)
+	s.handle(http.MethodPut, "/projects/{project_name_or_id}/webhook/policies/{webhook_policy_id}", s.updateWebhook)
+	s.handle(http.MethodDelete, "/projects/{project_name_or_id}/webhook/policies/{webhook_policy_id}", s.deleteWebhook)
+
+	s.handle(http.MethodGet, "/replication/policies", s.listReplications)
+	s.handle(http.MethodPost, "/replication/policies", s.createReplication)
+	s.handle(http.MethodGet, "/replication/policies/{id}", s.getReplication)
+	s.handle(http.MethodPut, "/replication/policies/{id}", s.updateReplication)
+	s.handle(http.MethodDelete, "/replication/policies/{id}", s.deleteReplication)
+}
+
+// retentionProject returns the project a retention policy is scoped to.
+func (s *Server) retentionProject(w http.ResponseWriter, policy harborapi.RetentionPolicy) (int, bool) {
+	if policy.Scope.Level != "project" || !s.projects.has(policy.Scope.Ref) {
+		writeError(w, http.StatusBadRequest, "retention scope must reference an existing project")
+		return 0, false
+	}
+	return policy.Scope.Ref, true
+}
+
+// createRetention stores the policy and links it from the project's
+// retention_id metadata. Harbor allows one policy per project.
+func (s *Server) createRetention(w http.ResponseWriter, r *http.Request) {
+	var in harborapi.RetentionPolicy
+	if !decode(w, r, &in) {
+		return
+	}
+	projectID, ok := s.retentionProject(w, in)
+	if !ok {
+		return
+	}
+	project, _ := s.projects.get(projectID)
+	if project.Metadata.RetentionID != nil {
+		writeError(w, http.StatusConflict, "project %d already has a retention policy", projectID)
+		return
+	}
+	id := s.retentions.insert(func(id int) harborapi.RetentionPolicy {
+		in.ID = id
+		return in
+	})
+	retentionID := strconv.Itoa(id)
+	project.Metadata.RetentionID = &retentionID
+	s.projects.put(projectID, project)
+	writeCreated(w, "/retentions/"+retentionID)
+}
+
+func (s *Server) retentionID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return 0, false
+	}
+	if !s.retentions.has(id) {
+		writeError(w, http.StatusNotFound, "retention policy %d not found", id)
+		return 0, false
+	}
+	return id, true
+}
+
+func (s *Server) getRetention(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.retentionID(w, r); ok {
+		policy, _ := s.retentions.get(id)
+		writeJSON(w, http.StatusOK, policy)
+	}
+}
+
+func (s *Server) updateRetention(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.retentionID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.RetentionPolicy
+	if !decode(w, r, &in) {
+		return
+	}
+	current, _ := s.retentions.get(id)
+	if in.Scope != current.Scope {
+		writeError(w, http.StatusBadRequest, "the scope of a retention policy cannot change")
+		return
+	}
+	in.ID = id
+	s.retentions.put(id, in)
+	w.WriteHeader(http.StatusOK)
+}
+
+// deleteRetention answers an unknown ID with 400 rather than 404, which is
+// what Harbor does.
+func (s *Server) deleteRetention(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+	policy, found := s.retentions.get(id)
+	if !found {
+		writeError(w, http.StatusBadRequest, "no such Retention policy with id %d", id)
+		return
+	}
+	s.retentions.delete(id)
+	if project, ok := s.projects.get(policy.Scope.Ref); ok {
+		project.Metadata.RetentionID = nil
+		s.projects.put(policy.Scope.Ref, project)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listImmutableRules(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := s.projectRef(w, r)
+	if !ok {
+		return
+	}
+	var items []harborapi.ImmutableRule
+	for _, rule := range s.immutableRules.all() {
+		if rule.projectID == projectID {
+			items = append(items, rule.rule)
+		}
+	}
+	writePage(w, r, items)
+}
+
+func (s *Server) createImmutableRule(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := s.projectRef(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.ImmutableRule
+	if !decode(w, r, &in) {
+		return
+	}
+	id := s.immutableRules.insert(func(id int) immutableRule {
+		in.ID = id
+		return immutableRule{projectID: projectID, rule: in}
+	})
+	writeCreated(w, "/projects/"+r.PathValue("project_name_or_id")+"/immutabletagrules/"+strconv.Itoa(id))
+}
+
+func (s *Server) immutableRuleID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	projectID, ok := s.projectRef(w, r)
+	if !ok {
+		return 0, false
+	}
+	id, ok := pathID(w, r, "immutable_rule_id")
+	if !ok {
+		return 0, false
+	}
+	if rule, found := s.immutableRules.get(id); !found || rule.projectID != projectID {
+		writeError(w, http.StatusNotFound, "immutable rule %d not found in project %d", id, projectID)
+		return 0, false
+	}
+	return id, true
+}
+
+func (s *Server) updateImmutableRule(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.immutableRuleID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.ImmutableRule
+	if !decode(w, r, &in) {
+		return
+	}
+	rule, _ := s.immutableRules.get(id)
+	in.ID = id
+	rule.rule = in
+	s.immutableRules.put(id, rule)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteImmutableRule(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.immutableRuleID(w, r); ok {
+		s.immutableRules.delete(id)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (s *Server) listWebhooks(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := s.projectRef(w, r)
+	if !ok {
+		return
+	}
+	var inProject []harborapi.WebhookPolicy
+	for _, policy := range s.webhooks.all() {
+		if policy.ProjectID == projectID {
+			inProject = append(inProject, policy)
+		}
+	}
+	items, ok := filter(w, r, inProject, func(policy harborapi.WebhookPolicy) map[string]string {
+		return map[string]string{"name": policy.Name}
+	})
+	if ok {
+		writePage(w, r, items)
+	}
+}
+
+func (s *Server) webhookConflict(projectID int, name string, id int) bool {
+	_, exists := s.webhooks.find(func(policy harborapi.WebhookPolicy) bool {
+		return policy.ProjectID == projectID && policy.Name == name && policy.ID != id
+	})
+	return exists
+}
+
+func (s *Server) createWebhook(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := s.projectRef(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.WebhookPolicy
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Name == "" || len(in.Targets) == 0 {
+		writeError(w, http.StatusBadRequest, "name and targets are required")
+		return
+	}
+	if s.webhookConflict(projectID, in.Name, 0) {
+		writeError(w, http.StatusConflict, "webhook policy %s already exists", in.Name)
+		return
+	}
+	creator := s.username(r)
+	id := s.webhooks.insert(func(id int) harborapi.WebhookPolicy {
+		in.ID, in.ProjectID, in.Creator = id, projectID, creator
+		in.CreationTime, in.UpdateTime = s.now(), s.now()
+		return in
+	})
+	writeCreated(w, "/projects/"+r.PathValue("project_name_or_id")+"/webhook/policies/"+strconv.Itoa(id))
+}
+
+func (s *Server) webhookID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	projectID, ok := s.projectRef(w, r)
+	if !ok {
+		return 0, false
+	}
+	id, ok := pathID(w, r, "webhook_policy_id")
+	if !ok {
+		return 0, false
+	}
+	if policy, found := s.webhooks.get(id); !found || policy.ProjectID != projectID {
+		writeError(w, http.StatusNotFound, "webhook policy %d not found in project %d", id, projectID)
+		return 0, false
+	}
+	return id, true
+}
+
+func (s *Server) getWebhook(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.webhookID(w, r); ok {
+		policy, _ := s.webhooks.get(id)
+		writeJSON(w, http.StatusOK, policy)
+	}
+}
+
+func (s *Server) updateWebhook(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.webhookID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.WebhookPolicy
+	if !decode(w, r, &in) {
+		return
+	}
+	current, _ := s.webhooks.get(id)
+	if s.webhookConflict(current.ProjectID, in.Name, id) {
+		writeError(w, http.StatusConflict, "webhook policy %s already exists", in.Name)
+		return
+	}
+	in.ID, in.ProjectID, in.Creator = id, current.ProjectID, current.Creator
+	in.CreationTime, in.UpdateTime = current.CreationTime, s.now()
+	s.webhooks.put(id, in)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteWebhook(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.webhookID(w, r); ok {
+		s.webhooks.delete(id)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// listReplications supports both the q expression and Harbor's legacy name
+// parameter, which is a substring match.
+func (s *Server) listReplications(w http.ResponseWriter, r *http.Request) {
+	items, ok := filter(w, r, s.replications.all(), func(policy harborapi.ReplicationPolicy) map[string]string {
+		return map[string]string{"name": policy.Name}
+	})
+	if !ok {
+		return
+	}
+	if name := r.URL.Query().Get("name"); name != "" {
+		matched := items[:0]
+		for _, policy := range items {
+			if strings.Contains(policy.Name, name) {
+				matched = append(matched, policy)
+			}
+		}
+		items = matched
+	}
+	for i := range items {
+		items[i] = s.expandReplication(items[i])
+	}
+	writePage(w, r, items)
+}
+
+// expandReplication fills in the registries a policy references, as Harbor
+// returns them in full. ID 0 is the local Harbor.
+func (s *Server) expandReplication(policy harborapi.ReplicationPolicy) harborapi.ReplicationPolicy {
+	for _, ref := range []*harborapi.Registry{&policy.SrcRegistry, &policy.DestRegistry} {
+		if registry, ok := s.registries.get(ref.ID); ok {
+			*ref = maskRegistry(registry)
+		}
+	}
+	return policy
+}
+
+// validateReplication checks the policy name and registries.
+func (s *Server) validateReplication(w http.ResponseWriter, in harborapi.ReplicationPolicy, id int) bool {
+	if in.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return false
+	}
+	for _, registryID := range []int{in.SrcRegistry.ID, in.DestRegistry.ID} {
+		if registryID != 0 && !s.registries.has(registryID) {
+			writeError(w, http.StatusBadRequest, "registry %d not found", registryID)
+			return false
+		}
+	}
+	if _, exists := s.replications.find(func(policy harborapi.ReplicationPolicy) bool {
+		return policy.Name == in.Name && policy.ID != id
+	}); exists {
+		writeError(w, http.StatusConflict, "policy %s already exists", in.Name)
+		return false
+	}
+	return true
+}
+
+func (s *Server) createReplication(w http.ResponseWriter, r *http.Request) {
+	var in harborapi.ReplicationPolicy
+	if !decode(w, r, &in) || !s.validateReplication(w, in, 0) {
+		return
+	}
+	id := s.replications.insert(func(id int) harborapi.ReplicationPolicy {
+		in.ID = id
+		in.SrcRegistry = harborapi.Registry{ID: in.SrcRegistry.ID}
+		in.DestRegistry = harborapi.Registry{ID: in.DestRegistry.ID}
+		in.CreationTime, in.UpdateTime = s.now(), s.now()
+		return in
+	})
+	writeCreated(w, "/replication/policies/"+strconv.Itoa(id))
+}
+
+func (s *Server) replicationID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return 0, false
+	}
+	if !s.replications.has(id) {
+		writeError(w, http.StatusNotFound, "policy %d not found", id)
+		return 0, false
+	}
+	return id, true
+}
+
+func (s *Server) getReplication(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.replicationID(w, r); ok {
+		policy, _ := s.replications.get(id)
+		writeJSON(w, http.StatusOK, s.expandReplication(policy))
+	}
+}
+
+func (s *Server) updateReplication(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.replicationID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.ReplicationPolicy
+	if !decode(w, r, &in) || !s.validateReplication(w, in, id) {
+		return
+	}
+	current, _ := s.replications.get(id)
+	in.ID = id
+	in.SrcRegistry = harborapi.Registry{ID: in.SrcRegistry.ID}
+	in.DestRegistry = harborapi.Registry{ID: in.DestRegistry.ID}
+	in.CreationTime, in.UpdateTime = current.CreationTime, s.now()
+	s.replications.put(id, in)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteReplication(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.replicationID(w, r); ok {
+		s.replications.delete(id)
+		w.WriteHeader(http.StatusOK)
+	}
+}
@@ -0,0 +1,376 @@
+package harborfake
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
+)
+
+// projectRoles maps Harbor project role IDs to their names.
+var projectRoles = map[int]string{
+	1: "projectAdmin",
+	2: "developer",
+	3: "guest",
+	4: "maintainer",
+	5: "limitedGuest",
+}
+
+func (s *Server) routeProjects() {
+	s.handle(http.MethodGet, "/projects", s.listProjects)
+	s.handle(http.MethodPost, "/projects", s.createProject)
+	s.handle(http.MethodGet, "/projects/{project_name_or_id}", s.getProject)
+	s.handle(http.MethodPut, "/projects/{project_name_or_id}", s.updateProject)
+	s.handle(http.MethodDelete, "/projects/{project_name_or_id}", s.deleteProject)
+
+	s.handle(http.MethodGet, "/projects/{project_name_or_id}/members", s.listMembers)
+	s.handle(http.MethodPost, "/projects/{project_name_or_id}/members", s.createMember)
+	s.handle(http.MethodGet, "/projects/{project_name_or_id}/members/{mid}", s.getMember)
+	s.handle(http.MethodPut, "/projects/{project_name_or_id}/members/{mid}", s.updateMember)
+	s.handle(http.MethodDelete, "/projects/{project_name_or_id}/members/{mid}", s.deleteMember)
+}
+
+// projectRef resolves a project_name_or_id path value. Like Harbor, a numeric
+// value is an ID and anything else a name.
+func (s *Server) projectRef(w http.ResponseWriter, r *http.Request) (int, bool) {
+	ref := r.PathValue("project_name_or_id")
+	if id, err := strconv.Atoi(ref); err == nil {
+		if s.projects.has(id) {
+			return id, true
+		}
+	} else if id, ok := s.projects.find(func(project harborapi.Project) bool { return project.Name == ref }); ok {
+		return id, true
+	}
+	writeError(w, http.StatusNotFound, "project %s not found", ref)
+	return 0, false
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	items, ok := filter(w, r, s.projects.all(), func(project harborapi.Project) map[string]string {
+		return map[string]string{
+			"name":        project.Name,
+			"project_id":  strconv.Itoa(project.ProjectID),
+			"public":      project.Metadata.Public,
+			"registry_id": strconv.Itoa(project.RegistryID),
+		}
+	})
+	if ok {
+		writePage(w, r, items)
+	}
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	var in harborapi.ProjectReq
+	if !decode(w, r, &in) {
+		return
+	}
+	switch {
+	case in.ProjectName == "":
+		writeError(w, http.StatusBadRequest, "project_name is required")
+		return
+	case in.RegistryID != nil && *in.RegistryID != 0 && !s.registries.has(*in.RegistryID):
+		writeError(w, http.StatusBadRequest, "registry %d not found", *in.RegistryID)
+		return
+	}
+	if _, exists := s.projects.find(func(project harborapi.Project) bool { return project.Name == in.ProjectName }); exists {
+		writeError(w, http.StatusConflict, "The project named %s already exists", in.ProjectName)
+		return
+	}
+
+	owner := s.username(r)
+	id := s.projects.insert(func(id int) harborapi.Project {
+		project := harborapi.Project{
+			ProjectID:    id,
+			Name:         in.ProjectName,
+			OwnerName:    owner,
+			Metadata:     in.Metadata,
+			CVEAllowlist: in.CVEAllowlist,
+			CreationTime: s.now(),
+			UpdateTime:   s.now(),
+		}
+		if in.RegistryID != nil {
+			project.RegistryID = *in.RegistryID
+		}
+		if in.Public != nil {
+			project.Metadata.Public = strconv.FormatBool(*in.Public)
+		}
+		if project.Metadata.Public == "" {
+			project.Metadata.Public = "false"
+		}
+		project.CVEAllowlist.ProjectID = id
+		return project
+	})
+
+	storageLimit := -1
+	if in.StorageLimit != nil {
+		storageLimit = *in.StorageLimit
+	}
+	s.quotas.insert(func(quotaID int) harborapi.Quota {
+		return harborapi.Quota{
+			ID:           quotaID,
+			Ref:          harborapi.QuotaRefObject{"id": id, "name": in.ProjectName, "owner_name": owner},
+			Hard:         harborapi.ResourceList{"storage": storageLimit},
+			Used:         harborapi.ResourceList{"storage": 0},
+			CreationTime: s.now(),
+			UpdateTime:   s.now(),
+		}
+	})
+	writeCreated(w, "/projects/"+strconv.Itoa(id))
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.projectRef(w, r); ok {
+		project, _ := s.projects.get(id)
+		writeJSON(w, http.StatusOK, project)
+	}
+}
+
+// updateProject merges metadata keys and replaces the CVE allowlist when the
+// request has one, as Harbor does. The project name and registry cannot
+// change.
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.projectRef(w, r)
+	if !ok {
+		return
+	}
+	var in struct {
+		harborapi.ProjectReq
+		// CVEAllowlist is nil when the request leaves cve_allowlist out.
+		CVEAllowlist *harborapi.CVEAllowlist `json:"cve_allowlist"`
+	}
+	if !decode(w, r, &in) {
+		return
+	}
+	project, _ := s.projects.get(id)
+	meta := &project.Metadata
+	if in.Public != nil {
+		meta.Public = strconv.FormatBool(*in.Public)
+	}
+	if in.Metadata.Public != "" {
+		meta.Public = in.Metadata.Public
+	}
+	mergeMetadata(meta, in.Metadata)
+	if in.CVEAllowlist != nil {
+		project.CVEAllowlist = *in.CVEAllowlist
+		project.CVEAllowlist.ProjectID = id
+	}
+	project.UpdateTime = s.now()
+	s.projects.put(id, project)
+
+	if in.StorageLimit != nil {
+		if quotaID, ok := s.projectQuota(id); ok {
+			quota, _ := s.quotas.get(quotaID)
+			quota.Hard["storage"] = *in.StorageLimit
+			s.quotas.put(quotaID, quota)
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// mergeMetadata copies the metadata keys present in in onto meta.
+func mergeMetadata(meta *harborapi.ProjectMetadata, in harborapi.ProjectMetadata) {
+	for _, field := range []struct{ dst, src **string }{
+		{&meta.AutoSBOMGeneration, &in.AutoSBOMGeneration},
+		{&meta.AutoScan, &in.AutoScan},
+		{&meta.EnableContentTrust, &in.EnableContentTrust},
+		{&meta.EnableContentTrustCosign, &in.EnableContentTrustCosign},
+		{&meta.MaxUpstreamConn, &in.MaxUpstreamConn},
+		{&meta.PreventVul, &in.PreventVul},
+		{&meta.ProxyCacheLocalOnNotFound, &in.ProxyCacheLocalOnNotFound},
+		{&meta.ProxyReferrerAPI, &in.ProxyReferrerAPI},
+		{&meta.ProxySpeedKB, &in.ProxySpeedKB},
+		{&meta.RetentionID, &in.RetentionID},
+		{&meta.ReuseSysCVEAllowlist, &in.ReuseSysCVEAllowlist},
+		{&meta.Severity, &in.Severity},
+	} {
+		if *field.src != nil {
+			*field.dst = *field.src
+		}
+	}
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.projectRef(w, r)
+	if !ok {
+		return
+	}
+	project, _ := s.projects.get(id)
+	s.projects.delete(id)
+	if quotaID, ok := s.projectQuota(id); ok {
+		s.quotas.delete(quotaID)
+	}
+	for memberID, member := range s.members.rows {
+		if member.ProjectID == id {
+			s.members.delete(memberID)
+		}
+	}
+	for ruleID, rule := range s.immutableRules.rows {
+		if rule.projectID == id {
+			s.immutableRules.delete(ruleID)
+		}
+	}
+	for policyID, policy := range s.webhooks.rows {
+		if policy.ProjectID == id {
+			s.webhooks.delete(policyID)
+		}
+	}
+	for labelID, label := range s.labels.rows {
+		if label.Scope == "p" && label.ProjectID == id {
+			s.labels.delete(labelID)
+		}
+	}
+	for robotID, robot := range s.robots.rows {
+		if robot.Level == "project" && robotProject(robot.Name) == project.Name {
+			s.robots.delete(robotID)
+			delete(s.robotSecrets, robotID)
+		}
+	}
+	for retentionID, policy := range s.retentions.rows {
+		if policy.Scope.Level == "project" && policy.Scope.Ref == id {
+			s.retentions.delete(retentionID)
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) projectQuota(projectID int) (int, bool) {
+	return s.quotas.find(func(quota harborapi.Quota) bool { return quota.Ref["id"] == projectID })
+}
+
+func (s *Server) listMembers(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := s.projectRef(w, r)
+	if !ok {
+		return
+	}
+	entityName := r.URL.Query().Get("entityname")
+	var items []harborapi.ProjectMemberEntity
+	for _, member := range s.members.all() {
+		if member.ProjectID != projectID {
+			continue
+		}
+		if entityName != "" && !strings.Contains(member.EntityName, entityName) {
+			continue
+		}
+		items = append(items, member)
+	}
+	writePage(w, r, items)
+}
+
+// createMember adds a user or group to a project. Users must exist. Groups
+// are matched by ID, then by name, and are created when missing, as Harbor
+// does for OIDC groups.
+func (s *Server) createMember(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := s.projectRef(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.ProjectMember
+	if !decode(w, r, &in) {
+		return
+	}
+	roleName, ok := projectRoles[in.RoleID]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "invalid role_id %d", in.RoleID)
+		return
+	}
+
+	member := harborapi.ProjectMemberEntity{ProjectID: projectID, RoleID: in.RoleID, RoleName: roleName}
+	switch {
+	case in.MemberUser.UserID != 0 || in.MemberUser.Username != "":
+		userID, found := s.users.find(func(user harborapi.UserResp) bool {
+			return user.UserID == in.MemberUser.UserID || user.Username == in.MemberUser.Username
+		})
+		if !found {
+			writeError(w, http.StatusNotFound, "user %s not found", in.MemberUser.Username)
+			return
+		}
+		user, _ := s.users.get(userID)
+		member.EntityType, member.EntityID, member.EntityName = "u", user.UserID, user.Username
+	case in.MemberGroup.ID != 0 || in.MemberGroup.GroupName != "" || in.MemberGroup.LDAPGroupDN != "":
+		groupID, found := s.groups.find(func(group harborapi.UserGroup) bool {
+			if in.MemberGroup.ID != 0 {
+				return group.ID == in.MemberGroup.ID
+			}
+			return group.GroupName == in.MemberGroup.GroupName && group.LDAPGroupDN == in.MemberGroup.LDAPGroupDN
+		})
+		if !found && in.MemberGroup.ID != 0 {
+			writeError(w, http.StatusNotFound, "user group %d not found", in.MemberGroup.ID)
+			return
+		}
+		if !found {
+			groupID = s.groups.insert(func(id int) harborapi.UserGroup {
+				group := in.MemberGroup
+				group.ID = id
+				return group
+			})
+		}
+		group, _ := s.groups.get(groupID)
+		member.EntityType, member.EntityID, member.EntityName = "g", group.ID, group.GroupName
+	default:
+		writeError(w, http.StatusBadRequest, "member_user or member_group is required")
+		return
+	}
+
+	if _, exists := s.members.find(func(existing harborapi.ProjectMemberEntity) bool {
+		return existing.ProjectID == projectID && existing.EntityType == member.EntityType && existing.EntityID == member.EntityID
+	}); exists {
+		writeError(w, http.StatusConflict, "the member already exists in project %d", projectID)
+		return
+	}
+	id := s.members.insert(func(id int) harborapi.ProjectMemberEntity {
+		member.ID = id
+		return member
+	})
+	writeCreated(w, "/projects/"+r.PathValue("project_name_or_id")+"/members/"+strconv.Itoa(id))
+}
+
+func (s *Server) memberID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	projectID, ok := s.projectRef(w, r)
+	if !ok {
+		return 0, false
+	}
+	id, ok := pathID(w, r, "mid")
+	if !ok {
+		return 0, false
+	}
+	if member, found := s.members.get(id); !found || member.ProjectID != projectID {
+		writeError(w, http.StatusNotFound, "member %d not found in project %d", id, projectID)
+		return 0, false
+	}
+	return id, true
+}
+
+func (s *Server) getMember(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.memberID(w, r); ok {
+		member, _ := s.members.get(id)
+		writeJSON(w, http.StatusOK, member)
+	}
+}
+
+func (s *Server) updateMember(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.memberID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.RoleRequest
+	if !decode(w, r, &in) {
+		return
+	}
+	roleName, ok := projectRoles[in.RoleID]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "invalid role_id %d", in.RoleID)
+		return
+	}
+	member, _ := s.members.get(id)
+	member.RoleID, member.RoleName = in.RoleID, roleName
+	s.members.put(id, member)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteMember(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.memberID(w, r); ok {
+		s.members.delete(id)
+		w.WriteHeader(http.StatusOK)
+	}
+}
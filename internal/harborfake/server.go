@@ -0,0 +1,442 @@
+// Package harborfake provides an in-memory Harbor API v2.0 server for tests.
+//
+// The server models the Harbor objects the operator manages: projects,
+// members, robots, registries, replication and retention policies, immutable
+// tag rules, webhook policies, labels, quotas, users, user groups, scanners,
+// the system schedules, and configurations. Objects are stored as the
+// generated harborapi models. Like Harbor, the server assigns IDs, answers
+// creates with a Location header, paginates lists with X-Total-Count and Link
+// headers, and rejects duplicate names with 409 Conflict. Faults can be
+// injected to exercise error handling.
+package harborfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
+)
+
+const (
+	// DefaultVersion is the Harbor version reported by /systeminfo.
+	DefaultVersion = "v2.12.0"
+
+	// AdminUsername is the system administrator every server starts with.
+	AdminUsername = "admin"
+
+	basePath        = harborapi.BasePath
+	defaultPageSize = 10
+	maxPageSize     = 100
+	timeLayout      = "2006-01-02T15:04:05.000Z"
+)
+
+// Options configures a Server.
+type Options struct {
+	// Username and Password, when set, are required as basic auth on every
+	// request except /ping.
+	Username string
+	Password string
+	// Token, when set, is also accepted as a bearer token.
+	Token string
+	// Version is reported by /systeminfo. Defaults to DefaultVersion.
+	Version string
+	// Now returns the time used for creation and update timestamps. Defaults
+	// to time.Now.
+	Now func() time.Time
+}
+
+// Fault replaces the response to matching requests with a Harbor error.
+type Fault struct {
+	// Method matches the request method. Empty matches every method.
+	Method string
+	// Path is a path.Match pattern for the request path, for example
+	// "/api/v2.0/projects/*". Empty matches every path.
+	Path string
+	// Status is the response status code.
+	Status int
+	// Message is returned in the Harbor error body.
+	Message string
+	// RetryAfter, when positive, is sent as the Retry-After header.
+	RetryAfter time.Duration
+	// Times is the number of requests that fail. Zero fails every matching
+	// request until ClearFaults.
+	Times int
+}
+
+// Request records a request the server received.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+}
+
+// Server is an in-memory Harbor. It embeds the running httptest.Server, so
+// URL and Close are available directly.
+type Server struct {
+	*httptest.Server
+
+	opts Options
+	mux  *http.ServeMux
+
+	mu             sync.Mutex
+	faults         []*Fault
+	requests       []Request
+	projects       table[harborapi.Project]
+	members        table[harborapi.ProjectMemberEntity]
+	quotas         table[harborapi.Quota]
+	retentions     table[harborapi.RetentionPolicy]
+	immutableRules table[immutableRule]
+	webhooks       table[harborapi.WebhookPolicy]
+	robots         table[harborapi.Robot]
+	robotSecrets   map[int]string
+	registries     table[harborapi.Registry]
+	replications   table[harborapi.ReplicationPolicy]
+	labels         table[harborapi.Label]
+	users          table[harborapi.UserResp]
+	groups         table[harborapi.UserGroup]
+	scanners       table[harborapi.ScannerRegistration]
+	schedules      map[string]*harborapi.Schedule
+	configurations map[string]configurationItem
+}
+
+type immutableRule struct {
+	projectID int
+	rule      harborapi.ImmutableRule
+}
+
+type configurationItem struct {
+	Value    json.RawMessage `json:"value"`
+	Editable bool            `json:"editable"`
+}
+
+// New starts a server. Close it when the test ends.
+func New(opts Options) *Server {
+	if opts.Version == "" {
+		opts.Version = DefaultVersion
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	s := &Server{
+		opts:           opts,
+		mux:            http.NewServeMux(),
+		robotSecrets:   map[int]string{},
+		schedules:      map[string]*harborapi.Schedule{},
+		configurations: defaultConfigurations(),
+	}
+	s.users.insert(func(id int) harborapi.UserResp {
+		return harborapi.UserResp{
+			UserID:       id,
+			Username:     AdminUsername,
+			Realname:     "system admin",
+			Email:        "admin@example.com",
+			SysadminFlag: true,
+			CreationTime: s.now(),
+			UpdateTime:   s.now(),
+		}
+	})
+	s.routeSystem()
+	s.routeProjects()
+	s.routePolicies()
+	s.routeAccounts()
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// InjectFault makes matching requests fail until the fault is used up or
+// cleared. Faults are checked in the order they were injected.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes every injected fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+// ServeHTTP serves one request. Requests are handled one at a time, so every
+// handler sees a consistent state.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.Query()})
+	if r.URL.Path != basePath+"/ping" && !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	if f := s.takeFault(r); f != nil {
+		if f.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(f.RetryAfter.Seconds())))
+		}
+		writeError(w, f.Status, "%s", f.Message)
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	if s.opts.Username == "" && s.opts.Token == "" {
+		return true
+	}
+	if s.opts.Token != "" && r.Header.Get("Authorization") == "Bearer "+s.opts.Token {
+		return true
+	}
+	username, password, ok := r.BasicAuth()
+	return ok && s.opts.Username != "" && username == s.opts.Username && password == s.opts.Password
+}
+
+func (s *Server) takeFault(r *http.Request) *Fault {
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if f.Path != "" {
+			if ok, _ := path.Match(f.Path, r.URL.Path); !ok {
+				continue
+			}
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = slices.Delete(s.faults, i, i+1)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+// handle registers a handler for a method and a path below the API base path.
+func (s *Server) handle(method, pattern string, h http.HandlerFunc) {
+	s.mux.HandleFunc(method+" "+basePath+pattern, h)
+}
+
+// username returns the caller as Harbor would record it, for owner and
+// creator fields.
+func (s *Server) username(r *http.Request) string {
+	if username, _, ok := r.BasicAuth(); ok {
+		return username
+	}
+	return AdminUsername
+}
+
+func (s *Server) now() string {
+	return s.opts.Now().UTC().Format(timeLayout)
+}
+
+// table stores objects by the ID the server assigned them.
+type table[T any] struct {
+	last int
+	rows map[int]T
+}
+
+func (t *table[T]) insert(build func(id int) T) int {
+	if t.rows == nil {
+		t.rows = map[int]T{}
+	}
+	t.last++
+	t.rows[t.last] = build(t.last)
+	return t.last
+}
+
+func (t *table[T]) get(id int) (T, bool) {
+	row, ok := t.rows[id]
+	return row, ok
+}
+
+func (t *table[T]) has(id int) bool {
+	_, ok := t.rows[id]
+	return ok
+}
+
+func (t *table[T]) put(id int, row T) {
+	t.rows[id] = row
+}
+
+func (t *table[T]) delete(id int) bool {
+	if _, ok := t.rows[id]; !ok {
+		return false
+	}
+	delete(t.rows, id)
+	return true
+}
+
+// all returns the objects in ID order, which is the order Harbor lists them
+// in by default.
+func (t *table[T]) all() []T {
+	out := make([]T, 0, len(t.rows))
+	for _, id := range slices.Sorted(maps.Keys(t.rows)) {
+		out = append(out, t.rows[id])
+	}
+	return out
+}
+
+// find returns the ID of the first object matching fn.
+func (t *table[T]) find(fn func(T) bool) (int, bool) {
+	for _, id := range slices.Sorted(maps.Keys(t.rows)) {
+		if fn(t.rows[id]) {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes the error body Harbor returns for failed requests.
+func writeError(w http.ResponseWriter, status int, format string, args ...any) {
+	code := strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_"))
+	writeJSON(w, status, harborapi.Errors{Errors: []harborapi.Error{{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}}})
+}
+
+// writeCreated answers a create with the Location of the new object.
+func writeCreated(w http.ResponseWriter, location string) {
+	w.Header().Set("Location", basePath+location)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// writePage writes one page of items with Harbor's pagination headers.
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	page, pageSize, ok := pagination(w, r)
+	if !ok {
+		return
+	}
+	total := len(items)
+	start := min((page-1)*pageSize, total)
+	end := min(start+pageSize, total)
+
+	var links []string
+	if page > 1 {
+		links = append(links, pageLink(r, page-1, pageSize, "prev"))
+	}
+	if end < total {
+		links = append(links, pageLink(r, page+1, pageSize, "next"))
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, " , "))
+	}
+	w.Header().Set("X-Total-Count", strconv.Itoa(total))
+	writeJSON(w, http.StatusOK, append([]T{}, items[start:end]...))
+}
+
+func pagination(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	page, pageSize := 1, defaultPageSize
+	query := r.URL.Query()
+	if v := query.Get("page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, "invalid page %q", v)
+			return 0, 0, false
+		}
+		page = n
+	}
+	if v := query.Get("page_size"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPageSize {
+			writeError(w, http.StatusBadRequest, "invalid page_size %q", v)
+			return 0, 0, false
+		}
+		pageSize = n
+	}
+	return page, pageSize, true
+}
+
+func pageLink(r *http.Request, page, pageSize int, rel string) string {
+	query := r.URL.Query()
+	query.Set("page", strconv.Itoa(page))
+	query.Set("page_size", strconv.Itoa(pageSize))
+	return fmt.Sprintf(`<%s?%s>; rel="%s"`, r.URL.Path, query.Encode(), rel)
+}
+
+// matchQuery reports whether an object with fields matches a Harbor q
+// expression such as "name=demo,level=~proj". "=" is an exact match and "=~"
+// a substring match. Keys the object does not have are rejected.
+func matchQuery(q string, fields map[string]string) (bool, error) {
+	if q == "" {
+		return true, nil
+	}
+	for _, term := range strings.Split(q, ",") {
+		key, value, ok := strings.Cut(term, "=")
+		if !ok {
+			return false, fmt.Errorf("invalid query term %q", term)
+		}
+		field, ok := fields[key]
+		if !ok {
+			return false, fmt.Errorf("unsupported query key %q", key)
+		}
+		if fuzzy, isFuzzy := strings.CutPrefix(value, "~"); isFuzzy {
+			if !strings.Contains(field, fuzzy) {
+				return false, nil
+			}
+			continue
+		}
+		if field != value {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// filter returns the items matching the q parameter of r. It writes a 400
+// response and returns false when the query is invalid.
+func filter[T any](w http.ResponseWriter, r *http.Request, items []T, fields func(T) map[string]string) ([]T, bool) {
+	q := r.URL.Query().Get("q")
+	out := make([]T, 0, len(items))
+	for _, item := range items {
+		ok, err := matchQuery(q, fields(item))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "%v", err)
+			return nil, false
+		}
+		if ok {
+			out = append(out, item)
+		}
+	}
+	return out, true
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: %v", err)
+		return false
+	}
+	return true
+}
+
+func pathID(w http.ResponseWriter, r *http.Request, name string) (int, bool) {
+	id, err := strconv.Atoi(r.PathValue(name))
+	if err != nil || id < 1 {
+		writeError(w, http.StatusBadRequest, "invalid %s %q", name, r.PathValue(name))
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,251 @@
+package harborfake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient"
+	"k8s.io/utils/ptr"
+)
+
+const testPassword = "Harbor12345"
+
+func newTestServer(t *testing.T) (*Server, *harborclient.Client) {
+	t.Helper()
+	server := New(Options{Username: AdminUsername, Password: testPassword})
+	t.Cleanup(server.Close)
+	client := harborclient.New(server.URL, AdminUsername, testPassword)
+	client.Retry = harborclient.RetryPolicy{MaxAttempts: 3, MaxDelay: time.Second}
+	return server, client
+}
+
+func TestProjectLifecycle(t *testing.T) {
+	t.Parallel()
+	_, client := newTestServer(t)
+	ctx := context.Background()
+
+	id, err := client.CreateProject(ctx, harborclient.CreateProjectRequest{ProjectName: "demo", Public: ptr.To(true)})
+	if err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	if id == 0 {
+		t.Fatalf("CreateProject returned no ID; the Location header was not parsed")
+	}
+
+	project, err := client.FindProjectByName(ctx, "demo")
+	if err != nil {
+		t.Fatalf("FindProjectByName returned error: %v", err)
+	}
+	if project.ProjectID != id || project.OwnerName != AdminUsername || project.Metadata.Public != "true" {
+		t.Fatalf("unexpected project: %+v", project)
+	}
+
+	_, err = client.CreateProject(ctx, harborclient.CreateProjectRequest{ProjectName: "demo"})
+	if !harborclient.IsConflict(err) {
+		t.Fatalf("expected a conflict for a duplicate project name, got %v", err)
+	}
+
+	quotas, err := client.ListQuotas(ctx, "project", strconv.Itoa(id))
+	if err != nil {
+		t.Fatalf("ListQuotas returned error: %v", err)
+	}
+	if len(quotas) != 1 || quotas[0].Hard["storage"] != -1 {
+		t.Fatalf("expected an unlimited quota for the new project, got %+v", quotas)
+	}
+
+	if err := client.DeleteProject(ctx, id); err != nil {
+		t.Fatalf("DeleteProject returned error: %v", err)
+	}
+	if _, err := client.GetProjectByID(ctx, id); !harborclient.IsNotFound(err) {
+		t.Fatalf("expected the deleted project to be gone, got %v", err)
+	}
+}
+
+func TestProjectAllowlistCanBeCleared(t *testing.T) {
+	t.Parallel()
+	_, client := newTestServer(t)
+	ctx := context.Background()
+
+	allowlist := harborclient.CVEAllowlist{Items: []harborclient.CVEAllowlistItem{{CVEID: "CVE-2024-0001"}, {CVEID: "CVE-2024-0002"}}}
+	id, err := client.CreateProject(ctx, harborclient.CreateProjectRequest{ProjectName: "demo", CVEAllowlist: allowlist})
+	if err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	if err := client.UpdateProject(ctx, id, harborclient.CreateProjectRequest{ProjectName: "demo"}); err != nil {
+		t.Fatalf("UpdateProject returned error: %v", err)
+	}
+	project, err := client.GetProjectByID(ctx, id)
+	if err != nil {
+		t.Fatalf("GetProjectByID returned error: %v", err)
+	}
+	if len(project.CVEAllowlist.Items) != 0 {
+		t.Fatalf("allowlist = %+v, want it cleared by an update without items", project.CVEAllowlist.Items)
+	}
+}
+
+func TestListsArePaginated(t *testing.T) {
+	t.Parallel()
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	for i := range 150 {
+		if _, err := client.CreateProject(ctx, harborclient.CreateProjectRequest{ProjectName: fmt.Sprintf("project-%03d", i)}); err != nil {
+			t.Fatalf("CreateProject returned error: %v", err)
+		}
+	}
+
+	projects, err := client.ListProjects(ctx)
+	if err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	if len(projects) != 150 {
+		t.Fatalf("expected 150 projects across pages, got %d", len(projects))
+	}
+
+	resp, err := http.Get(server.URL + basePath + "/projects?page=2&page_size=100")
+	if err != nil {
+		t.Fatalf("listing projects: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected an unauthenticated list to be rejected, got %d", resp.StatusCode)
+	}
+	req, _ := http.NewRequest(http.MethodGet, server.URL+basePath+"/projects?page=2&page_size=100", nil)
+	req.SetBasicAuth(AdminUsername, testPassword)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("listing projects: %v", err)
+	}
+	_ = resp.Body.Close()
+	if got := resp.Header.Get("X-Total-Count"); got != "150" {
+		t.Fatalf("expected X-Total-Count 150, got %q", got)
+	}
+	if got := resp.Header.Get("Link"); got != `<`+basePath+`/projects?page=1&page_size=100>; rel="prev"` {
+		t.Fatalf("unexpected Link header on the last page: %q", got)
+	}
+}
+
+func TestMembersRequireExistingUsers(t *testing.T) {
+	t.Parallel()
+	_, client := newTestServer(t)
+	ctx := context.Background()
+
+	if _, err := client.CreateProject(ctx, harborclient.CreateProjectRequest{ProjectName: "demo"}); err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	member := harborclient.CreateMemberRequest{RoleID: 2, MemberUser: harborclient.MemberUser{Username: "alice"}}
+	if _, err := client.CreateProjectMember(ctx, "demo", member); !harborclient.IsNotFound(err) {
+		t.Fatalf("expected an unknown user to be rejected, got %v", err)
+	}
+
+	if _, err := client.CreateUser(ctx, harborclient.CreateUserRequest{Username: "alice", Email: "alice@example.com", Password: testPassword}); err != nil {
+		t.Fatalf("CreateUser returned error: %v", err)
+	}
+	id, err := client.CreateProjectMember(ctx, "demo", member)
+	if err != nil {
+		t.Fatalf("CreateProjectMember returned error: %v", err)
+	}
+	if _, err := client.CreateProjectMember(ctx, "demo", member); !harborclient.IsConflict(err) {
+		t.Fatalf("expected a duplicate member to conflict, got %v", err)
+	}
+
+	got, err := client.GetProjectMember(ctx, "demo", id)
+	if err != nil {
+		t.Fatalf("GetProjectMember returned error: %v", err)
+	}
+	if got.EntityName != "alice" || got.EntityType != "u" || got.RoleName != "developer" {
+		t.Fatalf("unexpected member: %+v", got)
+	}
+}
+
+func TestRobotSecretsRefresh(t *testing.T) {
+	t.Parallel()
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	if _, err := client.CreateProject(ctx, harborclient.CreateProjectRequest{ProjectName: "demo"}); err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	created, err := client.CreateRobot(ctx, harborclient.RobotCreateRequest{
+		Name:     "ci",
+		Level:    "project",
+		Duration: -1,
+		Permissions: []harborclient.RobotPermission{{
+			Kind:      "project",
+			Namespace: "demo",
+			Access:    []harborclient.Access{{Resource: "repository", Action: "pull"}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("CreateRobot returned error: %v", err)
+	}
+	if created.Name != "robot$demo+ci" || created.Secret == "" {
+		t.Fatalf("unexpected created robot: %+v", created)
+	}
+
+	robots, err := client.ListRobots(ctx, "name=ci")
+	if err != nil {
+		t.Fatalf("ListRobots returned error: %v", err)
+	}
+	if len(robots) != 1 || robots[0].ID != created.ID || robots[0].Secret != "" {
+		t.Fatalf("expected the robot to be listed without its secret, got %+v", robots)
+	}
+
+	refreshed, err := client.RefreshRobotSecret(ctx, created.ID, "")
+	if err != nil {
+		t.Fatalf("RefreshRobotSecret returned error: %v", err)
+	}
+	if refreshed.Secret == "" || refreshed.Secret == created.Secret {
+		t.Fatalf("expected a new secret, got %q", refreshed.Secret)
+	}
+	if secret, _ := server.RobotSecret(created.ID); secret != refreshed.Secret {
+		t.Fatalf("expected the server to hold the refreshed secret")
+	}
+}
+
+func TestRetentionDeleteOfMissingPolicyIsIgnored(t *testing.T) {
+	t.Parallel()
+	_, client := newTestServer(t)
+
+	if err := client.DeleteRetention(context.Background(), 42); err != nil {
+		t.Fatalf("expected deleting a missing retention policy to succeed, got %v", err)
+	}
+}
+
+func TestInjectedFaultsAreRetried(t *testing.T) {
+	t.Parallel()
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	server.InjectFault(Fault{Method: http.MethodGet, Path: basePath + "/projects", Status: http.StatusServiceUnavailable, Times: 1})
+	if _, err := client.ListProjects(ctx); err != nil {
+		t.Fatalf("expected a single 503 to be retried, got %v", err)
+	}
+
+	server.InjectFault(Fault{Method: http.MethodGet, Path: basePath + "/projects/*", Status: http.StatusInternalServerError, Message: "boom"})
+	_, err := client.GetProjectByID(ctx, 1)
+	var httpErr *harborclient.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected the persistent fault to surface, got %v", err)
+	}
+
+	server.ClearFaults()
+	if _, err := client.GetProjectByID(ctx, 1); !harborclient.IsNotFound(err) {
+		t.Fatalf("expected a 404 once faults are cleared, got %v", err)
+	}
+
+	var listCalls int
+	for _, req := range server.Requests() {
+		if req.Method == http.MethodGet && req.Path == basePath+"/projects" {
+			listCalls++
+		}
+	}
+	if listCalls != 2 {
+		t.Fatalf("expected the failed list request and its retry to be recorded, got %d", listCalls)
+	}
+}
@@ -0,0 +1,562 @@
+package harborfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/rkthtrifork/harbor-operator/internal/harborclient/harborapi"
+)
+
+// scheduleKinds are the system schedules, named by their API path segment.
+var scheduleKinds = []string{"gc", "purgeaudit", "scanAll"}
+
+func defaultConfigurations() map[string]configurationItem {
+	values := map[string]any{
+		"auth_mode":                    "db_auth",
+		"notification_enable":          true,
+		"project_creation_restriction": "everyone",
+		"read_only":                    false,
+		"robot_name_prefix":            "robot$",
+		"robot_token_duration":         30,
+		"self_registration":            false,
+		"token_expiration":             30,
+	}
+	out := make(map[string]configurationItem, len(values))
+	for key, value := range values {
+		raw, _ := json.Marshal(value)
+		out[key] = configurationItem{Value: raw, Editable: true}
+	}
+	return out
+}
+
+func (s *Server) configurationString(key string) string {
+	var value string
+	_ = json.Unmarshal(s.configurations[key].Value, &value)
+	return value
+}
+
+func (s *Server) routeSystem() {
+	s.handle(http.MethodGet, "/ping", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("Pong"))
+	})
+	s.handle(http.MethodGet, "/systeminfo", s.getSystemInfo)
+	s.handle(http.MethodGet, "/users/current/permissions", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, []harborapi.Permission{})
+	})
+
+	s.handle(http.MethodGet, "/configurations", s.getConfigurations)
+	s.handle(http.MethodPut, "/configurations", s.updateConfigurations)
+
+	for _, kind := range scheduleKinds {
+		pattern := "/system/" + kind + "/schedule"
+		s.handle(http.MethodGet, pattern, s.getSchedule(kind))
+		s.handle(http.MethodPost, pattern, s.setSchedule(kind, true))
+		s.handle(http.MethodPut, pattern, s.setSchedule(kind, false))
+	}
+
+	s.handle(http.MethodGet, "/scanners", s.listScanners)
+	s.handle(http.MethodPost, "/scanners", s.createScanner)
+	s.handle(http.MethodGet, "/scanners/{registration_id}", s.getScanner)
+	s.handle(http.MethodPut, "/scanners/{registration_id}", s.updateScanner)
+	s.handle(http.MethodPatch, "/scanners/{registration_id}", s.setDefaultScanner)
+	s.handle(http.MethodDelete, "/scanners/{registration_id}", s.deleteScanner)
+
+	s.handle(http.MethodGet, "/labels", s.listLabels)
+	s.handle(http.MethodPost, "/labels", s.createLabel)
+	s.handle(http.MethodGet, "/labels/{label_id}", s.getLabel)
+	s.handle(http.MethodPut, "/labels/{label_id}", s.updateLabel)
+	s.handle(http.MethodDelete, "/labels/{label_id}", s.deleteLabel)
+
+	s.handle(http.MethodGet, "/quotas", s.listQuotas)
+	s.handle(http.MethodGet, "/quotas/{id}", s.getQuota)
+	s.handle(http.MethodPut, "/quotas/{id}", s.updateQuota)
+
+	s.handle(http.MethodGet, "/registries", s.listRegistries)
+	s.handle(http.MethodPost, "/registries", s.createRegistry)
+	s.handle(http.MethodGet, "/registries/{id}", s.getRegistry)
+	s.handle(http.MethodPut, "/registries/{id}", s.updateRegistry)
+	s.handle(http.MethodDelete, "/registries/{id}", s.deleteRegistry)
+}
+
+func (s *Server) getSystemInfo(w http.ResponseWriter, _ *http.Request) {
+	version := s.opts.Version
+	authMode := s.configurationString("auth_mode")
+	var readOnly bool
+	_ = json.Unmarshal(s.configurations["read_only"].Value, &readOnly)
+	writeJSON(w, http.StatusOK, harborapi.GeneralInfo{
+		HarborVersion: &version,
+		AuthMode:      &authMode,
+		ReadOnly:      &readOnly,
+	})
+}
+
+func (s *Server) getConfigurations(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.configurations)
+}
+
+func (s *Server) updateConfigurations(w http.ResponseWriter, r *http.Request) {
+	var in map[string]json.RawMessage
+	if !decode(w, r, &in) {
+		return
+	}
+	for key := range in {
+		item, ok := s.configurations[key]
+		if !ok {
+			writeError(w, http.StatusBadRequest, "unknown configuration key %q", key)
+			return
+		}
+		if !item.Editable {
+			writeError(w, http.StatusForbidden, "configuration %q is not editable", key)
+			return
+		}
+	}
+	for key, value := range in {
+		item := s.configurations[key]
+		item.Value = value
+		s.configurations[key] = item
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getSchedule(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		schedule, ok := s.schedules[kind]
+		if !ok {
+			schedule = &harborapi.Schedule{Schedule: harborapi.ScheduleObj{Type: "None"}}
+		}
+		writeJSON(w, http.StatusOK, schedule)
+	}
+}
+
+// setSchedule creates or updates a system schedule. Harbor refuses to create
+// a second schedule while one is set.
+func (s *Server) setSchedule(kind string, create bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var in harborapi.Schedule
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Schedule.Type == "" {
+			writeError(w, http.StatusBadRequest, "schedule type is required")
+			return
+		}
+		current, exists := s.schedules[kind]
+		if create && exists && current.Schedule.Type != "None" {
+			writeError(w, http.StatusConflict, "fail to set schedule for %s, there is already a schedule", kind)
+			return
+		}
+		if !create && !exists {
+			writeError(w, http.StatusNotFound, "no schedule for %s", kind)
+			return
+		}
+		in.ID = 1
+		in.CreationTime = s.now()
+		if exists {
+			in.CreationTime = current.CreationTime
+		}
+		in.UpdateTime = s.now()
+		s.schedules[kind] = &in
+		if create {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (s *Server) scannerID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	uuid := r.PathValue("registration_id")
+	id, ok := s.scanners.find(func(scanner harborapi.ScannerRegistration) bool { return scanner.UUID == uuid })
+	if !ok {
+		writeError(w, http.StatusNotFound, "scanner registration %s not found", uuid)
+	}
+	return id, ok
+}
+
+func (s *Server) listScanners(w http.ResponseWriter, r *http.Request) {
+	items, ok := filter(w, r, s.scanners.all(), func(scanner harborapi.ScannerRegistration) map[string]string {
+		return map[string]string{"name": scanner.Name, "description": scanner.Description, "url": scanner.URL}
+	})
+	if ok {
+		writePage(w, r, items)
+	}
+}
+
+func (s *Server) createScanner(w http.ResponseWriter, r *http.Request) {
+	var in harborapi.ScannerRegistrationReq
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Name == "" || in.URL == "" {
+		writeError(w, http.StatusBadRequest, "name and url are required")
+		return
+	}
+	if _, exists := s.scanners.find(func(scanner harborapi.ScannerRegistration) bool { return scanner.Name == in.Name }); exists {
+		writeError(w, http.StatusConflict, "scanner registration %s already exists", in.Name)
+		return
+	}
+	var uuid string
+	s.scanners.insert(func(id int) harborapi.ScannerRegistration {
+		uuid = fmt.Sprintf("00000000-0000-4000-8000-%012d", id)
+		return harborapi.ScannerRegistration{
+			UUID:             uuid,
+			Name:             in.Name,
+			Description:      in.Description,
+			URL:              in.URL,
+			Auth:             in.Auth,
+			AccessCredential: in.AccessCredential,
+			SkipCertVerify:   in.SkipCertVerify,
+			UseInternalAddr:  in.UseInternalAddr,
+			Disabled:         in.Disabled,
+			Health:           "healthy",
+			CreateTime:       s.now(),
+			UpdateTime:       s.now(),
+		}
+	})
+	writeCreated(w, "/scanners/"+uuid)
+}
+
+func (s *Server) getScanner(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.scannerID(w, r); ok {
+		scanner, _ := s.scanners.get(id)
+		writeJSON(w, http.StatusOK, scanner)
+	}
+}
+
+func (s *Server) updateScanner(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.scannerID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.ScannerRegistrationReq
+	if !decode(w, r, &in) {
+		return
+	}
+	if _, exists := s.scanners.find(func(scanner harborapi.ScannerRegistration) bool {
+		return scanner.Name == in.Name && scanner.UUID != r.PathValue("registration_id")
+	}); exists {
+		writeError(w, http.StatusConflict, "scanner registration %s already exists", in.Name)
+		return
+	}
+	scanner, _ := s.scanners.get(id)
+	scanner.Name = in.Name
+	scanner.Description = in.Description
+	scanner.URL = in.URL
+	scanner.Auth = in.Auth
+	scanner.AccessCredential = in.AccessCredential
+	scanner.SkipCertVerify = in.SkipCertVerify
+	scanner.UseInternalAddr = in.UseInternalAddr
+	scanner.Disabled = in.Disabled
+	scanner.UpdateTime = s.now()
+	s.scanners.put(id, scanner)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) setDefaultScanner(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.scannerID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.IsDefault
+	if !decode(w, r, &in) {
+		return
+	}
+	if !in.IsDefault {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	for otherID, scanner := range s.scanners.rows {
+		scanner.IsDefault = otherID == id
+		s.scanners.put(otherID, scanner)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteScanner(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.scannerID(w, r)
+	if !ok {
+		return
+	}
+	scanner, _ := s.scanners.get(id)
+	if scanner.IsDefault {
+		writeError(w, http.StatusForbidden, "the default scanner registration cannot be deleted")
+		return
+	}
+	s.scanners.delete(id)
+	writeJSON(w, http.StatusOK, scanner)
+}
+
+func (s *Server) listLabels(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	name, scope, projectID := query.Get("name"), query.Get("scope"), query.Get("project_id")
+	var items []harborapi.Label
+	for _, label := range s.labels.all() {
+		if name != "" && label.Name != name ||
+			scope != "" && label.Scope != scope ||
+			projectID != "" && strconv.Itoa(label.ProjectID) != projectID {
+			continue
+		}
+		items = append(items, label)
+	}
+	writePage(w, r, items)
+}
+
+func (s *Server) labelConflict(in harborapi.Label, id int) bool {
+	_, exists := s.labels.find(func(label harborapi.Label) bool {
+		return label.ID != id && label.Name == in.Name && label.Scope == in.Scope && label.ProjectID == in.ProjectID
+	})
+	return exists
+}
+
+func (s *Server) createLabel(w http.ResponseWriter, r *http.Request) {
+	var in harborapi.Label
+	if !decode(w, r, &in) {
+		return
+	}
+	switch {
+	case in.Name == "":
+		writeError(w, http.StatusBadRequest, "label name is required")
+		return
+	case in.Scope != "g" && in.Scope != "p":
+		writeError(w, http.StatusBadRequest, "invalid label scope %q", in.Scope)
+		return
+	case in.Scope == "p" && !s.projects.has(in.ProjectID):
+		writeError(w, http.StatusBadRequest, "project %d not found", in.ProjectID)
+		return
+	case s.labelConflict(in, 0):
+		writeError(w, http.StatusConflict, "label %s already exists", in.Name)
+		return
+	}
+	id := s.labels.insert(func(id int) harborapi.Label {
+		in.ID = id
+		in.CreationTime = s.now()
+		in.UpdateTime = s.now()
+		return in
+	})
+	writeCreated(w, "/labels/"+strconv.Itoa(id))
+}
+
+func (s *Server) labelID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, ok := pathID(w, r, "label_id")
+	if ok && !s.labels.has(id) {
+		writeError(w, http.StatusNotFound, "label %d not found", id)
+		return 0, false
+	}
+	return id, ok
+}
+
+func (s *Server) getLabel(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.labelID(w, r); ok {
+		label, _ := s.labels.get(id)
+		writeJSON(w, http.StatusOK, label)
+	}
+}
+
+func (s *Server) updateLabel(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.labelID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.Label
+	if !decode(w, r, &in) {
+		return
+	}
+	label, _ := s.labels.get(id)
+	label.Name = in.Name
+	label.Description = in.Description
+	label.Color = in.Color
+	if s.labelConflict(label, id) {
+		writeError(w, http.StatusConflict, "label %s already exists", label.Name)
+		return
+	}
+	label.UpdateTime = s.now()
+	s.labels.put(id, label)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteLabel(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.labelID(w, r); ok {
+		s.labels.delete(id)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (s *Server) listQuotas(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	reference, referenceID := query.Get("reference"), query.Get("reference_id")
+	var items []harborapi.Quota
+	for _, quota := range s.quotas.all() {
+		if reference != "" && reference != "project" {
+			continue
+		}
+		if referenceID != "" && fmt.Sprint(quota.Ref["id"]) != referenceID {
+			continue
+		}
+		items = append(items, quota)
+	}
+	writePage(w, r, items)
+}
+
+func (s *Server) quotaID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, ok := pathID(w, r, "id")
+	if ok && !s.quotas.has(id) {
+		writeError(w, http.StatusNotFound, "quota %d not found", id)
+		return 0, false
+	}
+	return id, ok
+}
+
+func (s *Server) getQuota(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.quotaID(w, r); ok {
+		quota, _ := s.quotas.get(id)
+		writeJSON(w, http.StatusOK, quota)
+	}
+}
+
+func (s *Server) updateQuota(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.quotaID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.QuotaUpdateReq
+	if !decode(w, r, &in) {
+		return
+	}
+	quota, _ := s.quotas.get(id)
+	for resource, limit := range in.Hard {
+		if _, known := quota.Hard[resource]; !known {
+			writeError(w, http.StatusBadRequest, "unknown quota resource %q", resource)
+			return
+		}
+		quota.Hard[resource] = limit
+	}
+	quota.UpdateTime = s.now()
+	s.quotas.put(id, quota)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listRegistries(w http.ResponseWriter, r *http.Request) {
+	items, ok := filter(w, r, s.registries.all(), func(registry harborapi.Registry) map[string]string {
+		return map[string]string{"name": registry.Name, "type": registry.Type, "url": registry.URL}
+	})
+	if !ok {
+		return
+	}
+	for i := range items {
+		items[i] = maskRegistry(items[i])
+	}
+	writePage(w, r, items)
+}
+
+// maskRegistry hides the stored secret the way Harbor does in responses.
+func maskRegistry(registry harborapi.Registry) harborapi.Registry {
+	if registry.Credential.AccessSecret != "" {
+		registry.Credential.AccessSecret = "*****"
+	}
+	return registry
+}
+
+func (s *Server) registryConflict(name string, id int) bool {
+	_, exists := s.registries.find(func(registry harborapi.Registry) bool {
+		return registry.ID != id && registry.Name == name
+	})
+	return exists
+}
+
+func (s *Server) createRegistry(w http.ResponseWriter, r *http.Request) {
+	var in harborapi.Registry
+	if !decode(w, r, &in) {
+		return
+	}
+	switch {
+	case in.Name == "" || in.URL == "" || in.Type == "":
+		writeError(w, http.StatusBadRequest, "name, url, and type are required")
+		return
+	case s.registryConflict(in.Name, 0):
+		writeError(w, http.StatusConflict, "registry %s already exists", in.Name)
+		return
+	}
+	id := s.registries.insert(func(id int) harborapi.Registry {
+		in.ID = id
+		in.Status = "healthy"
+		in.CreationTime = s.now()
+		in.UpdateTime = s.now()
+		return in
+	})
+	writeCreated(w, "/registries/"+strconv.Itoa(id))
+}
+
+func (s *Server) registryID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, ok := pathID(w, r, "id")
+	if ok && !s.registries.has(id) {
+		writeError(w, http.StatusNotFound, "registry %d not found", id)
+		return 0, false
+	}
+	return id, ok
+}
+
+func (s *Server) getRegistry(w http.ResponseWriter, r *http.Request) {
+	if id, ok := s.registryID(w, r); ok {
+		registry, _ := s.registries.get(id)
+		writeJSON(w, http.StatusOK, maskRegistry(registry))
+	}
+}
+
+func (s *Server) updateRegistry(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.registryID(w, r)
+	if !ok {
+		return
+	}
+	var in harborapi.RegistryUpdate
+	if !decode(w, r, &in) {
+		return
+	}
+	registry, _ := s.registries.get(id)
+	if in.Name != nil {
+		if s.registryConflict(*in.Name, id) {
+			writeError(w, http.StatusConflict, "registry %s already exists", *in.Name)
+			return
+		}
+		registry.Name = *in.Name
+	}
+	setIfPresent(&registry.Description, in.Description)
+	setIfPresent(&registry.URL, in.URL)
+	setIfPresent(&registry.Insecure, in.Insecure)
+	setIfPresent(&registry.Credential.Type, in.CredentialType)
+	setIfPresent(&registry.Credential.AccessKey, in.AccessKey)
+	setIfPresent(&registry.Credential.AccessSecret, in.AccessSecret)
+	if in.CACertificate != nil {
+		registry.CACertificate = in.CACertificate
+	}
+	registry.UpdateTime = s.now()
+	s.registries.put(id, registry)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteRegistry(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.registryID(w, r)
+	if !ok {
+		return
+	}
+	if _, used := s.replications.find(func(policy harborapi.ReplicationPolicy) bool {
+		return policy.SrcRegistry.ID == id || policy.DestRegistry.ID == id
+	}); used {
+		writeError(w, http.StatusPreconditionFailed, "registry %d is used by replication policies", id)
+		return
+	}
+	if _, used := s.projects.find(func(project harborapi.Project) bool { return project.RegistryID == id }); used {
+		writeError(w, http.StatusPreconditionFailed, "registry %d is used by proxy cache projects", id)
+		return
+	}
+	s.registries.delete(id)
+	w.WriteHeader(http.StatusOK)
+}
+
+func setIfPresent[T any](dst *T, src *T) {
+	if src != nil {
+		*dst = *src
+	}
+}
@@ -0,0 +1,21 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var driftDetectedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "harbor_operator_drift_detected_total",
+		Help: "Total number of times a Harbor resource was found to differ from an unchanged spec.",
+	},
+	[]string{"kind"},
+)
+
+func init() {
+	prometheus.MustRegister(driftDetectedTotal)
+}
+
+// ObserveDrift records that a resource of the given kind was found changed in
+// Harbor. A difference that persists across reconciles is recorded once.
+func ObserveDrift(kind string) {
+	driftDetectedTotal.WithLabelValues(kind).Inc()
+}
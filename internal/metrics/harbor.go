@@ -12,7 +12,7 @@ var (
 			Name: "harbor_operator_harbor_api_requests_total",
 			Help: "Total number of Harbor API requests made by the operator.",
 		},
-		[]string{"method", "endpoint", "status"},
+		[]string{"method", "endpoint", "status", "attempt"},
 	)
 
 	harborAPIRequestDurationSeconds = prometheus.NewHistogramVec(
@@ -21,20 +21,94 @@ var (
 			Help:    "Duration of Harbor API requests made by the operator.",
 			Buckets: prometheus.DefBuckets,
 		},
-		[]string{"method", "endpoint", "status"},
+		[]string{"method", "endpoint", "status", "attempt"},
+	)
+
+	harborClientCacheLookupsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "harbor_operator_harbor_client_cache_lookups_total",
+			Help: "Total number of shared Harbor client lookups by result.",
+		},
+		[]string{"result"},
+	)
+
+	harborLimiterWaitSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "harbor_operator_harbor_api_limiter_wait_seconds",
+			Help:    "Time Harbor API requests waited for the connection rate and concurrency limits.",
+			Buckets: []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2, 5},
+		},
+		[]string{"connection"},
+	)
+
+	harborLimiterQueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "harbor_operator_harbor_api_limiter_queue_depth",
+			Help: "Number of Harbor API requests waiting for the connection rate and concurrency limits.",
+		},
+		[]string{"connection"},
+	)
+
+	harborCircuitBreakerOpen = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "harbor_operator_harbor_circuit_breaker_open",
+			Help: "Whether the circuit breaker of a Harbor connection is open (1) or closed (0).",
+		},
+		[]string{"connection"},
 	)
 )
 
 func init() {
-	prometheus.MustRegister(harborAPIRequestsTotal, harborAPIRequestDurationSeconds)
+	prometheus.MustRegister(
+		harborAPIRequestsTotal,
+		harborAPIRequestDurationSeconds,
+		harborClientCacheLookupsTotal,
+		harborLimiterWaitSeconds,
+		harborLimiterQueueDepth,
+		harborCircuitBreakerOpen,
+	)
 }
 
-// ObserveHarborRequest records a single Harbor API request.
-func ObserveHarborRequest(method, endpoint string, status int, durationSeconds float64) {
+// ObserveHarborRequest records a single Harbor API request attempt. Retries of
+// the same request are recorded separately with increasing attempt numbers.
+func ObserveHarborRequest(method, endpoint string, status, attempt int, durationSeconds float64) {
 	statusLabel := "error"
 	if status > 0 {
 		statusLabel = strconv.Itoa(status)
 	}
-	harborAPIRequestsTotal.WithLabelValues(method, endpoint, statusLabel).Inc()
-	harborAPIRequestDurationSeconds.WithLabelValues(method, endpoint, statusLabel).Observe(durationSeconds)
+	attemptLabel := strconv.Itoa(attempt)
+	harborAPIRequestsTotal.WithLabelValues(method, endpoint, statusLabel, attemptLabel).Inc()
+	harborAPIRequestDurationSeconds.WithLabelValues(method, endpoint, statusLabel, attemptLabel).Observe(durationSeconds)
+}
+
+// ObserveHarborClientCache records whether a reconcile reused a shared Harbor
+// client or had to read the connection Secrets again.
+func ObserveHarborClientCache(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	harborClientCacheLookupsTotal.WithLabelValues(result).Inc()
+}
+
+// ObserveHarborLimiterWait records how long a request waited for the limits of
+// a Harbor connection, including requests that gave up.
+func ObserveHarborLimiterWait(connection string, durationSeconds float64) {
+	harborLimiterWaitSeconds.WithLabelValues(connection).Observe(durationSeconds)
+}
+
+// AddHarborLimiterQueueDepth adjusts the number of requests waiting for the
+// limits of a Harbor connection.
+func AddHarborLimiterQueueDepth(connection string, delta float64) {
+	harborLimiterQueueDepth.WithLabelValues(connection).Add(delta)
+}
+
+// SetHarborCircuitBreakerOpen records whether the circuit breaker of a Harbor
+// connection currently fails requests fast.
+func SetHarborCircuitBreakerOpen(connection string, open bool) {
+	value := 0.0
+	if open {
+		value = 1
+	}
+	harborCircuitBreakerOpen.WithLabelValues(connection).Set(value)
 }
@@ -0,0 +1,30 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var robotSecretExpiring = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "harbor_operator_robot_secret_expiring",
+		Help: "Whether the secret of a Robot expires within its warning window (1) or not (0).",
+	},
+	[]string{"namespace", "name"},
+)
+
+func init() {
+	prometheus.MustRegister(robotSecretExpiring)
+}
+
+// SetRobotSecretExpiring records whether the secret of a Robot is close to
+// expiring without having been rotated.
+func SetRobotSecretExpiring(namespace, name string, expiring bool) {
+	value := 0.0
+	if expiring {
+		value = 1
+	}
+	robotSecretExpiring.WithLabelValues(namespace, name).Set(value)
+}
+
+// DeleteRobotSecretExpiring removes the series of a Robot that no longer exists.
+func DeleteRobotSecretExpiring(namespace, name string) {
+	robotSecretExpiring.DeleteLabelValues(namespace, name)
+}
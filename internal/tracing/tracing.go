@@ -0,0 +1,89 @@
+// Package tracing configures the OpenTelemetry tracer provider the operator
+// exports reconcile and Harbor API spans through.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// ServiceName is the service.name resource attribute of exported spans.
+const ServiceName = "harbor-operator"
+
+// Exporter selects where spans are sent.
+type Exporter string
+
+const (
+	// ExporterNone disables tracing. Spans are still created but dropped.
+	ExporterNone Exporter = "none"
+	// ExporterOTLP sends spans to an OTLP gRPC collector.
+	ExporterOTLP Exporter = "otlp"
+	// ExporterStdout writes spans to standard output as JSON.
+	ExporterStdout Exporter = "stdout"
+)
+
+// Config is the tracing configuration from the command line.
+type Config struct {
+	Exporter Exporter
+	// Endpoint is the OTLP collector address as host:port. Empty uses the
+	// OTEL_EXPORTER_OTLP_ENDPOINT environment variable or localhost:4317.
+	Endpoint string
+	// Insecure disables TLS to the OTLP collector.
+	Insecure bool
+	// SampleRatio is the fraction of new traces that are sampled. Spans whose
+	// parent is sampled are always sampled.
+	SampleRatio float64
+}
+
+// Setup installs the global tracer provider and the W3C trace context
+// propagator. The returned function flushes and stops the exporter.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, fmt.Errorf("tracing sample ratio must be between 0 and 1, got %v", cfg.SampleRatio)
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{}
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	default:
+		return nil, fmt.Errorf("unsupported tracing exporter %q, must be one of none, otlp, or stdout", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create %s trace exporter: %w", cfg.Exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attribute.String("service.name", ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown, nil
+}